@@ -3,9 +3,11 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/harveywangdao/ants/app/article/model"
+	"github.com/harveywangdao/ants/database/softdelete"
 	"github.com/harveywangdao/ants/logger"
 	articlepb "github.com/harveywangdao/ants/rpc/article"
 	"github.com/harveywangdao/ants/util"
@@ -30,6 +32,7 @@ func (s *Service) AddArticle(ctx context.Context, req *articlepb.AddArticleReque
 		logger.Error(err)
 		return nil, err
 	}
+	s.ArticleSyncer.Notify(articleID)
 
 	return &articlepb.AddArticleResponse{
 		ArticleID: articleID,
@@ -117,12 +120,14 @@ func (s *Service) ModifyArticleInfo(ctx context.Context, req *articlepb.ModifyAr
 		logger.Error(err)
 		return nil, err
 	}
+	s.ArticleSyncer.Notify(req.ArticleInfo.ArticleID)
 
 	return &articlepb.ModifyArticleInfoResponse{
 		CodeMsg: "modify success",
 	}, nil
 }
 
+// 软删除,可以用RestoreArticle恢复
 func (s *Service) DelArticle(ctx context.Context, req *articlepb.DelArticleRequest) (*articlepb.DelArticleResponse, error) {
 	if req.ArticleID == "" {
 		return nil, errors.New("lost articleID")
@@ -133,8 +138,30 @@ func (s *Service) DelArticle(ctx context.Context, req *articlepb.DelArticleReque
 		logger.Error(err)
 		return nil, err
 	}
+	s.ArticleSyncer.Notify(req.ArticleID)
 
 	return &articlepb.DelArticleResponse{
 		CodeMsg: "delete success",
 	}, nil
 }
+
+func (s *Service) RestoreArticle(ctx context.Context, req *articlepb.RestoreArticleRequest) (*articlepb.RestoreArticleResponse, error) {
+	if req.ArticleID == "" {
+		return nil, errors.New("lost articleID")
+	}
+
+	restored, err := softdelete.Restore(s.db, model.ArticleModel{}, "article_id = ?", req.ArticleID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !restored {
+		return nil, fmt.Errorf("deleted article %s not found", req.ArticleID)
+	}
+	s.ArticleSyncer.Notify(req.ArticleID)
+
+	return &articlepb.RestoreArticleResponse{
+		CodeMsg: "restore success",
+	}, nil
+}
@@ -41,6 +41,16 @@ type ClientConfig struct {
 	UserServiceName string `yaml:"userServiceName" json:"userServiceName"`
 }
 
+type SoftDeleteConfig struct {
+	RetentionDays int64 `yaml:"retentionDays" json:"retentionDays"`
+}
+
+type SearchConfig struct {
+	ElasticURL   string `yaml:"elasticURL" json:"elasticURL"`
+	ArticleIndex string `yaml:"articleIndex" json:"articleIndex"`
+	SyncIndex    bool   `yaml:"syncIndex" json:"syncIndex"`
+}
+
 type Config struct {
 	Log        *LogConfig        `yaml:"log" json:"log"`
 	Etcd       *EtcdConfig       `yaml:"etcd" json:"etcd"`
@@ -48,6 +58,8 @@ type Config struct {
 	HttpServer *HttpServerConfig `yaml:"httpServer" json:"httpServer"`
 	Database   *DatabaseConfig   `yaml:"database" json:"database"`
 	Client     *ClientConfig     `yaml:"client" json:"client"`
+	SoftDelete *SoftDeleteConfig `yaml:"softDelete" json:"softDelete"`
+	Search     *SearchConfig     `yaml:"search" json:"search"`
 }
 
 func getConfig() (*Config, error) {
@@ -68,3 +80,10 @@ func getConfig() (*Config, error) {
 	logger.Debug("config:", string(data))
 	return &config, nil
 }
+
+func softDeleteRetentionDays(config *Config) int64 {
+	if config.SoftDelete == nil {
+		return 0
+	}
+	return config.SoftDelete.RetentionDays
+}
@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/article/model"
+	"github.com/harveywangdao/ants/indexer"
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+文章索引同步
+1.文章写入成功后通知ArticleSyncer,后台按articleID读取最新数据写入elasticsearch
+2.启动参数-reindex全量重建索引,重建完成后切换别名,search.articleIndex配置的是别名
+*/
+
+const (
+	ArticleIndexMapping = `{
+	"mappings": {
+		"_doc": {
+			"properties": {
+				"id":         {"type": "long"},
+				"article_id": {"type": "keyword"},
+				"user_id":    {"type": "keyword"},
+				"title":      {"type": "text"},
+				"content":    {"type": "text"},
+				"tags":       {"type": "keyword"}
+			}
+		}
+	}
+}`
+
+	ArticleScanBatchSize = 500
+)
+
+// 索引中的文章文档,字段名和article_tb一致
+type articleDocument struct {
+	ID        int64    `json:"id"`
+	ArticleID string   `json:"article_id"`
+	UserID    string   `json:"user_id"`
+	Title     string   `json:"title"`
+	Content   string   `json:"content"`
+	Tags      []string `json:"tags"`
+}
+
+func toArticleDocument(article *model.ArticleModel) *articleDocument {
+	doc := &articleDocument{
+		ID:        article.ID,
+		ArticleID: article.ArticleID,
+		UserID:    article.UserID,
+		Title:     article.Title,
+		Content:   article.Content,
+	}
+	if article.Tags != "" {
+		doc.Tags = strings.Split(article.Tags, "&&")
+	}
+
+	return doc
+}
+
+// 文章不存在或已删除时返回nil,索引中删除
+func (s *Service) loadArticleDocument(articleID string) (interface{}, error) {
+	var article model.ArticleModel
+	if err := s.db.Where("article_id = ?", articleID).First(&article).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	return toArticleDocument(&article), nil
+}
+
+// 全量扫描按id分批,增量扫描包括已删除的文章
+func (s *Service) scanArticles(ctx context.Context, since time.Time, put indexer.PutFunc) error {
+	var lastID int64
+	for {
+		query := s.db
+		if since.IsZero() {
+			query = query.Where("id > ?", lastID)
+		} else {
+			query = query.Unscoped().Where("id > ? AND update_time >= ?", lastID, since)
+		}
+
+		var articles []*model.ArticleModel
+		if err := query.Order("id asc").Limit(ArticleScanBatchSize).Find(&articles).Error; err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		for _, article := range articles {
+			var err error
+			if article.IsDelete != 0 {
+				err = put(article.ArticleID, nil)
+			} else {
+				err = put(article.ArticleID, toArticleDocument(article))
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(articles) < ArticleScanBatchSize {
+			return nil
+		}
+		lastID = articles[len(articles)-1].ID
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) initIndexer() error {
+	config := s.Config.Search
+	if config == nil || !config.SyncIndex {
+		return nil
+	}
+
+	idx, err := indexer.NewIndexer(config.ElasticURL)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := idx.EnsureIndex(context.Background(), config.ArticleIndex, ArticleIndexMapping); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	s.Indexer = idx
+	s.ArticleSyncer = indexer.NewSyncer(idx, config.ArticleIndex, s.loadArticleDocument)
+	s.ArticleSyncer.Start()
+
+	return nil
+}
+
+// 全量重建文章索引
+func StartReindex() error {
+	config, err := getConfig()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	App.Config = config
+
+	if App.Config.Search == nil {
+		return errors.New("search config is null")
+	}
+
+	if err := App.initDB(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	idx, err := indexer.NewIndexer(App.Config.Search.ElasticURL)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := idx.Reindex(context.Background(), App.Config.Search.ArticleIndex, ArticleIndexMapping, App.scanArticles); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	logger.Info("reindex", App.Config.Search.ArticleIndex, "success")
+	return nil
+}
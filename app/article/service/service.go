@@ -7,6 +7,9 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/harveywangdao/ants/app/article/model"
+	"github.com/harveywangdao/ants/database/softdelete"
+	"github.com/harveywangdao/ants/indexer"
 	"github.com/harveywangdao/ants/logger"
 	"github.com/harveywangdao/ants/register"
 	"github.com/harveywangdao/ants/register/discovery"
@@ -22,6 +25,9 @@ type Service struct {
 	Config    *Config
 	discovery *discovery.Discovery
 	db        *gorm.DB
+
+	Indexer       *indexer.Indexer
+	ArticleSyncer *indexer.Syncer
 }
 
 var (
@@ -60,7 +66,23 @@ func initService() error {
 	}
 	App.discovery = dis
 
-	dbConfig := App.Config.Database
+	if err := App.initDB(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := App.initIndexer(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	softdelete.StartPurge(softDeleteRetentionDays(App.Config), softdelete.PurgeTables(App.db, model.ArticleModel{}.TableName()))
+
+	return nil
+}
+
+func (s *Service) initDB() error {
+	dbConfig := s.Config.Database
 	dbParam := dbConfig.Username + ":" + dbConfig.Password + "@tcp(" + dbConfig.Address + ")/" + dbConfig.DbName + "?charset=utf8&parseTime=True&loc=Local"
 	db, err := gorm.Open(dbConfig.DriverName, dbParam)
 	if err != nil {
@@ -71,8 +93,9 @@ func initService() error {
 	db.DB().SetMaxIdleConns(10)
 	db.DB().SetMaxOpenConns(100)
 	db.DB().SetConnMaxLifetime(time.Hour)
+	softdelete.Register(db)
 
-	App.db = db
+	s.db = db
 
 	return nil
 }
@@ -0,0 +1,467 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/cart"
+	goodspb "github.com/harveywangdao/ants/rpc/goods"
+)
+
+/*
+购物车
+1.每个购物车是一个redis hash,field是goodsID,有SKU的商品是goodsID:skuID,value是数量
+2.登录用户按buyerID存,未登录按客户端生成的anonymousID存,每次修改都刷新过期时间
+3.登录后把匿名购物车合并到用户购物车,数量相加,匿名购物车删除
+*/
+
+const (
+	CartBuyerPrefix     = "CartBuyer"
+	CartAnonymousPrefix = "CartAnonymous"
+
+	CartDefaultTTLSeconds          = 30 * 24 * 3600
+	CartDefaultAnonymousTTLSeconds = 7 * 24 * 3600
+	CartDefaultMaxItems            = 100
+	CartDefaultMaxCount            = 999
+)
+
+func (s *Service) cartMaxItems() int64 {
+	if s.Config.Cart != nil && s.Config.Cart.MaxItems > 0 {
+		return s.Config.Cart.MaxItems
+	}
+	return CartDefaultMaxItems
+}
+
+func (s *Service) cartMaxCount() uint32 {
+	if s.Config.Cart != nil && s.Config.Cart.MaxCount > 0 {
+		return s.Config.Cart.MaxCount
+	}
+	return CartDefaultMaxCount
+}
+
+func (s *Service) buyerCart(buyerID string) (string, int64) {
+	ttl := int64(CartDefaultTTLSeconds)
+	if s.Config.Cart != nil && s.Config.Cart.TTLSeconds > 0 {
+		ttl = s.Config.Cart.TTLSeconds
+	}
+	return CartBuyerPrefix + buyerID, ttl
+}
+
+func (s *Service) anonymousCart(anonymousID string) (string, int64) {
+	ttl := int64(CartDefaultAnonymousTTLSeconds)
+	if s.Config.Cart != nil && s.Config.Cart.AnonymousTTLSeconds > 0 {
+		ttl = s.Config.Cart.AnonymousTTLSeconds
+	}
+	return CartAnonymousPrefix + anonymousID, ttl
+}
+
+// 登录用户优先用buyerID
+func (s *Service) cartKey(buyerID, anonymousID string) (string, int64, error) {
+	if buyerID != "" {
+		key, ttl := s.buyerCart(buyerID)
+		return key, ttl, nil
+	}
+
+	if anonymousID != "" {
+		key, ttl := s.anonymousCart(anonymousID)
+		return key, ttl, nil
+	}
+
+	return "", 0, errors.New("buyerID or anonymousID is null")
+}
+
+func cartField(goodsID, skuID string) string {
+	if skuID == "" {
+		return goodsID
+	}
+	return goodsID + ":" + skuID
+}
+
+func parseCartField(field string) (string, string) {
+	if i := strings.Index(field, ":"); i >= 0 {
+		return field[:i], field[i+1:]
+	}
+	return field, ""
+}
+
+// 检查商品存在,带skuID时检查SKU属于这个商品
+func (s *Service) checkCartGoods(ctx context.Context, goodsID, skuID string) error {
+	if skuID == "" {
+		_, err := s.GoodsServiceClient.GetGoods(ctx, &goodspb.GetGoodsRequest{GoodsID: goodsID, FromDB: true})
+		if err != nil {
+			logger.Error(err)
+		}
+		return err
+	}
+
+	getSkuResp, err := s.GoodsServiceClient.GetSku(ctx, &goodspb.GetSkuRequest{SkuID: skuID})
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	if getSkuResp.Code != 0 || getSkuResp.SkuInfo == nil || getSkuResp.SkuInfo.GoodsID != goodsID {
+		return fmt.Errorf("goods %s sku %s not found", goodsID, skuID)
+	}
+	return nil
+}
+
+// key是cartField
+func (s *Service) getCart(conn *redis.Redis, key string) (map[string]uint32, error) {
+	fieldValues, err := conn.Hgetall(key)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	cart := make(map[string]uint32)
+	for field, value := range fieldValues {
+		count, err := strconv.ParseUint(value, 10, 32)
+		if err != nil {
+			logger.Error("cart", key, "goods", field, "count", value, err)
+			continue
+		}
+		cart[field] = uint32(count)
+	}
+
+	return cart, nil
+}
+
+/*
+数量累加,超过上限按上限算
+新增商品后种类超过上限时删掉这个商品
+*/
+func (s *Service) addToCart(conn *redis.Redis, key, field string, count uint32) (uint32, error) {
+	total, err := conn.HincrBy(key, field, int64(count))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	if total == int64(count) {
+		itemNum, err := conn.Hlen(key)
+		if err != nil {
+			logger.Error(err)
+			return 0, err
+		}
+
+		if itemNum > s.cartMaxItems() {
+			if err := conn.Hdel(key, field); err != nil {
+				logger.Error(err)
+				return 0, err
+			}
+			return 0, nil
+		}
+	}
+
+	maxCount := s.cartMaxCount()
+	if total > int64(maxCount) {
+		if err := conn.Hset(key, field, strconv.FormatUint(uint64(maxCount), 10)); err != nil {
+			logger.Error(err)
+			return 0, err
+		}
+		total = int64(maxCount)
+	}
+
+	return uint32(total), nil
+}
+
+func (s *Service) AddCartItem(ctx context.Context, req *proto.AddCartItemRequest) (*proto.AddCartItemResponse, error) {
+	if req.GoodsID == "" || req.Count == 0 {
+		return nil, errors.New("goodsID or count is null")
+	}
+
+	key, ttl, err := s.cartKey(req.BuyerID, req.AnonymousID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 商品或SKU不存在时报错
+	if err := s.checkCartGoods(ctx, req.GoodsID, req.SkuID); err != nil {
+		return nil, err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	count, err := s.addToCart(conn, key, cartField(req.GoodsID, req.SkuID), req.Count)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if count == 0 {
+		return &proto.AddCartItemResponse{
+			Code:    common.ErrCartIsFull,
+			CodeMsg: "cart is full",
+		}, nil
+	}
+
+	if err := conn.Expire(key, ttl); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.AddCartItemResponse{
+		CodeMsg: "add cart item success",
+		Count:   count,
+	}, nil
+}
+
+// count为0时删除
+func (s *Service) UpdateCartItem(ctx context.Context, req *proto.UpdateCartItemRequest) (*proto.UpdateCartItemResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	key, ttl, err := s.cartKey(req.BuyerID, req.AnonymousID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	cart, err := s.getCart(conn, key)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	field := cartField(req.GoodsID, req.SkuID)
+	if _, ok := cart[field]; !ok {
+		return &proto.UpdateCartItemResponse{
+			Code:    common.ErrCartItemNotFound,
+			CodeMsg: "cart item not found",
+		}, nil
+	}
+
+	if req.Count == 0 {
+		if err := conn.Hdel(key, field); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	} else {
+		count := req.Count
+		if maxCount := s.cartMaxCount(); count > maxCount {
+			count = maxCount
+		}
+
+		if err := conn.Hset(key, field, strconv.FormatUint(uint64(count), 10)); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
+	if err := conn.Expire(key, ttl); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.UpdateCartItemResponse{
+		CodeMsg: "update cart item success",
+	}, nil
+}
+
+func (s *Service) RemoveCartItem(ctx context.Context, req *proto.RemoveCartItemRequest) (*proto.RemoveCartItemResponse, error) {
+	// goodsIDs删除没有SKU的商品,items按goodsID和skuID删除
+	fields := append([]string{}, req.GoodsIDs...)
+	for _, item := range req.Items {
+		fields = append(fields, cartField(item.GoodsID, item.SkuID))
+	}
+	if len(fields) == 0 {
+		return nil, errors.New("goodsIDs is null")
+	}
+
+	key, ttl, err := s.cartKey(req.BuyerID, req.AnonymousID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.Hdel(key, fields...); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if err := conn.Expire(key, ttl); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.RemoveCartItemResponse{
+		CodeMsg: "remove cart item success",
+	}, nil
+}
+
+// 商品名称价格库存实时查询,查询失败的商品只返回数量
+func (s *Service) ListCart(ctx context.Context, req *proto.ListCartRequest) (*proto.ListCartResponse, error) {
+	key, _, err := s.cartKey(req.BuyerID, req.AnonymousID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	cart, err := s.getCart(conn, key)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var fields []string
+	for field := range cart {
+		fields = append(fields, field)
+	}
+	sort.Strings(fields)
+
+	resp := &proto.ListCartResponse{}
+	for _, field := range fields {
+		goodsID, skuID := parseCartField(field)
+		item := &proto.CartItem{
+			GoodsID: goodsID,
+			SkuID:   skuID,
+			Count:   cart[field],
+		}
+
+		getGoodsResp, err := s.GoodsServiceClient.GetGoods(ctx, &goodspb.GetGoodsRequest{GoodsID: goodsID, FromDB: true})
+		if err != nil {
+			logger.Error(err)
+		} else if getGoodsResp.GoodsInfo != nil {
+			item.GoodsName = getGoodsResp.GoodsInfo.Name
+			item.Price = getGoodsResp.GoodsInfo.Price
+			item.Stock = getGoodsResp.GoodsInfo.Stock
+		}
+
+		// 有SKU的按SKU的价格和库存
+		if skuID != "" {
+			getSkuResp, err := s.GoodsServiceClient.GetSku(ctx, &goodspb.GetSkuRequest{SkuID: skuID})
+			if err != nil {
+				logger.Error(err)
+			} else if getSkuResp.SkuInfo != nil {
+				item.Price = getSkuResp.SkuInfo.Price
+				item.Stock = getSkuResp.SkuInfo.Stock
+			}
+		}
+
+		resp.Items = append(resp.Items, item)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ClearCart(ctx context.Context, req *proto.ClearCartRequest) (*proto.ClearCartResponse, error) {
+	key, _, err := s.cartKey(req.BuyerID, req.AnonymousID)
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	if err := conn.DeleteKey(key); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.ClearCartResponse{
+		CodeMsg: "clear cart success",
+	}, nil
+}
+
+// 登录后合并匿名购物车,用户购物车满了的商品不再合并
+func (s *Service) MergeCart(ctx context.Context, req *proto.MergeCartRequest) (*proto.MergeCartResponse, error) {
+	if req.BuyerID == "" || req.AnonymousID == "" {
+		return nil, errors.New("buyerID or anonymousID is null")
+	}
+
+	lock := redis.NewDistLock(s.RedisPool, "MergeCart"+req.AnonymousID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	anonymousKey, _ := s.anonymousCart(req.AnonymousID)
+	buyerKey, ttl := s.buyerCart(req.BuyerID)
+
+	anonymousCart, err := s.getCart(conn, anonymousKey)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if len(anonymousCart) == 0 {
+		return &proto.MergeCartResponse{
+			CodeMsg: "anonymous cart is empty",
+		}, nil
+	}
+
+	var merged uint32
+	for field, count := range anonymousCart {
+		if count == 0 {
+			continue
+		}
+
+		total, err := s.addToCart(conn, buyerKey, field, count)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		if total != 0 {
+			merged++
+		}
+	}
+
+	if err := conn.DeleteKey(anonymousKey); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if err := conn.Expire(buyerKey, ttl); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.MergeCartResponse{
+		CodeMsg:     "merge cart success",
+		MergedCount: merged,
+	}, nil
+}
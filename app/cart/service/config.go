@@ -0,0 +1,76 @@
+package service
+
+import (
+	"encoding/json"
+	"github.com/harveywangdao/ants/logger"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+)
+
+const (
+	configPath = "conf/app.yaml"
+)
+
+type LogConfig struct {
+	LogPath  string `yaml:"logPath" json:"logPath"`
+	LogLevel string `yaml:"logLevel" json:"logLevel"`
+}
+
+type EtcdConfig struct {
+	Endpoints []string `yaml:"endpoints" json:"endpoints"`
+}
+
+type ServerConfig struct {
+	Name string `yaml:"name" json:"name"`
+	Port string `yaml:"port" json:"port"`
+}
+
+type HttpServerConfig struct {
+	Port string `yaml:"port" json:"port"`
+}
+
+type ClientConfig struct {
+	GoodsServiceName string `yaml:"goodsServiceName" json:"goodsServiceName"`
+}
+
+type RedisConfig struct {
+	Address          string `yaml:"address" json:"address"`
+	Password         string `yaml:"password" json:"password"`
+	RedisLockTimeout int64  `yaml:"redisLockTimeout" json:"redisLockTimeout"`
+}
+
+type CartConfig struct {
+	TTLSeconds          int64  `yaml:"ttlSeconds" json:"ttlSeconds"`
+	AnonymousTTLSeconds int64  `yaml:"anonymousTTLSeconds" json:"anonymousTTLSeconds"`
+	MaxItems            int64  `yaml:"maxItems" json:"maxItems"`
+	MaxCount            uint32 `yaml:"maxCount" json:"maxCount"`
+}
+
+type Config struct {
+	Log        *LogConfig        `yaml:"log" json:"log"`
+	Etcd       *EtcdConfig       `yaml:"etcd" json:"etcd"`
+	Server     *ServerConfig     `yaml:"server" json:"server"`
+	HttpServer *HttpServerConfig `yaml:"httpServer" json:"httpServer"`
+	Client     *ClientConfig     `yaml:"client" json:"client"`
+	Redis      *RedisConfig      `yaml:"redis" json:"redis"`
+	Cart       *CartConfig       `yaml:"cart" json:"cart"`
+}
+
+func getConfig() (*Config, error) {
+	confData, err := ioutil.ReadFile(configPath)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var config Config
+	err = yaml.Unmarshal(confData, &config)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	data, _ := json.Marshal(&config)
+	logger.Debug("config:", string(data))
+	return &config, nil
+}
@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/cart"
+)
+
+type HandlerServiceFunc func([]byte) ([]byte, error)
+
+type HttpServer struct {
+	ServiceName string
+	Port        string
+}
+
+func (h *HttpServer) StartHttpServer(httpService *HttpService) {
+	router := gin.New()
+
+	router.Use(gin.Logger())
+	router.Use(gin.Recovery())
+
+	router.POST("/ants/v1/"+h.ServiceName+"/:funcName", func(c *gin.Context) {
+		funcName := c.Param("funcName")
+
+		body, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			logger.Error(err)
+			c.JSON(http.StatusOK, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Debug("funcName:", funcName, "body:", string(body))
+
+		//elem := reflect.ValueOf(&httpService).Elem()
+		elem := reflect.ValueOf(httpService)
+
+		myref := elem.Elem()
+		typeOfType := myref.Type()
+		for i := 0; i < myref.NumField(); i++ {
+			field := myref.Field(i)
+			logger.Debug(i, typeOfType.Field(i).Name, field.Type(), field.Interface())
+		}
+
+		methodExisted := false
+		for i := 0; i < elem.NumMethod(); i++ {
+			//logger.Info(elem.Method(i))
+			//logger.Info(elem.Type().Method(i).Name)
+
+			if funcName == elem.Type().Method(i).Name {
+				methodExisted = true
+				break
+			}
+		}
+
+		if !methodExisted {
+			c.JSON(http.StatusOK, gin.H{"error": "method not existed"})
+			return
+		}
+
+		params := make([]reflect.Value, 1)
+		params[0] = reflect.ValueOf(body)
+		resp := elem.MethodByName(funcName).Call(params)
+
+		if len(resp) != 2 {
+			c.JSON(http.StatusOK, gin.H{"error": "method return param num error"})
+			return
+		}
+
+		for i := 0; i < len(resp); i++ {
+			logger.Info(resp[i].Interface())
+		}
+
+		errMsg, ok := resp[1].Interface().(error)
+		if !ok {
+			errMsg = errors.New("")
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": resp[0].Interface(),
+			"error":   errMsg.Error(),
+		})
+	})
+
+	if err := router.Run(":" + h.Port); err != nil {
+		logger.Panicln(err)
+	}
+}
+
+type HttpService struct {
+	ServiceApp *Service
+}
+
+func (h *HttpService) AddCartItem(reqData []byte) (interface{}, error) {
+	req := &proto.AddCartItemRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.AddCartItem(context.Background(), req)
+}
+
+func (h *HttpService) UpdateCartItem(reqData []byte) (interface{}, error) {
+	req := &proto.UpdateCartItemRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.UpdateCartItem(context.Background(), req)
+}
+
+func (h *HttpService) RemoveCartItem(reqData []byte) (interface{}, error) {
+	req := &proto.RemoveCartItemRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RemoveCartItem(context.Background(), req)
+}
+
+func (h *HttpService) ListCart(reqData []byte) (interface{}, error) {
+	req := &proto.ListCartRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListCart(context.Background(), req)
+}
+
+func (h *HttpService) ClearCart(reqData []byte) (interface{}, error) {
+	req := &proto.ClearCartRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ClearCart(context.Background(), req)
+}
+
+func (h *HttpService) MergeCart(reqData []byte) (interface{}, error) {
+	req := &proto.MergeCartRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.MergeCart(context.Background(), req)
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+type CouponModel struct {
+	ID         int64     `gorm:"column:id"`
+	CouponID   string    `gorm:"column:coupon_id"`
+	TemplateID string    `gorm:"column:template_id"`
+	UserID     string    `gorm:"column:user_id"`
+	Status     uint8     `gorm:"column:status"`
+	OrderID    string    `gorm:"column:order_id"`
+	Discount   float64   `gorm:"column:discount"`
+	UseTime    int64     `gorm:"column:use_time"`
+	ExpireTime int64     `gorm:"column:expire_time"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m CouponModel) TableName() string {
+	return "coupon_tb"
+}
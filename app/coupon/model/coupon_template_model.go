@@ -0,0 +1,28 @@
+package model
+
+import (
+	"time"
+)
+
+type CouponTemplateModel struct {
+	ID           int64     `gorm:"column:id"`
+	TemplateID   string    `gorm:"column:template_id"`
+	Name         string    `gorm:"column:name"`
+	CouponType   string    `gorm:"column:coupon_type"`
+	Threshold    float64   `gorm:"column:threshold"`
+	Reduction    float64   `gorm:"column:reduction"`
+	DiscountRate float64   `gorm:"column:discount_rate"`
+	Total        uint32    `gorm:"column:total"`
+	Issued       uint32    `gorm:"column:issued"`
+	LimitPerUser uint32    `gorm:"column:limit_per_user"`
+	StartTime    int64     `gorm:"column:start_time"`
+	EndTime      int64     `gorm:"column:end_time"`
+	ValidDays    uint32    `gorm:"column:valid_days"`
+	CreateTime   time.Time `gorm:"column:create_time;-"`
+	UpdateTime   time.Time `gorm:"column:update_time;-"`
+	IsDelete     uint8     `gorm:"column:is_delete"`
+}
+
+func (m CouponTemplateModel) TableName() string {
+	return "coupon_template_tb"
+}
@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/harveywangdao/ants/app/coupon/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/coupon"
+	"github.com/harveywangdao/ants/util"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+优惠券
+1.先创建优惠券模板,再按模板发放给用户,可以批量发放也可以用户自己领取
+2.发放数量和每人限领数量在MySQL里用条件更新保证不超发
+3.下单时核销,用分布式锁和status条件更新保证一张券只能用一次,同一个订单重复核销返回相同结果
+4.订单取消或退款时退回优惠券,只有核销它的订单才能退回
+*/
+
+const (
+	CouponTypeReduction = "reduction" // 满减
+	CouponTypeDiscount  = "discount"  // 折扣
+
+	CouponStatusUnused = 0
+	CouponStatusUsed   = 1
+
+	CouponTimeLayout = "2006-01-02 15:04:05"
+)
+
+func roundPrice(x float64) float64 {
+	return math.Round(x*100) / 100
+}
+
+func formatTime(t int64) string {
+	if t == 0 {
+		return ""
+	}
+	return time.Unix(t, 0).Format(CouponTimeLayout)
+}
+
+func validateTemplate(t *proto.CouponTemplate) error {
+	if t.Name == "" || t.StartTime == "" || t.EndTime == "" {
+		return errors.New("name, startTime or endTime is null")
+	}
+
+	switch t.CouponType {
+	case CouponTypeReduction:
+		if t.Reduction <= 0 || t.Threshold < 0 {
+			return errors.New("reduction must be greater than 0")
+		}
+	case CouponTypeDiscount:
+		if t.DiscountRate <= 0 || t.DiscountRate >= 1 {
+			return errors.New("discountRate must be between 0 and 1")
+		}
+	default:
+		return fmt.Errorf("couponType %s not supported", t.CouponType)
+	}
+
+	return nil
+}
+
+func toTemplateInfo(m *model.CouponTemplateModel) *proto.CouponTemplate {
+	return &proto.CouponTemplate{
+		TemplateID:   m.TemplateID,
+		Name:         m.Name,
+		CouponType:   m.CouponType,
+		Threshold:    m.Threshold,
+		Reduction:    m.Reduction,
+		DiscountRate: m.DiscountRate,
+		Total:        m.Total,
+		Issued:       m.Issued,
+		LimitPerUser: m.LimitPerUser,
+		StartTime:    formatTime(m.StartTime),
+		EndTime:      formatTime(m.EndTime),
+		ValidDays:    m.ValidDays,
+	}
+}
+
+func toCouponInfo(c *model.CouponModel, t *model.CouponTemplateModel) *proto.Coupon {
+	info := &proto.Coupon{
+		CouponID:   c.CouponID,
+		TemplateID: c.TemplateID,
+		UserID:     c.UserID,
+		Status:     uint32(c.Status),
+		OrderID:    c.OrderID,
+		Discount:   c.Discount,
+		ExpireTime: formatTime(c.ExpireTime),
+	}
+
+	if t != nil {
+		info.Name = t.Name
+		info.CouponType = t.CouponType
+		info.Threshold = t.Threshold
+		info.Reduction = t.Reduction
+		info.DiscountRate = t.DiscountRate
+	}
+
+	return info
+}
+
+// 订单金额可以优惠多少,达不到门槛返回false
+func couponDiscount(t *model.CouponTemplateModel, amount float64) (float64, bool) {
+	if amount < t.Threshold {
+		return 0, false
+	}
+
+	switch t.CouponType {
+	case CouponTypeReduction:
+		return roundPrice(math.Min(t.Reduction, amount)), true
+	case CouponTypeDiscount:
+		return roundPrice(amount * (1 - t.DiscountRate)), true
+	}
+
+	return 0, false
+}
+
+func (s *Service) CreateCouponTemplate(ctx context.Context, req *proto.CreateCouponTemplateRequest) (*proto.CreateCouponTemplateResponse, error) {
+	if req.Template == nil {
+		return nil, errors.New("template is null")
+	}
+
+	if err := validateTemplate(req.Template); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	startTime, err := time.ParseInLocation(CouponTimeLayout, req.Template.StartTime, time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	endTime, err := time.ParseInLocation(CouponTimeLayout, req.Template.EndTime, time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !startTime.Before(endTime) {
+		return nil, errors.New("startTime must be before endTime")
+	}
+
+	t := &model.CouponTemplateModel{
+		TemplateID:   util.GetUUID(),
+		Name:         req.Template.Name,
+		CouponType:   req.Template.CouponType,
+		Threshold:    req.Template.Threshold,
+		Reduction:    req.Template.Reduction,
+		DiscountRate: req.Template.DiscountRate,
+		Total:        req.Template.Total,
+		LimitPerUser: req.Template.LimitPerUser,
+		StartTime:    startTime.Unix(),
+		EndTime:      endTime.Unix(),
+		ValidDays:    req.Template.ValidDays,
+	}
+
+	if err := s.db.Create(t).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.CreateCouponTemplateResponse{
+		CodeMsg:    "create coupon template success",
+		TemplateID: t.TemplateID,
+	}, nil
+}
+
+func (s *Service) getTemplate(templateID string) (*model.CouponTemplateModel, error) {
+	var t model.CouponTemplateModel
+	if err := s.db.Where("template_id = ?", templateID).First(&t).Error; err != nil {
+		return nil, err
+	}
+
+	return &t, nil
+}
+
+func (s *Service) GetCouponTemplate(ctx context.Context, req *proto.GetCouponTemplateRequest) (*proto.GetCouponTemplateResponse, error) {
+	if req.TemplateID == "" {
+		return nil, errors.New("templateID is null")
+	}
+
+	t, err := s.getTemplate(req.TemplateID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.GetCouponTemplateResponse{
+		Template: toTemplateInfo(t),
+	}, nil
+}
+
+// 给用户发一张券,返回的code不为0表示发放失败的原因
+func (s *Service) issueCoupon(t *model.CouponTemplateModel, userID string) (string, uint32, error) {
+	now := time.Now().Unix()
+	if now >= t.EndTime {
+		return "", common.ErrCouponUnavailable, nil
+	}
+
+	// 同一个用户同时只能领一张,保证不超过每人限领数量
+	lock := redis.NewDistLock(s.RedisPool, "ClaimCoupon"+t.TemplateID+userID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return "", 0, err
+	}
+	defer lock.Unlock()
+
+	if t.LimitPerUser != 0 {
+		var n uint32
+		if err := s.db.Model(model.CouponModel{}).Where("template_id = ? AND user_id = ?", t.TemplateID, userID).Count(&n).Error; err != nil {
+			logger.Error(err)
+			return "", 0, err
+		}
+
+		if n >= t.LimitPerUser {
+			return "", common.ErrCouponClaimLimit, nil
+		}
+	}
+
+	coupon := &model.CouponModel{
+		CouponID:   util.GetUUID(),
+		TemplateID: t.TemplateID,
+		UserID:     userID,
+		Status:     CouponStatusUnused,
+		ExpireTime: t.EndTime,
+	}
+	if t.ValidDays != 0 {
+		coupon.ExpireTime = now + int64(t.ValidDays)*24*3600
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		logger.Error(err)
+		return "", 0, err
+	}
+
+	result := tx.Model(model.CouponTemplateModel{}).Where("template_id = ? AND (total = 0 OR issued < total)", t.TemplateID).Update("issued", gorm.Expr("issued + ?", 1))
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return "", 0, err
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return "", common.ErrCouponSoldOut, nil
+	}
+
+	if err := tx.Create(coupon).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return "", 0, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		logger.Error(err)
+		return "", 0, err
+	}
+
+	return coupon.CouponID, 0, nil
+}
+
+// 批量发放,领完或者超过限领数量的用户放在failedUserIDs
+func (s *Service) IssueCoupons(ctx context.Context, req *proto.IssueCouponsRequest) (*proto.IssueCouponsResponse, error) {
+	if req.TemplateID == "" || len(req.UserIDs) == 0 {
+		return nil, errors.New("templateID or userIDs is null")
+	}
+
+	t, err := s.getTemplate(req.TemplateID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.IssueCouponsResponse{}
+	for _, userID := range req.UserIDs {
+		couponID, code, err := s.issueCoupon(t, userID)
+		if err != nil || code != 0 {
+			logger.Error("issue coupon", req.TemplateID, "to", userID, "failed, code:", code, "err:", err)
+			resp.FailedUserIDs = append(resp.FailedUserIDs, userID)
+			continue
+		}
+
+		resp.CouponIDs = append(resp.CouponIDs, couponID)
+	}
+
+	resp.CodeMsg = fmt.Sprintf("issue %d coupons, %d failed", len(resp.CouponIDs), len(resp.FailedUserIDs))
+
+	return resp, nil
+}
+
+func (s *Service) ClaimCoupon(ctx context.Context, req *proto.ClaimCouponRequest) (*proto.ClaimCouponResponse, error) {
+	if req.TemplateID == "" || req.UserID == "" {
+		return nil, errors.New("templateID or userID is null")
+	}
+
+	t, err := s.getTemplate(req.TemplateID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if time.Now().Unix() < t.StartTime {
+		return &proto.ClaimCouponResponse{
+			Code:    common.ErrCouponUnavailable,
+			CodeMsg: "coupon claim not started",
+		}, nil
+	}
+
+	couponID, code, err := s.issueCoupon(t, req.UserID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	switch code {
+	case common.ErrCouponUnavailable:
+		return &proto.ClaimCouponResponse{Code: code, CodeMsg: "coupon claim ended"}, nil
+	case common.ErrCouponClaimLimit:
+		return &proto.ClaimCouponResponse{Code: code, CodeMsg: "coupon claim limit reached"}, nil
+	case common.ErrCouponSoldOut:
+		return &proto.ClaimCouponResponse{Code: code, CodeMsg: "coupon sold out"}, nil
+	}
+
+	return &proto.ClaimCouponResponse{
+		CodeMsg:  "claim coupon success",
+		CouponID: couponID,
+	}, nil
+}
+
+func (s *Service) ListUserCoupons(ctx context.Context, req *proto.ListUserCouponsRequest) (*proto.ListUserCouponsResponse, error) {
+	if req.UserID == "" {
+		return nil, errors.New("userID is null")
+	}
+
+	query := s.db.Where("user_id = ?", req.UserID)
+	if req.OnlyAvailable {
+		query = query.Where("status = ? AND expire_time > ?", CouponStatusUnused, time.Now().Unix())
+	}
+
+	var coupons []*model.CouponModel
+	if err := query.Order("id desc").Find(&coupons).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var templateIDs []string
+	for _, c := range coupons {
+		templateIDs = append(templateIDs, c.TemplateID)
+	}
+
+	var templates []*model.CouponTemplateModel
+	if len(templateIDs) != 0 {
+		if err := s.db.Where("template_id in (?)", templateIDs).Find(&templates).Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
+	templateMap := make(map[string]*model.CouponTemplateModel)
+	for _, t := range templates {
+		templateMap[t.TemplateID] = t
+	}
+
+	resp := &proto.ListUserCouponsResponse{}
+	for _, c := range coupons {
+		resp.Coupons = append(resp.Coupons, toCouponInfo(c, templateMap[c.TemplateID]))
+	}
+
+	return resp, nil
+}
+
+// 核销优惠券,返回订单金额的优惠
+func (s *Service) RedeemCoupon(ctx context.Context, req *proto.RedeemCouponRequest) (*proto.RedeemCouponResponse, error) {
+	if req.CouponID == "" || req.UserID == "" || req.OrderID == "" {
+		return nil, errors.New("couponID, userID or orderID is null")
+	}
+
+	if req.Amount <= 0 {
+		return nil, errors.New("amount must be greater than 0")
+	}
+
+	lock := redis.NewDistLock(s.RedisPool, "RedeemCoupon"+req.CouponID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	var coupon model.CouponModel
+	if err := s.db.Where("coupon_id = ?", req.CouponID).First(&coupon).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.RedeemCouponResponse{
+				Code:    common.ErrCouponNotFound,
+				CodeMsg: "coupon not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	if coupon.UserID != req.UserID {
+		return &proto.RedeemCouponResponse{
+			Code:    common.ErrCouponUnavailable,
+			CodeMsg: "coupon not belong to user",
+		}, nil
+	}
+
+	if coupon.Status == CouponStatusUsed {
+		if coupon.OrderID == req.OrderID {
+			return &proto.RedeemCouponResponse{
+				CodeMsg:  "coupon already redeemed by this order",
+				Discount: coupon.Discount,
+			}, nil
+		}
+
+		return &proto.RedeemCouponResponse{
+			Code:    common.ErrCouponUnavailable,
+			CodeMsg: "coupon already used",
+		}, nil
+	}
+
+	now := time.Now().Unix()
+	if coupon.ExpireTime <= now {
+		return &proto.RedeemCouponResponse{
+			Code:    common.ErrCouponUnavailable,
+			CodeMsg: "coupon expired",
+		}, nil
+	}
+
+	t, err := s.getTemplate(coupon.TemplateID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	discount, ok := couponDiscount(t, req.Amount)
+	if !ok {
+		return &proto.RedeemCouponResponse{
+			Code:    common.ErrCouponThreshold,
+			CodeMsg: fmt.Sprintf("amount must reach %.2f", t.Threshold),
+		}, nil
+	}
+
+	param := map[string]interface{}{
+		"status":   CouponStatusUsed,
+		"order_id": req.OrderID,
+		"discount": discount,
+		"use_time": now,
+	}
+	result := s.db.Model(model.CouponModel{}).Where("coupon_id = ? AND status = ?", req.CouponID, CouponStatusUnused).Updates(param)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if result.RowsAffected == 0 {
+		return &proto.RedeemCouponResponse{
+			Code:    common.ErrCouponUnavailable,
+			CodeMsg: "coupon already used",
+		}, nil
+	}
+
+	return &proto.RedeemCouponResponse{
+		CodeMsg:  "redeem coupon success",
+		Discount: discount,
+	}, nil
+}
+
+// 退回优惠券,只有核销它的订单才能退回
+func (s *Service) ReturnCoupon(ctx context.Context, req *proto.ReturnCouponRequest) (*proto.ReturnCouponResponse, error) {
+	if req.CouponID == "" || req.OrderID == "" {
+		return nil, errors.New("couponID or orderID is null")
+	}
+
+	param := map[string]interface{}{
+		"status":   CouponStatusUnused,
+		"order_id": "",
+		"discount": 0,
+		"use_time": 0,
+	}
+	result := s.db.Model(model.CouponModel{}).Where("coupon_id = ? AND status = ? AND order_id = ?", req.CouponID, CouponStatusUsed, req.OrderID).Updates(param)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if result.RowsAffected == 0 {
+		return &proto.ReturnCouponResponse{
+			Code:    common.ErrCouponNotRedeemed,
+			CodeMsg: fmt.Sprintf("coupon %s not redeemed by order %s", req.CouponID, req.OrderID),
+		}, nil
+	}
+
+	return &proto.ReturnCouponResponse{
+		CodeMsg: "return coupon success",
+	}, nil
+}
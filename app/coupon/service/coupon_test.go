@@ -0,0 +1,34 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/harveywangdao/ants/app/coupon/model"
+)
+
+func TestCouponDiscount(t *testing.T) {
+	tests := []struct {
+		name     string
+		template *model.CouponTemplateModel
+		amount   float64
+		want     float64
+		wantOK   bool
+	}{
+		{"reduction below threshold", &model.CouponTemplateModel{CouponType: CouponTypeReduction, Threshold: 100, Reduction: 20}, 99.99, 0, false},
+		{"reduction reach threshold", &model.CouponTemplateModel{CouponType: CouponTypeReduction, Threshold: 100, Reduction: 20}, 100, 20, true},
+		{"reduction no threshold", &model.CouponTemplateModel{CouponType: CouponTypeReduction, Reduction: 20}, 35.5, 20, true},
+		// 优惠不超过订单金额
+		{"reduction more than amount", &model.CouponTemplateModel{CouponType: CouponTypeReduction, Reduction: 50}, 30, 30, true},
+		{"discount", &model.CouponTemplateModel{CouponType: CouponTypeDiscount, DiscountRate: 0.8}, 50, 10, true},
+		{"discount round", &model.CouponTemplateModel{CouponType: CouponTypeDiscount, DiscountRate: 0.85}, 99.99, 15, true},
+		{"discount below threshold", &model.CouponTemplateModel{CouponType: CouponTypeDiscount, Threshold: 200, DiscountRate: 0.9}, 150, 0, false},
+		{"unknown type", &model.CouponTemplateModel{CouponType: "gift"}, 100, 0, false},
+	}
+
+	for _, tt := range tests {
+		got, ok := couponDiscount(tt.template, tt.amount)
+		if got != tt.want || ok != tt.wantOK {
+			t.Errorf("%s: couponDiscount(%v) = %v, %v, want %v, %v", tt.name, tt.amount, got, ok, tt.want, tt.wantOK)
+		}
+	}
+}
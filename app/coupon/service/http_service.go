@@ -0,0 +1,174 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"reflect"
+
+	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/idempotency"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/coupon"
+)
+
+type HttpServer struct {
+	ServiceName string
+	Port        string
+}
+
+func (h *HttpServer) StartHttpServer(httpService *HttpService) {
+	router := gin.New()
+
+	router.Use(gin.Logger())
+	router.Use(gin.Recovery())
+	router.Use(idempotency.GinMiddleware(httpService.ServiceApp.Idempotency))
+
+	router.POST("/ants/v1/"+h.ServiceName+"/:funcName", func(c *gin.Context) {
+		funcName := c.Param("funcName")
+
+		body, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			logger.Error(err)
+			c.JSON(http.StatusOK, gin.H{"error": err.Error()})
+			return
+		}
+		logger.Debug("funcName:", funcName, "body:", string(body))
+
+		//elem := reflect.ValueOf(&httpService).Elem()
+		elem := reflect.ValueOf(httpService)
+
+		myref := elem.Elem()
+		typeOfType := myref.Type()
+		for i := 0; i < myref.NumField(); i++ {
+			field := myref.Field(i)
+			logger.Debug(i, typeOfType.Field(i).Name, field.Type(), field.Interface())
+		}
+
+		methodExisted := false
+		for i := 0; i < elem.NumMethod(); i++ {
+			//logger.Info(elem.Method(i))
+			//logger.Info(elem.Type().Method(i).Name)
+
+			if funcName == elem.Type().Method(i).Name {
+				methodExisted = true
+				break
+			}
+		}
+
+		if !methodExisted {
+			c.JSON(http.StatusOK, gin.H{"error": "method not existed"})
+			return
+		}
+
+		params := make([]reflect.Value, 1)
+		params[0] = reflect.ValueOf(body)
+		resp := elem.MethodByName(funcName).Call(params)
+
+		if len(resp) != 2 {
+			c.JSON(http.StatusOK, gin.H{"error": "method return param num error"})
+			return
+		}
+
+		for i := 0; i < len(resp); i++ {
+			logger.Info(resp[i].Interface())
+		}
+
+		errMsg, ok := resp[1].Interface().(error)
+		if !ok {
+			errMsg = errors.New("")
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": resp[0].Interface(),
+			"error":   errMsg.Error(),
+		})
+	})
+
+	if err := router.Run(":" + h.Port); err != nil {
+		logger.Panicln(err)
+	}
+}
+
+type HttpService struct {
+	ServiceApp *Service
+}
+
+func (h *HttpService) CreateCouponTemplate(reqData []byte) (interface{}, error) {
+	req := &proto.CreateCouponTemplateRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.CreateCouponTemplate(context.Background(), req)
+}
+
+func (h *HttpService) GetCouponTemplate(reqData []byte) (interface{}, error) {
+	req := &proto.GetCouponTemplateRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetCouponTemplate(context.Background(), req)
+}
+
+func (h *HttpService) IssueCoupons(reqData []byte) (interface{}, error) {
+	req := &proto.IssueCouponsRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.IssueCoupons(context.Background(), req)
+}
+
+func (h *HttpService) ClaimCoupon(reqData []byte) (interface{}, error) {
+	req := &proto.ClaimCouponRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ClaimCoupon(context.Background(), req)
+}
+
+func (h *HttpService) ListUserCoupons(reqData []byte) (interface{}, error) {
+	req := &proto.ListUserCouponsRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListUserCoupons(context.Background(), req)
+}
+
+func (h *HttpService) RedeemCoupon(reqData []byte) (interface{}, error) {
+	req := &proto.RedeemCouponRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RedeemCoupon(context.Background(), req)
+}
+
+func (h *HttpService) ReturnCoupon(reqData []byte) (interface{}, error) {
+	req := &proto.ReturnCouponRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ReturnCoupon(context.Background(), req)
+}
@@ -0,0 +1,39 @@
+CREATE TABLE IF NOT EXISTS `coupon_template_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `template_id` VARCHAR(50) NOT NULL COMMENT '优惠券模板唯一标识',
+   `name` VARCHAR(100) NOT NULL COMMENT '优惠券名称',
+   `coupon_type` VARCHAR(20) NOT NULL COMMENT '类型 reduction:满减 discount:折扣',
+   `threshold` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '使用门槛,订单金额达到才能使用',
+   `reduction` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '减免金额',
+   `discount_rate` DECIMAL(4,2) NOT NULL DEFAULT 0 COMMENT '折扣率',
+   `total` INT(11) NOT NULL DEFAULT 0 COMMENT '发放总量 0:不限',
+   `issued` INT(11) NOT NULL DEFAULT 0 COMMENT '已发放数量',
+   `limit_per_user` INT(11) NOT NULL DEFAULT 0 COMMENT '每人限领数量 0:不限',
+   `start_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '领取开始时间,unix时间戳',
+   `end_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '领取结束时间,unix时间戳',
+   `valid_days` INT(11) NOT NULL DEFAULT 0 COMMENT '领取后有效天数 0:到领取结束时间失效',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   UNIQUE INDEX `unique_template_id` (`template_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '优惠券模板表';
+
+CREATE TABLE IF NOT EXISTS `coupon_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `coupon_id` VARCHAR(50) NOT NULL COMMENT '优惠券唯一标识',
+   `template_id` VARCHAR(50) NOT NULL COMMENT '优惠券模板唯一标识',
+   `user_id` VARCHAR(50) NOT NULL COMMENT '用户ID',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:未使用 1:已使用',
+   `order_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的订单',
+   `discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '使用时的优惠金额',
+   `use_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '使用时间,unix时间戳',
+   `expire_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '过期时间,unix时间戳',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_user_id` (`user_id`),
+   INDEX `index_template_id_user_id` (`template_id`, `user_id`),
+   UNIQUE INDEX `unique_coupon_id` (`coupon_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '优惠券表';
@@ -1,8 +1,12 @@
 package service
 
 import (
+	"context"
+	"github.com/harveywangdao/ants/idempotency"
 	"github.com/harveywangdao/ants/logger"
 	"github.com/harveywangdao/ants/register/discovery"
+	"github.com/harveywangdao/ants/seller"
+	"google.golang.org/grpc/metadata"
 	"io/ioutil"
 	"net/http"
 	"strings"
@@ -46,7 +50,14 @@ func (s *gatewayHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	resp, err := httpToGrpc(dis, r.Form.Get(svc), r.Form.Get(grpcsvc), r.Form.Get(method), body)
+	// 幂等键透传给后端服务
+	ctx := context.Background()
+	if key := r.Header.Get(idempotency.HeaderKey); key != "" {
+		ctx = metadata.AppendToOutgoingContext(ctx, idempotency.MetadataKey, key)
+	}
+	ctx = seller.AppendToOutgoingContext(ctx, r.Header.Get(seller.HeaderKey))
+
+	resp, err := httpToGrpc(ctx, dis, r.Form.Get(svc), r.Form.Get(grpcsvc), r.Form.Get(method), body)
 	if err != nil {
 		logger.Error(err)
 		w.Write([]byte(err.Error()))
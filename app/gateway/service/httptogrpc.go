@@ -19,7 +19,7 @@ import (
 	reflectpb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 )
 
-func httpToGrpc(dis *discovery.Discovery, svcName, grpcSvcName, method string, reqData []byte) ([]byte, error) {
+func httpToGrpc(ctx context.Context, dis *discovery.Discovery, svcName, grpcSvcName, method string, reqData []byte) ([]byte, error) {
 	addrs, err := dis.QueryServiceIpPort(svcName)
 	if err != nil {
 		logger.Error(err)
@@ -87,7 +87,7 @@ func httpToGrpc(dis *discovery.Discovery, svcName, grpcSvcName, method string, r
 	reqMsg.UnmarshalJSON(reqData)
 
 	stub := grpcdynamic.NewStub(cc)
-	respMsg, err := stub.InvokeRpc(context.Background(), methodDesc, reqMsg)
+	respMsg, err := stub.InvokeRpc(ctx, methodDesc, reqMsg)
 	if err != nil {
 		logger.Error(err)
 		return nil, err
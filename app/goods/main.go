@@ -1,6 +1,7 @@
 package main
 
 import (
+	"flag"
 	"log"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -16,7 +17,28 @@ func init() {
 	logger.SetLevel(logger.INFO)
 }
 
+var (
+	reindex           = flag.Bool("reindex", false, "全量重建商品搜索索引后退出")
+	rebuildProjection = flag.Bool("rebuildProjection", false, "全量重建MongoDB商品读模型后退出")
+)
+
 func main() {
+	flag.Parse()
+
+	if *reindex {
+		if err := service.StartReindex(); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+
+	if *rebuildProjection {
+		if err := service.StartRebuildProjection(); err != nil {
+			logger.Fatal(err)
+		}
+		return
+	}
+
 	logger.Info("Start Server")
 	service.StartService()
 	logger.Info("Stop Server")
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+type CategoryModel struct {
+	ID         uint32    `gorm:"column:id"`
+	ParentID   uint32    `gorm:"column:parent_id"`
+	Name       string    `gorm:"column:name"`
+	Path       string    `gorm:"column:path"`
+	Level      uint32    `gorm:"column:level"`
+	Sort       int32     `gorm:"column:sort"`
+	Remark     string    `gorm:"column:remark"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m CategoryModel) TableName() string {
+	return "category_tb"
+}
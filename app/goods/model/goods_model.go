@@ -7,18 +7,21 @@ import (
 )
 
 type GoogsModel struct {
-	ID         int64     `gorm:"column:id"`
-	GoodsID    string    `gorm:"column:goods_id"`
-	SellerID   string    `gorm:"column:seller_id"`
-	GoodsName  string    `gorm:"column:goods_name"`
-	Price      float64   `gorm:"column:price"`
-	Category   uint32    `gorm:"column:category"`
-	Stock      int32     `gorm:"column:stock"`
-	Brand      string    `gorm:"column:brand"`
-	Remark     string    `gorm:"column:remark"`
-	CreateTime time.Time `gorm:"column:create_time;-"`
-	UpdateTime time.Time `gorm:"column:update_time;-"`
-	IsDelete   uint8     `gorm:"column:is_delete"`
+	ID                int64     `gorm:"column:id"`
+	GoodsID           string    `gorm:"column:goods_id"`
+	SellerID          string    `gorm:"column:seller_id"`
+	GoodsName         string    `gorm:"column:goods_name"`
+	Price             float64   `gorm:"column:price"`
+	Category          uint32    `gorm:"column:category"`
+	Stock             int32     `gorm:"column:stock"`
+	Brand             string    `gorm:"column:brand"`
+	SkuAttrs          string    `gorm:"column:sku_attrs"`
+	LowStockThreshold int32     `gorm:"column:low_stock_threshold"`
+	LowStockAlerted   uint8     `gorm:"column:low_stock_alerted"`
+	Remark            string    `gorm:"column:remark"`
+	CreateTime        time.Time `gorm:"column:create_time;-"`
+	UpdateTime        time.Time `gorm:"column:update_time;-"`
+	IsDelete          uint8     `gorm:"column:is_delete"`
 }
 
 func (m GoogsModel) TableName() string {
@@ -41,6 +44,9 @@ type PurchaseRecordModel struct {
 	GoodsID    string    `gorm:"column:goods_id"`
 	OrderID    string    `gorm:"column:order_id"`
 	PayID      string    `gorm:"column:pay_id"`
+	SkuID      string    `gorm:"column:sku_id"`
+	Number     uint32    `gorm:"column:number"`
+	Status     uint8     `gorm:"column:status"`
 	Remark     string    `gorm:"column:remark"`
 	CreateTime time.Time `gorm:"column:create_time;-"`
 	UpdateTime time.Time `gorm:"column:update_time;-"`
@@ -50,3 +56,58 @@ type PurchaseRecordModel struct {
 func (m PurchaseRecordModel) TableName() string {
 	return "purchase_record_tb"
 }
+
+type StockReservationModel struct {
+	ID            int64     `gorm:"column:id"`
+	ReservationID string    `gorm:"column:reservation_id"`
+	GoodsID       string    `gorm:"column:goods_id"`
+	OrderID       string    `gorm:"column:order_id"`
+	SkuID         string    `gorm:"column:sku_id"`
+	PayID         string    `gorm:"column:pay_id"`
+	Number        uint32    `gorm:"column:number"`
+	Status        uint8     `gorm:"column:status"`
+	ExpireTime    int64     `gorm:"column:expire_time"`
+	Remark        string    `gorm:"column:remark"`
+	CreateTime    time.Time `gorm:"column:create_time;-"`
+	UpdateTime    time.Time `gorm:"column:update_time;-"`
+	IsDelete      uint8     `gorm:"column:is_delete"`
+}
+
+func (m StockReservationModel) TableName() string {
+	return "stock_reservation_tb"
+}
+
+type StockLedgerModel struct {
+	ID         int64     `gorm:"column:id"`
+	GoodsID    string    `gorm:"column:goods_id"`
+	SkuID      string    `gorm:"column:sku_id"`
+	Type       uint8     `gorm:"column:type"`
+	Quantity   int32     `gorm:"column:quantity"`
+	RefID      string    `gorm:"column:ref_id"`
+	Reason     string    `gorm:"column:reason"`
+	ChangeTime int64     `gorm:"column:change_time"`
+	Remark     string    `gorm:"column:remark"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m StockLedgerModel) TableName() string {
+	return "stock_ledger_tb"
+}
+
+type RestockSubscriptionModel struct {
+	ID         int64     `gorm:"column:id"`
+	GoodsID    string    `gorm:"column:goods_id"`
+	UserID     string    `gorm:"column:user_id"`
+	Status     uint8     `gorm:"column:status"`
+	NotifyTime int64     `gorm:"column:notify_time"`
+	Remark     string    `gorm:"column:remark"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m RestockSubscriptionModel) TableName() string {
+	return "restock_subscription_tb"
+}
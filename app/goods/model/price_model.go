@@ -0,0 +1,42 @@
+package model
+
+import (
+	"time"
+)
+
+type PriceHistoryModel struct {
+	ID            int64     `gorm:"column:id"`
+	GoodsID       string    `gorm:"column:goods_id"`
+	SkuID         string    `gorm:"column:sku_id"`
+	Price         float64   `gorm:"column:price"`
+	OldPrice      float64   `gorm:"column:old_price"`
+	EffectiveTime int64     `gorm:"column:effective_time"`
+	Source        uint8     `gorm:"column:source"`
+	ScheduleID    string    `gorm:"column:schedule_id"`
+	Remark        string    `gorm:"column:remark"`
+	CreateTime    time.Time `gorm:"column:create_time;-"`
+	UpdateTime    time.Time `gorm:"column:update_time;-"`
+	IsDelete      uint8     `gorm:"column:is_delete"`
+}
+
+func (m PriceHistoryModel) TableName() string {
+	return "price_history_tb"
+}
+
+type PriceScheduleModel struct {
+	ID            int64     `gorm:"column:id"`
+	ScheduleID    string    `gorm:"column:schedule_id"`
+	GoodsID       string    `gorm:"column:goods_id"`
+	SkuID         string    `gorm:"column:sku_id"`
+	Price         float64   `gorm:"column:price"`
+	EffectiveTime int64     `gorm:"column:effective_time"`
+	Status        uint8     `gorm:"column:status"`
+	Remark        string    `gorm:"column:remark"`
+	CreateTime    time.Time `gorm:"column:create_time;-"`
+	UpdateTime    time.Time `gorm:"column:update_time;-"`
+	IsDelete      uint8     `gorm:"column:is_delete"`
+}
+
+func (m PriceScheduleModel) TableName() string {
+	return "price_schedule_tb"
+}
@@ -0,0 +1,22 @@
+package model
+
+import (
+	"time"
+)
+
+type SkuModel struct {
+	ID         int64     `gorm:"column:id"`
+	SkuID      string    `gorm:"column:sku_id"`
+	GoodsID    string    `gorm:"column:goods_id"`
+	Attrs      string    `gorm:"column:attrs"`
+	Price      float64   `gorm:"column:price"`
+	Stock      int32     `gorm:"column:stock"`
+	Remark     string    `gorm:"column:remark"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m SkuModel) TableName() string {
+	return "sku_tb"
+}
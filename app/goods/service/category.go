@@ -0,0 +1,548 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+商品分类树
+1.category_tb的id就是goods_tb的category,0表示未分类
+2.path保存从顶级分类到自己的ID路径,如/1/5/12/,查子树用path前缀匹配
+3.新增、移动、删除会改变树的结构,用同一把分布式锁串行执行
+4.有子分类或商品的分类不能删除
+*/
+
+const (
+	CategoryMaxLevel = 5
+
+	CategoryTreeLockKey = "CategoryTree"
+)
+
+func toCategoryInfo(category *model.CategoryModel) *proto.CategoryInfo {
+	return &proto.CategoryInfo{
+		CategoryID: category.ID,
+		ParentID:   category.ParentID,
+		Name:       category.Name,
+		Level:      category.Level,
+		Sort:       category.Sort,
+	}
+}
+
+func categoryPath(parentPath string, categoryID uint32) string {
+	if parentPath == "" {
+		parentPath = "/"
+	}
+	return parentPath + strconv.FormatUint(uint64(categoryID), 10) + "/"
+}
+
+func getCategory(db *gorm.DB, categoryID uint32) (*model.CategoryModel, error) {
+	var category model.CategoryModel
+	if err := db.Where("id = ?", categoryID).First(&category).Error; err != nil {
+		return nil, err
+	}
+	return &category, nil
+}
+
+// 同一个父分类下名称不能重复
+func categoryNameExisted(db *gorm.DB, parentID uint32, name string, excludeID uint32) (bool, error) {
+	var count int64
+	if err := db.Model(model.CategoryModel{}).Where("parent_id = ? AND name = ? AND id != ?", parentID, name, excludeID).Count(&count).Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+	return count != 0, nil
+}
+
+// 商品接口校验分类,0表示未分类
+func (s *Service) categoryExisted(categoryID uint32) (bool, error) {
+	if categoryID == 0 {
+		return true, nil
+	}
+
+	if _, err := getCategory(s.db, categoryID); err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		logger.Error(err)
+		return false, err
+	}
+	return true, nil
+}
+
+// 子树中所有分类的ID,包括自己
+func (s *Service) subCategoryIDs(category *model.CategoryModel) ([]uint32, error) {
+	var categories []*model.CategoryModel
+	if err := s.db.Select("id").Where("path LIKE ?", category.Path+"%").Find(&categories).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var ids []uint32
+	for _, c := range categories {
+		ids = append(ids, c.ID)
+	}
+	return ids, nil
+}
+
+func (s *Service) lockCategoryTree() (*redis.DistLock, error) {
+	lock := redis.NewDistLock(s.RedisPool, CategoryTreeLockKey, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	return lock, nil
+}
+
+func (s *Service) AddCategory(ctx context.Context, req *proto.AddCategoryRequest) (*proto.AddCategoryResponse, error) {
+	if req.Name == "" {
+		return nil, errors.New("category name is null")
+	}
+
+	lock, err := s.lockCategoryTree()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	parentPath, level := "", uint32(1)
+	if req.ParentID != 0 {
+		parent, err := getCategory(s.db, req.ParentID)
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return &proto.AddCategoryResponse{
+					Code:    common.ErrCategoryNotFound,
+					CodeMsg: "parent category not found",
+				}, nil
+			}
+			logger.Error(err)
+			return nil, err
+		}
+
+		if parent.Level >= CategoryMaxLevel {
+			return &proto.AddCategoryResponse{
+				Code:    common.ErrCategoryTooDeep,
+				CodeMsg: "category level exceeded",
+			}, nil
+		}
+		parentPath, level = parent.Path, parent.Level+1
+	}
+
+	existed, err := categoryNameExisted(s.db, req.ParentID, req.Name, 0)
+	if err != nil {
+		return nil, err
+	}
+	if existed {
+		return &proto.AddCategoryResponse{
+			Code:    common.ErrCategoryNameRepeat,
+			CodeMsg: "category name repeat",
+		}, nil
+	}
+
+	category := &model.CategoryModel{
+		ParentID: req.ParentID,
+		Name:     req.Name,
+		Level:    level,
+		Sort:     req.Sort,
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(category).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	// path包含自增id,插入后才能确定
+	if err := tx.Model(model.CategoryModel{}).Where("id = ?", category.ID).Update("path", categoryPath(parentPath, category.ID)).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return &proto.AddCategoryResponse{
+		CategoryID: category.ID,
+	}, nil
+}
+
+func (s *Service) GetCategory(ctx context.Context, req *proto.GetCategoryRequest) (*proto.GetCategoryResponse, error) {
+	if req.CategoryID == 0 {
+		return nil, errors.New("categoryID is null")
+	}
+
+	category, err := getCategory(s.db, req.CategoryID)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.GetCategoryResponse{
+				Code:    common.ErrCategoryNotFound,
+				CodeMsg: "category not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 从path解析出所有上级分类
+	var ancestorIDs []string
+	for _, id := range strings.Split(strings.Trim(category.Path, "/"), "/") {
+		if id != "" && id != strconv.FormatUint(uint64(category.ID), 10) {
+			ancestorIDs = append(ancestorIDs, id)
+		}
+	}
+
+	resp := &proto.GetCategoryResponse{
+		CategoryInfo: toCategoryInfo(category),
+	}
+
+	if len(ancestorIDs) != 0 {
+		var ancestors []*model.CategoryModel
+		if err := s.db.Where("id in (?)", ancestorIDs).Order("level asc").Find(&ancestors).Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		for _, a := range ancestors {
+			resp.Ancestors = append(resp.Ancestors, toCategoryInfo(a))
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ListCategories(ctx context.Context, req *proto.ListCategoriesRequest) (*proto.ListCategoriesResponse, error) {
+	var categories []*model.CategoryModel
+	if err := s.db.Where("parent_id = ?", req.ParentID).Order("sort asc").Order("id asc").Find(&categories).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.ListCategoriesResponse{}
+	for _, c := range categories {
+		resp.Categories = append(resp.Categories, toCategoryInfo(c))
+	}
+
+	return resp, nil
+}
+
+// categoryID为0时返回整棵树
+func (s *Service) GetCategoryTree(ctx context.Context, req *proto.GetCategoryTreeRequest) (*proto.GetCategoryTreeResponse, error) {
+	query := s.db
+	if req.CategoryID != 0 {
+		root, err := getCategory(s.db, req.CategoryID)
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return &proto.GetCategoryTreeResponse{
+					Code:    common.ErrCategoryNotFound,
+					CodeMsg: "category not found",
+				}, nil
+			}
+			logger.Error(err)
+			return nil, err
+		}
+		query = query.Where("path LIKE ?", root.Path+"%")
+	}
+
+	var categories []*model.CategoryModel
+	if err := query.Order("level asc").Order("sort asc").Order("id asc").Find(&categories).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 按层级排序,父节点一定在子节点之前
+	resp := &proto.GetCategoryTreeResponse{}
+	nodes := make(map[uint32]*proto.CategoryNode)
+	for _, c := range categories {
+		node := &proto.CategoryNode{
+			CategoryInfo: toCategoryInfo(c),
+		}
+		nodes[c.ID] = node
+
+		if parent, ok := nodes[c.ParentID]; ok {
+			parent.Children = append(parent.Children, node)
+		} else {
+			resp.Nodes = append(resp.Nodes, node)
+		}
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ModifyCategory(ctx context.Context, req *proto.ModifyCategoryRequest) (*proto.ModifyCategoryResponse, error) {
+	if req.CategoryID == 0 || req.Name == "" {
+		return nil, errors.New("categoryID or name is null")
+	}
+
+	lock, err := s.lockCategoryTree()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	category, err := getCategory(s.db, req.CategoryID)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.ModifyCategoryResponse{
+				Code:    common.ErrCategoryNotFound,
+				CodeMsg: "category not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	existed, err := categoryNameExisted(s.db, category.ParentID, req.Name, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existed {
+		return &proto.ModifyCategoryResponse{
+			Code:    common.ErrCategoryNameRepeat,
+			CodeMsg: "category name repeat",
+		}, nil
+	}
+
+	param := map[string]interface{}{
+		"name": req.Name,
+		"sort": req.Sort,
+	}
+	if err := s.db.Model(model.CategoryModel{}).Where("id = ?", category.ID).Updates(param).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	s.notifyCategoryGoods([]uint32{category.ID})
+
+	return &proto.ModifyCategoryResponse{
+		CodeMsg: "modify success",
+	}, nil
+}
+
+/*
+移动分类,子树跟着一起移动
+1.不能移动到自己或自己的子分类下
+2.移动后子树的最大层级不能超过CategoryMaxLevel
+3.子树所有分类的path前缀和level一起更新,商品的category不变
+*/
+func (s *Service) MoveCategory(ctx context.Context, req *proto.MoveCategoryRequest) (*proto.MoveCategoryResponse, error) {
+	if req.CategoryID == 0 {
+		return nil, errors.New("categoryID is null")
+	}
+
+	lock, err := s.lockCategoryTree()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	category, err := getCategory(s.db, req.CategoryID)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.MoveCategoryResponse{
+				Code:    common.ErrCategoryNotFound,
+				CodeMsg: "category not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	if category.ParentID == req.ParentID {
+		return &proto.MoveCategoryResponse{
+			CodeMsg: "move success",
+		}, nil
+	}
+
+	parentPath, level := "", uint32(1)
+	if req.ParentID != 0 {
+		parent, err := getCategory(s.db, req.ParentID)
+		if err != nil {
+			if gorm.IsRecordNotFoundError(err) {
+				return &proto.MoveCategoryResponse{
+					Code:    common.ErrCategoryNotFound,
+					CodeMsg: "parent category not found",
+				}, nil
+			}
+			logger.Error(err)
+			return nil, err
+		}
+
+		if strings.HasPrefix(parent.Path, category.Path) {
+			return &proto.MoveCategoryResponse{
+				Code:    common.ErrCategoryMoveIllegal,
+				CodeMsg: "can not move category into its own subtree",
+			}, nil
+		}
+		parentPath, level = parent.Path, parent.Level+1
+	}
+
+	var maxLevel struct {
+		Level uint32 `gorm:"column:level"`
+	}
+	if err := s.db.Model(model.CategoryModel{}).Select("max(level) as level").Where("path LIKE ?", category.Path+"%").Scan(&maxLevel).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if maxLevel.Level-category.Level+level > CategoryMaxLevel {
+		return &proto.MoveCategoryResponse{
+			Code:    common.ErrCategoryTooDeep,
+			CodeMsg: "category level exceeded",
+		}, nil
+	}
+
+	existed, err := categoryNameExisted(s.db, req.ParentID, category.Name, category.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existed {
+		return &proto.MoveCategoryResponse{
+			Code:    common.ErrCategoryNameRepeat,
+			CodeMsg: "category name repeat",
+		}, nil
+	}
+
+	newPath := categoryPath(parentPath, category.ID)
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	// 已删除的子分类也一起更新,恢复后仍在正确的位置
+	if err := tx.Exec("UPDATE category_tb SET path = CONCAT(?, SUBSTRING(path, ?)), level = level + ? WHERE path LIKE ?",
+		newPath, len(category.Path)+1, int64(level)-int64(category.Level), category.Path+"%").Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Model(model.CategoryModel{}).Where("id = ?", category.ID).Update("parent_id", req.ParentID).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	// 子树下商品的分类路径变了,查询失败只记日志
+	if s.ProjectionSyncer != nil {
+		category.Path = newPath
+		if ids, err := s.subCategoryIDs(category); err == nil {
+			s.notifyCategoryGoods(ids)
+		}
+	}
+
+	return &proto.MoveCategoryResponse{
+		CodeMsg: "move success",
+	}, nil
+}
+
+// 软删除,有子分类或商品时不能删除
+func (s *Service) DelCategory(ctx context.Context, req *proto.DelCategoryRequest) (*proto.DelCategoryResponse, error) {
+	if req.CategoryID == 0 {
+		return nil, errors.New("categoryID is null")
+	}
+
+	lock, err := s.lockCategoryTree()
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	if _, err := getCategory(s.db, req.CategoryID); err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.DelCategoryResponse{
+				Code:    common.ErrCategoryNotFound,
+				CodeMsg: "category not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	var children int64
+	if err := s.db.Model(model.CategoryModel{}).Where("parent_id = ?", req.CategoryID).Count(&children).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var goods int64
+	if err := s.db.Model(model.GoogsModel{}).Where("category = ?", req.CategoryID).Count(&goods).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if children != 0 || goods != 0 {
+		return &proto.DelCategoryResponse{
+			Code:    common.ErrCategoryNotEmpty,
+			CodeMsg: "category has sub categories or goods",
+		}, nil
+	}
+
+	if err := s.db.Where("id = ?", req.CategoryID).Delete(model.CategoryModel{}).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.DelCategoryResponse{
+		CodeMsg: "delete success",
+	}, nil
+}
+
+// 分类及其所有子分类下的商品,分页和排序同SearchGoods
+func (s *Service) ListGoodsByCategoryTree(ctx context.Context, req *proto.ListGoodsByCategoryTreeRequest) (*proto.ListGoodsByCategoryTreeResponse, error) {
+	if req.CategoryID == 0 {
+		return nil, errors.New("categoryID is null")
+	}
+
+	category, err := getCategory(s.db, req.CategoryID)
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.ListGoodsByCategoryTreeResponse{
+				Code:    common.ErrCategoryNotFound,
+				CodeMsg: "category not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	ids, err := s.subCategoryIDs(category)
+	if err != nil {
+		return nil, err
+	}
+
+	searchResp, err := s.SearchGoods(ctx, &proto.SearchGoodsRequest{
+		Categories: ids,
+		Cursor:     req.Cursor,
+		NumPerPage: req.NumPerPage,
+	})
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.ListGoodsByCategoryTreeResponse{
+		Goods:      searchResp.Goods,
+		Total:      searchResp.Total,
+		NextCursor: searchResp.NextCursor,
+	}, nil
+}
@@ -54,15 +54,70 @@ type MongoConfig struct {
 	DbName   string `yaml:"dbName" json:"dbName"`
 }
 
+type ReservationConfig struct {
+	ExpireSeconds int64 `yaml:"expireSeconds" json:"expireSeconds"`
+	SweepInterval int64 `yaml:"sweepInterval" json:"sweepInterval"`
+}
+
+type PriceScheduleConfig struct {
+	SweepInterval int64 `yaml:"sweepInterval" json:"sweepInterval"`
+}
+
+type StockLedgerConfig struct {
+	ReconcileInterval int64 `yaml:"reconcileInterval" json:"reconcileInterval"`
+}
+
+type StockAlertConfig struct {
+	Publisher  string   `yaml:"publisher" json:"publisher"`
+	Topic      string   `yaml:"topic" json:"topic"`
+	NsqAddr    string   `yaml:"nsqAddr" json:"nsqAddr"`
+	KafkaAddrs []string `yaml:"kafkaAddrs" json:"kafkaAddrs"`
+}
+
+type RestockConfig struct {
+	Sender   string `yaml:"sender" json:"sender"`
+	FilePath string `yaml:"filePath" json:"filePath"`
+}
+
+type IdempotencyConfig struct {
+	TTLSeconds int64 `yaml:"ttlSeconds" json:"ttlSeconds"`
+}
+
+type SoftDeleteConfig struct {
+	RetentionDays int64 `yaml:"retentionDays" json:"retentionDays"`
+}
+
+type SearchConfig struct {
+	Backend    string `yaml:"backend" json:"backend"`
+	ElasticURL string `yaml:"elasticURL" json:"elasticURL"`
+	GoodsIndex string `yaml:"goodsIndex" json:"goodsIndex"`
+	SyncIndex  bool   `yaml:"syncIndex" json:"syncIndex"`
+}
+
+type ProjectionConfig struct {
+	Sync       bool   `yaml:"sync" json:"sync"`
+	ReadGoods  bool   `yaml:"readGoods" json:"readGoods"`
+	Collection string `yaml:"collection" json:"collection"`
+}
+
 type Config struct {
-	Log        *LogConfig        `yaml:"log" json:"log"`
-	Etcd       *EtcdConfig       `yaml:"etcd" json:"etcd"`
-	Server     *ServerConfig     `yaml:"server" json:"server"`
-	HttpServer *HttpServerConfig `yaml:"httpServer" json:"httpServer"`
-	Database   *DatabaseConfig   `yaml:"database" json:"database"`
-	Client     *ClientConfig     `yaml:"client" json:"client"`
-	Redis      *RedisConfig      `yaml:"redis" json:"redis"`
-	Mongo      *MongoConfig      `yaml:"mongo" json:"mongo"`
+	Log           *LogConfig           `yaml:"log" json:"log"`
+	Etcd          *EtcdConfig          `yaml:"etcd" json:"etcd"`
+	Server        *ServerConfig        `yaml:"server" json:"server"`
+	HttpServer    *HttpServerConfig    `yaml:"httpServer" json:"httpServer"`
+	Database      *DatabaseConfig      `yaml:"database" json:"database"`
+	Client        *ClientConfig        `yaml:"client" json:"client"`
+	Redis         *RedisConfig         `yaml:"redis" json:"redis"`
+	Mongo         *MongoConfig         `yaml:"mongo" json:"mongo"`
+	Reservation   *ReservationConfig   `yaml:"reservation" json:"reservation"`
+	PriceSchedule *PriceScheduleConfig `yaml:"priceSchedule" json:"priceSchedule"`
+	StockLedger   *StockLedgerConfig   `yaml:"stockLedger" json:"stockLedger"`
+	StockAlert    *StockAlertConfig    `yaml:"stockAlert" json:"stockAlert"`
+	Restock       *RestockConfig       `yaml:"restock" json:"restock"`
+	Idempotency   *IdempotencyConfig   `yaml:"idempotency" json:"idempotency"`
+	SoftDelete    *SoftDeleteConfig    `yaml:"softDelete" json:"softDelete"`
+	Search        *SearchConfig        `yaml:"search" json:"search"`
+	Projection    *ProjectionConfig    `yaml:"projection" json:"projection"`
 }
 
 func getConfig() (*Config, error) {
@@ -83,3 +138,31 @@ func getConfig() (*Config, error) {
 	logger.Debug("config:", string(data))
 	return &config, nil
 }
+
+func idempotencyTTLSeconds(config *Config) int64 {
+	if config.Idempotency == nil {
+		return 0
+	}
+	return config.Idempotency.TTLSeconds
+}
+
+func softDeleteRetentionDays(config *Config) int64 {
+	if config.SoftDelete == nil {
+		return 0
+	}
+	return config.SoftDelete.RetentionDays
+}
+
+func priceScheduleSweepInterval(config *Config) int64 {
+	if config.PriceSchedule == nil {
+		return 0
+	}
+	return config.PriceSchedule.SweepInterval
+}
+
+func stockReconcileInterval(config *Config) int64 {
+	if config.StockLedger == nil {
+		return 0
+	}
+	return config.StockLedger.ReconcileInterval
+}
@@ -3,109 +3,44 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/harveywangdao/ants/app/goods/model"
 	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/database/softdelete"
 	"github.com/harveywangdao/ants/logger"
 	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/harveywangdao/ants/seller"
 	"github.com/harveywangdao/ants/util"
-	"go.mongodb.org/mongo-driver/bson"
 )
 
-func (s *Service) AddGoodsIntoMgo(ctx context.Context, goods *model.GoogsModel) error {
-	collection := s.Mongo.Database(s.Config.Mongo.DbName).Collection("product_col")
-
-	// db
-	dbs, err := s.Mongo.ListDatabases(context.Background(), bson.M{})
-	if err != nil {
-		logger.Error(err)
-		return err
-	}
-	logger.Info("dbs:", dbs)
-
-	// collection
-	collections, err := s.Mongo.Database(s.Config.Mongo.DbName).ListCollectionNames(context.Background(), bson.M{})
-	if err != nil {
-		logger.Error(err)
-		return err
-	}
-	logger.Info("collections:", collections)
-
-	// insert
-	insertOneResult, err := collection.InsertOne(context.Background(), bson.M{
-		"goods_id":   goods.GoodsID,
-		"seller_id":  goods.SellerID,
-		"goods_name": goods.GoodsName,
-		"price":      goods.Price,
-		"category":   goods.Category,
-		"stock":      goods.Stock,
-		"brand":      goods.Brand,
-		"remark":     goods.Remark,
-	})
-	if err != nil {
-		logger.Error(err)
-		return err
-	}
-	logger.Info("mgo insert result:", *insertOneResult)
-
-	// query
-	findOneResult := map[string]interface{}{}
-	err = collection.FindOne(context.Background(), bson.M{
-		"goods_id": goods.GoodsID,
-	}).Decode(&findOneResult)
-	if err != nil {
-		logger.Error(err)
-		return err
-	}
-	logger.Info("mgo query result:", findOneResult)
-
-	// update
-	updateResult, err := collection.UpdateOne(context.Background(), bson.D{{"goods_id", goods.GoodsID}}, bson.D{
-		{"$set", bson.D{{"goods_name", "青龙偃月刀"}, {"brand", "三国"}}},
-	})
-	if err != nil {
-		logger.Error(err)
-		return err
-	}
-	logger.Info("mgo update result:", *updateResult)
-
-	findOneResult = map[string]interface{}{}
-	err = collection.FindOne(context.Background(), bson.M{"goods_id": goods.GoodsID}).Decode(&findOneResult)
-	if err != nil {
-		logger.Error(err)
-		return err
+func (s *Service) AddGoods(ctx context.Context, req *proto.AddGoodsRequest) (*proto.AddGoodsResponse, error) {
+	if req.Name == "" {
+		return nil, errors.New("goods name is null")
 	}
-	logger.Info("mgo query after update result:", findOneResult)
 
-	// delete
-	deleteResult, err := collection.DeleteOne(context.Background(), bson.D{{"goods_id", goods.GoodsID}})
+	sellerID, err := seller.Require(ctx)
 	if err != nil {
-		logger.Error(err)
-		return err
+		return nil, err
 	}
-	logger.Info("mgo delete result:", *deleteResult)
 
-	findOneResult = map[string]interface{}{}
-	err = collection.FindOne(context.Background(), bson.M{"goods_id": goods.GoodsID}).Decode(&findOneResult)
+	existed, err := s.categoryExisted(req.Category)
 	if err != nil {
-		logger.Error(err)
-		return err
+		return nil, err
 	}
-	logger.Info("mgo query after delete result:", findOneResult)
-
-	return nil
-}
-
-func (s *Service) AddGoods(ctx context.Context, req *proto.AddGoodsRequest) (*proto.AddGoodsResponse, error) {
-	if req.Name == "" {
-		return nil, errors.New("goods name is null")
+	if !existed {
+		return &proto.AddGoodsResponse{
+			Code:    common.ErrCategoryNotFound,
+			CodeMsg: "category not found",
+		}, nil
 	}
 
 	goodsID := util.GetUUID()
 
 	goods := &model.GoogsModel{
 		GoodsID:   goodsID,
+		SellerID:  sellerID,
 		GoodsName: req.Name,
 		Price:     req.Price,
 		Category:  req.Category,
@@ -113,14 +48,32 @@ func (s *Service) AddGoods(ctx context.Context, req *proto.AddGoodsRequest) (*pr
 		Brand:     req.Brand,
 	}
 
-	err := s.db.Create(goods).Error
-	if err != nil {
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(goods).Error; err != nil {
 		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := recordPrice(tx, goodsID, "", 0, goods.Price, PriceSourceCreate, ""); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := recordStock(tx, goodsID, "", goods.Stock, stockLedgerEntry{Type: StockLedgerReceive, Reason: "add goods"}); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
 		return nil, err
 	}
 
-	// MongoDB
-	//s.AddGoodsIntoMgo(ctx, goods)
+	s.notifyGoodsChanged(goodsID)
 
 	return &proto.AddGoodsResponse{
 		GoodsID: goodsID,
@@ -132,6 +85,22 @@ func (s *Service) GetGoods(ctx context.Context, req *proto.GetGoodsRequest) (*pr
 		return nil, errors.New("goodsID is null")
 	}
 
+	// 到期的调价先生效,返回当前价格
+	if err := s.applyDuePriceSchedules(req.GoodsID); err != nil {
+		return nil, err
+	}
+
+	// 读模型有延迟,只用于展示;下单、购物车、秒杀等内部调用带fromDB直接读MySQL,读模型里没有时也读MySQL
+	if s.Config.Projection != nil && s.Config.Projection.ReadGoods && !req.FromDB {
+		doc, err := s.getGoodsProjection(ctx, req.GoodsID)
+		if err != nil {
+			return nil, err
+		}
+		if doc != nil {
+			return projectionToGoodsResponse(doc), nil
+		}
+	}
+
 	var goods model.GoogsModel
 	err := s.db.Where("goods_id = ?", req.GoodsID).First(&goods).Error
 	if err != nil {
@@ -141,6 +110,11 @@ func (s *Service) GetGoods(ctx context.Context, req *proto.GetGoodsRequest) (*pr
 
 	logger.Info(goods)
 
+	skuAttrs, err := parseSkuAttrs(goods.SkuAttrs)
+	if err != nil {
+		return nil, err
+	}
+
 	resp := &proto.GetGoodsResponse{
 		GoodsInfo: &proto.GoodsInfo{
 			Name:     goods.GoodsName,
@@ -149,6 +123,20 @@ func (s *Service) GetGoods(ctx context.Context, req *proto.GetGoodsRequest) (*pr
 			Category: goods.Category,
 			Brand:    goods.Brand,
 		},
+		SkuAttrs: skuAttrs,
+		SellerID: goods.SellerID,
+	}
+
+	skus, err := s.getSkus(goods.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sku := range skus {
+		info, err := toSkuInfo(sku)
+		if err != nil {
+			return nil, err
+		}
+		resp.Skus = append(resp.Skus, info)
 	}
 
 	return resp, nil
@@ -173,45 +161,172 @@ func (s *Service) GetGoodsListByCategory(ctx context.Context, req *proto.GetGood
 }
 
 func (s *Service) ModifyGoodsInfo(ctx context.Context, req *proto.ModifyGoodsInfoRequest) (*proto.ModifyGoodsInfoResponse, error) {
-	if req.GoodsID == "" {
-		return nil, errors.New("goodsID is null")
+	if req.GoodsID == "" || req.GoodsInfo == nil {
+		return nil, errors.New("goodsID or goodsInfo is null")
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.ModifyGoodsInfoResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	existed, err := s.categoryExisted(req.GoodsInfo.Category)
+	if err != nil {
+		return nil, err
+	}
+	if !existed {
+		return &proto.ModifyGoodsInfoResponse{
+			Code:    common.ErrCategoryNotFound,
+			CodeMsg: "category not found",
+		}, nil
 	}
 
 	param := map[string]interface{}{
 		"goods_name": req.GoodsInfo.Name,
 		"price":      req.GoodsInfo.Price,
 		"category":   req.GoodsInfo.Category,
-		"stock":      req.GoodsInfo.Stock,
 		"brand":      req.GoodsInfo.Brand,
 	}
 
-	err := s.db.Model(model.GoogsModel{}).Where("goods_id = ?", req.GoodsID).Updates(param).Error
+	// 和SKU的增删改互斥,判断有没有SKU之后不会再加入第一个SKU
+	lock, err := s.lockSku(req.GoodsID)
 	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	// 有SKU的商品库存和价格由SKU汇总
+	hasSkus, err := s.goodsHasSkus(req.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+	if hasSkus {
+		delete(param, "price")
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	// 在行锁内读出库存和价格,下单扣减也要等这行的锁
+	var goods model.GoogsModel
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Select("id, price, stock").Where("goods_id = ?", req.GoodsID).First(&goods).Error; err != nil {
 		logger.Error(err)
+		tx.Rollback()
 		return nil, err
 	}
 
+	if err := tx.Model(model.GoogsModel{}).Where("id = ?", goods.ID).Updates(param).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if !hasSkus {
+		if err := recordPrice(tx, req.GoodsID, "", goods.Price, req.GoodsInfo.Price, PriceSourceManual, ""); err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+
+		// 差值基于行锁内读到的库存,读和改之间不会插入并发的扣减
+		changed, err := changeStock(tx, req.GoodsID, "", req.GoodsInfo.Stock-goods.Stock, stockLedgerEntry{Type: StockLedgerAdjust, Reason: "modify goods info"})
+		if err != nil {
+			tx.Rollback()
+			return nil, err
+		}
+		if !changed {
+			tx.Rollback()
+			return &proto.ModifyGoodsInfoResponse{
+				Code:    common.ErrStockIsNotEnough,
+				CodeMsg: "stock is not enough",
+			}, nil
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	s.notifyGoodsChanged(req.GoodsID)
+	s.afterStockChange(req.GoodsID)
+
 	return &proto.ModifyGoodsInfoResponse{
 		CodeMsg: "modify success",
 	}, nil
 }
 
+const (
+	PurchaseStatusDeducted = 0
+	PurchaseStatusRestored = 1
+)
+
+// 软删除,可以用RestoreGoods恢复
 func (s *Service) DelGoods(ctx context.Context, req *proto.DelGoodsRequest) (*proto.DelGoodsResponse, error) {
 	if req.GoodsID == "" {
 		return nil, errors.New("goodsID is null")
 	}
 
-	err := s.db.Where("goods_id = ?", req.GoodsID).Delete(model.GoogsModel{}).Error
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.DelGoodsResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	err = s.db.Where("goods_id = ?", req.GoodsID).Delete(model.GoogsModel{}).Error
 	if err != nil {
 		logger.Error(err)
 		return nil, err
 	}
+	s.notifyGoodsChanged(req.GoodsID)
 
 	return &proto.DelGoodsResponse{
 		CodeMsg: "delete success",
 	}, nil
 }
 
+func (s *Service) RestoreGoods(ctx context.Context, req *proto.RestoreGoodsRequest) (*proto.RestoreGoodsResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, true)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.RestoreGoodsResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	restored, err := softdelete.Restore(s.db, model.GoogsModel{}, "goods_id = ?", req.GoodsID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !restored {
+		return nil, fmt.Errorf("deleted goods %s not found", req.GoodsID)
+	}
+	s.notifyGoodsChanged(req.GoodsID)
+
+	return &proto.RestoreGoodsResponse{
+		CodeMsg: "restore success",
+	}, nil
+}
+
 func (s *Service) DeductStock(ctx context.Context, req *proto.DeductStockRequest) (*proto.DeductStockResponse, error) {
 	if req.GoodsID == "" || req.OrderID == "" || req.PayID == "" {
 		return nil, errors.New("param can not be null")
@@ -221,6 +336,17 @@ func (s *Service) DeductStock(ctx context.Context, req *proto.DeductStockRequest
 		return nil, errors.New("deduct stock number is 0")
 	}
 
+	code, err := s.checkStockSku(req.GoodsID, req.SkuID)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.DeductStockResponse{
+			Code:    code,
+			CodeMsg: "sku not found or required",
+		}, nil
+	}
+
 	tx := s.db.Begin()
 	if err := tx.Error; err != nil {
 		return nil, err
@@ -245,6 +371,8 @@ func (s *Service) DeductStock(ctx context.Context, req *proto.DeductStockRequest
 			GoodsID: req.GoodsID,
 			OrderID: req.OrderID,
 			PayID:   req.PayID,
+			SkuID:   req.SkuID,
+			Number:  req.Number,
 		}).Error; err != nil {
 			logger.Error(err)
 			tx.Rollback()
@@ -260,16 +388,14 @@ func (s *Service) DeductStock(ctx context.Context, req *proto.DeductStockRequest
 		}
 
 		// 扣库存,能解决超卖问题,但是性能不高,适合并发少的情况
-		result := tx.Exec("UPDATE goods_tb SET stock = stock - ? WHERE goods_id = ? AND stock >= ?", req.Number, req.GoodsID, req.Number)
-		if err := result.Error; err != nil {
+		deducted, err := deductStock(tx, req.GoodsID, req.SkuID, req.Number, stockLedgerEntry{Type: StockLedgerDeduct, RefID: req.PayID})
+		if err != nil {
 			logger.Error(err)
 			tx.Rollback()
 			return nil, err
 		}
 
-		logger.Debug("RowsAffected:", result.RowsAffected)
-
-		if result.RowsAffected == 0 {
+		if !deducted {
 			tx.Rollback()
 			return &proto.DeductStockResponse{
 				Code:    common.ErrStockIsNotEnough,
@@ -280,9 +406,89 @@ func (s *Service) DeductStock(ctx context.Context, req *proto.DeductStockRequest
 		if err := tx.Commit().Error; err != nil {
 			return nil, err
 		}
+		s.notifyGoodsChanged(req.GoodsID)
+		s.afterStockChange(req.GoodsID)
 	}
 
 	return &proto.DeductStockResponse{
 		CodeMsg: "deduct stock success",
 	}, nil
 }
+
+/*
+按支付号归还库存,同一个支付号只会归还一次
+1.goodsID为空时归还这个支付号扣减的所有商品
+2.购买记录状态改为已归还,全部已归还返回ErrRestoreStockRepeat
+*/
+func (s *Service) RestoreStock(ctx context.Context, req *proto.RestoreStockRequest) (*proto.RestoreStockResponse, error) {
+	if req.PayID == "" {
+		return nil, errors.New("payID is null")
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	// 带goodsID时只归还这个商品的这个SKU
+	db := tx.Where("pay_id = ?", req.PayID)
+	if req.GoodsID != "" {
+		db = db.Where("goods_id = ? AND sku_id = ?", req.GoodsID, req.SkuID)
+	}
+
+	var records []*model.PurchaseRecordModel
+	if err := db.Find(&records).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if len(records) == 0 {
+		// 没有扣过库存,不需要归还
+		tx.Rollback()
+		return &proto.RestoreStockResponse{
+			CodeMsg: "no stock to restore",
+		}, nil
+	}
+
+	var restored int
+	for _, record := range records {
+		result := tx.Model(model.PurchaseRecordModel{}).Where("id = ? AND status = ?", record.ID, PurchaseStatusDeducted).Update("status", PurchaseStatusRestored)
+		if err := result.Error; err != nil {
+			logger.Error(err)
+			tx.Rollback()
+			return nil, err
+		}
+
+		if result.RowsAffected == 0 {
+			continue
+		}
+
+		if err := addStock(tx, record.GoodsID, record.SkuID, record.Number, stockLedgerEntry{Type: StockLedgerRestore, RefID: record.PayID}); err != nil {
+			logger.Error(err)
+			tx.Rollback()
+			return nil, err
+		}
+		restored++
+	}
+
+	if restored == 0 {
+		tx.Rollback()
+		return &proto.RestoreStockResponse{
+			Code:    common.ErrRestoreStockRepeat,
+			CodeMsg: "restore stock repeat",
+		}, nil
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		s.notifyGoodsChanged(record.GoodsID)
+		s.afterStockChange(record.GoodsID)
+	}
+
+	return &proto.RestoreStockResponse{
+		CodeMsg: "restore stock success",
+	}, nil
+}
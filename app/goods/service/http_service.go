@@ -9,8 +9,10 @@ import (
 	"reflect"
 
 	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/idempotency"
 	"github.com/harveywangdao/ants/logger"
 	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/harveywangdao/ants/seller"
 )
 
 type HandlerServiceFunc func([]byte) ([]byte, error)
@@ -25,6 +27,7 @@ func (h *HttpServer) StartHttpServer(httpService *HttpService) {
 
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
+	router.Use(idempotency.GinMiddleware(httpService.ServiceApp.Idempotency))
 
 	router.POST("/ants/v1/"+h.ServiceName+"/:funcName", func(c *gin.Context) {
 		funcName := c.Param("funcName")
@@ -63,8 +66,12 @@ func (h *HttpServer) StartHttpServer(httpService *HttpService) {
 			return
 		}
 
-		params := make([]reflect.Value, 1)
-		params[0] = reflect.ValueOf(body)
+		// 商家接口从metadata取商家ID
+		ctx := seller.NewIncomingContext(c.Request.Context(), c.GetHeader(seller.HeaderKey))
+
+		params := make([]reflect.Value, 2)
+		params[0] = reflect.ValueOf(ctx)
+		params[1] = reflect.ValueOf(body)
 		resp := elem.MethodByName(funcName).Call(params)
 
 		if len(resp) != 2 {
@@ -96,7 +103,7 @@ type HttpService struct {
 	ServiceApp *Service
 }
 
-func (h *HttpService) AddGoods(reqData []byte) (interface{}, error) {
+func (h *HttpService) AddGoods(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.AddGoodsRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -104,10 +111,10 @@ func (h *HttpService) AddGoods(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.AddGoods(context.Background(), req)
+	return h.ServiceApp.AddGoods(ctx, req)
 }
 
-func (h *HttpService) GetGoods(reqData []byte) (interface{}, error) {
+func (h *HttpService) GetGoods(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.GetGoodsRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -115,10 +122,10 @@ func (h *HttpService) GetGoods(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.GetGoods(context.Background(), req)
+	return h.ServiceApp.GetGoods(ctx, req)
 }
 
-func (h *HttpService) GetGoodsListByCategory(reqData []byte) (interface{}, error) {
+func (h *HttpService) GetGoodsListByCategory(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.GetGoodsListByCategoryRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -126,10 +133,21 @@ func (h *HttpService) GetGoodsListByCategory(reqData []byte) (interface{}, error
 		return nil, err
 	}
 
-	return h.ServiceApp.GetGoodsListByCategory(context.Background(), req)
+	return h.ServiceApp.GetGoodsListByCategory(ctx, req)
 }
 
-func (h *HttpService) ModifyGoodsInfo(reqData []byte) (interface{}, error) {
+func (h *HttpService) SearchGoods(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.SearchGoodsRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.SearchGoods(ctx, req)
+}
+
+func (h *HttpService) ModifyGoodsInfo(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.ModifyGoodsInfoRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -137,10 +155,10 @@ func (h *HttpService) ModifyGoodsInfo(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.ModifyGoodsInfo(context.Background(), req)
+	return h.ServiceApp.ModifyGoodsInfo(ctx, req)
 }
 
-func (h *HttpService) DelGoods(reqData []byte) (interface{}, error) {
+func (h *HttpService) DelGoods(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.DelGoodsRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -148,10 +166,21 @@ func (h *HttpService) DelGoods(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.DelGoods(context.Background(), req)
+	return h.ServiceApp.DelGoods(ctx, req)
 }
 
-func (h *HttpService) DeductStock(reqData []byte) (interface{}, error) {
+func (h *HttpService) RestoreGoods(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.RestoreGoodsRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RestoreGoods(ctx, req)
+}
+
+func (h *HttpService) DeductStock(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.DeductStockRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -159,5 +188,291 @@ func (h *HttpService) DeductStock(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.DeductStock(context.Background(), req)
+	return h.ServiceApp.DeductStock(ctx, req)
+}
+
+func (h *HttpService) RestoreStock(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.RestoreStockRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RestoreStock(ctx, req)
+}
+
+func (h *HttpService) ReserveStock(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ReserveStockRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ReserveStock(ctx, req)
+}
+
+func (h *HttpService) ReserveStocks(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ReserveStocksRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ReserveStocks(ctx, req)
+}
+
+func (h *HttpService) ConfirmReservation(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ConfirmReservationRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ConfirmReservation(ctx, req)
+}
+
+func (h *HttpService) ReleaseReservation(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ReleaseReservationRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ReleaseReservation(ctx, req)
+}
+
+func (h *HttpService) AddCategory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.AddCategoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.AddCategory(ctx, req)
+}
+
+func (h *HttpService) GetCategory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetCategoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetCategory(ctx, req)
+}
+
+func (h *HttpService) ListCategories(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ListCategoriesRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListCategories(ctx, req)
+}
+
+func (h *HttpService) GetCategoryTree(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetCategoryTreeRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetCategoryTree(ctx, req)
+}
+
+func (h *HttpService) ModifyCategory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ModifyCategoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ModifyCategory(ctx, req)
+}
+
+func (h *HttpService) MoveCategory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.MoveCategoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.MoveCategory(ctx, req)
+}
+
+func (h *HttpService) DelCategory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.DelCategoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.DelCategory(ctx, req)
+}
+
+func (h *HttpService) ListGoodsByCategoryTree(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ListGoodsByCategoryTreeRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListGoodsByCategoryTree(ctx, req)
+}
+
+func (h *HttpService) SetSkuAttrs(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.SetSkuAttrsRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.SetSkuAttrs(ctx, req)
+}
+
+func (h *HttpService) AddSku(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.AddSkuRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.AddSku(ctx, req)
+}
+
+func (h *HttpService) GetSku(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetSkuRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetSku(ctx, req)
+}
+
+func (h *HttpService) ListSkus(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ListSkusRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListSkus(ctx, req)
+}
+
+func (h *HttpService) ModifySku(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ModifySkuRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ModifySku(ctx, req)
+}
+
+func (h *HttpService) DelSku(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.DelSkuRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.DelSku(ctx, req)
+}
+
+func (h *HttpService) ListPriceSchedules(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ListPriceSchedulesRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListPriceSchedules(ctx, req)
+}
+
+func (h *HttpService) GetPriceAt(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetPriceAtRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetPriceAt(ctx, req)
+}
+
+func (h *HttpService) GetPriceHistory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetPriceHistoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetPriceHistory(ctx, req)
+}
+
+func (h *HttpService) ListStockLedger(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ListStockLedgerRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListStockLedger(ctx, req)
+}
+
+func (h *HttpService) ReconcileStock(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ReconcileStockRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ReconcileStock(ctx, req)
+}
+
+func (h *HttpService) SubscribeRestock(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.SubscribeRestockRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.SubscribeRestock(ctx, req)
+}
+
+func (h *HttpService) UnsubscribeRestock(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.UnsubscribeRestockRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.UnsubscribeRestock(ctx, req)
 }
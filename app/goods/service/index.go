@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/indexer"
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+商品索引同步
+1.商品写入成功后通知GoodsSyncer,后台按goodsID读取最新数据写入elasticsearch
+2.启动参数-reindex全量重建索引,重建完成后切换别名,search.goodsIndex配置的是别名
+*/
+
+const (
+	GoodsIndexMapping = `{
+	"mappings": {
+		"_doc": {
+			"properties": {
+				"id":         {"type": "long"},
+				"goods_id":   {"type": "keyword"},
+				"seller_id":  {"type": "keyword"},
+				"goods_name": {"type": "text"},
+				"price":      {"type": "double"},
+				"category":   {"type": "integer"},
+				"stock":      {"type": "integer"},
+				"brand":      {"type": "keyword"}
+			}
+		}
+	}
+}`
+
+	GoodsScanBatchSize = 500
+)
+
+func toGoodsDocument(goods *model.GoogsModel) *goodsDocument {
+	return &goodsDocument{
+		ID:        goods.ID,
+		GoodsID:   goods.GoodsID,
+		SellerID:  goods.SellerID,
+		GoodsName: goods.GoodsName,
+		Price:     goods.Price,
+		Category:  goods.Category,
+		Stock:     goods.Stock,
+		Brand:     goods.Brand,
+	}
+}
+
+// 商品不存在或已删除时返回nil,索引中删除
+func (s *Service) loadGoodsDocument(goodsID string) (interface{}, error) {
+	var goods model.GoogsModel
+	if err := s.db.Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	return toGoodsDocument(&goods), nil
+}
+
+// 全量扫描按id分批,增量扫描包括已删除的商品
+func (s *Service) scanGoods(ctx context.Context, since time.Time, put indexer.PutFunc) error {
+	var lastID int64
+	for {
+		query := s.db
+		if since.IsZero() {
+			query = query.Where("id > ?", lastID)
+		} else {
+			query = query.Unscoped().Where("id > ? AND update_time >= ?", lastID, since)
+		}
+
+		var goodsList []*model.GoogsModel
+		if err := query.Order("id asc").Limit(GoodsScanBatchSize).Find(&goodsList).Error; err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		for _, goods := range goodsList {
+			var err error
+			if goods.IsDelete != 0 {
+				err = put(goods.GoodsID, nil)
+			} else {
+				err = put(goods.GoodsID, toGoodsDocument(goods))
+			}
+			if err != nil {
+				return err
+			}
+		}
+
+		if len(goodsList) < GoodsScanBatchSize {
+			return nil
+		}
+		lastID = goodsList[len(goodsList)-1].ID
+
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Service) initIndexer() error {
+	config := s.Config.Search
+	if config == nil || !config.SyncIndex {
+		return nil
+	}
+
+	idx, err := indexer.NewIndexer(config.ElasticURL)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := idx.EnsureIndex(context.Background(), config.GoodsIndex, GoodsIndexMapping); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	s.Indexer = idx
+	s.GoodsSyncer = indexer.NewSyncer(idx, config.GoodsIndex, s.loadGoodsDocument)
+	s.GoodsSyncer.Start()
+
+	return nil
+}
+
+// 全量重建商品索引
+func StartReindex() error {
+	config, err := getConfig()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	App.Config = config
+
+	if App.Config.Search == nil {
+		return errors.New("search config is null")
+	}
+
+	if err := App.initDB(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	idx, err := indexer.NewIndexer(App.Config.Search.ElasticURL)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := idx.Reindex(context.Background(), App.Config.Search.GoodsIndex, GoodsIndexMapping, App.scanGoods); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	logger.Info("reindex", App.Config.Search.GoodsIndex, "success")
+	return nil
+}
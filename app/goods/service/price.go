@@ -0,0 +1,505 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/harveywangdao/ants/util"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	PriceSourceManual   = 0
+	PriceSourceSchedule = 1
+	PriceSourceSku      = 2
+	PriceSourceCreate   = 3
+
+	PriceScheduleStatusPending   = 0
+	PriceScheduleStatusApplied   = 1
+	PriceScheduleStatusCancelled = 2
+
+	PriceScheduleSweepBatchSize       = 100
+	PriceScheduleDefaultSweepInterval = 10 * time.Second
+
+	PriceHistoryDefaultLimit = 100
+	PriceHistoryMaxLimit     = 1000
+)
+
+/*
+商品价格历史和定时调价
+1.商品和SKU的价格每次变化都在price_history_tb记一条,sku_id为空是商品价格,有SKU的商品价格是SKU最低价
+2.定时调价到了生效时间由后台扫描生效,查询商品和价格时也会先让到期的调价生效,不用等扫描
+3.GetPriceAt按生效时间查历史价格,下单时用下单时刻生效的价格
+4.历史表上线前的商品没有记录,按当前价格返回
+*/
+
+// 价格没有变化时不记录
+func recordPrice(tx *gorm.DB, goodsID, skuID string, oldPrice, price float64, source uint8, scheduleID string) error {
+	if oldPrice == price && source != PriceSourceCreate {
+		return nil
+	}
+
+	if err := tx.Create(&model.PriceHistoryModel{
+		GoodsID:       goodsID,
+		SkuID:         skuID,
+		Price:         price,
+		OldPrice:      oldPrice,
+		EffectiveTime: time.Now().Unix(),
+		Source:        source,
+		ScheduleID:    scheduleID,
+	}).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func toPriceSchedule(schedule *model.PriceScheduleModel) *proto.PriceSchedule {
+	return &proto.PriceSchedule{
+		ScheduleID:    schedule.ScheduleID,
+		GoodsID:       schedule.GoodsID,
+		SkuID:         schedule.SkuID,
+		Price:         schedule.Price,
+		EffectiveTime: schedule.EffectiveTime,
+		Status:        uint32(schedule.Status),
+	}
+}
+
+// 有SKU的商品只能按SKU调价,没有SKU的商品skuID必须为空
+func (s *Service) checkPriceTarget(goodsID, skuID string) (uint32, error) {
+	hasSkus, err := s.goodsHasSkus(goodsID)
+	if err != nil {
+		return 0, err
+	}
+
+	if skuID == "" {
+		if hasSkus {
+			return common.ErrSkuRequired, nil
+		}
+		return 0, nil
+	}
+
+	var count int64
+	if err := s.db.Model(model.SkuModel{}).Where("sku_id = ? AND goods_id = ?", skuID, goodsID).Count(&count).Error; err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+	if count == 0 {
+		return common.ErrSkuNotFound, nil
+	}
+	return 0, nil
+}
+
+func (s *Service) SchedulePriceChange(ctx context.Context, req *proto.SchedulePriceChangeRequest) (*proto.SchedulePriceChangeResponse, error) {
+	if req.GoodsID == "" || req.Price < 0 {
+		return nil, errors.New("param error")
+	}
+
+	if req.EffectiveTime <= time.Now().Unix() {
+		return &proto.SchedulePriceChangeResponse{
+			Code:    common.ErrPriceScheduleTime,
+			CodeMsg: "effective time must be in the future",
+		}, nil
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.SchedulePriceChangeResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	code, err = s.checkPriceTarget(req.GoodsID, req.SkuID)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.SchedulePriceChangeResponse{
+			Code:    code,
+			CodeMsg: "sku not found or required",
+		}, nil
+	}
+
+	schedule := &model.PriceScheduleModel{
+		ScheduleID:    util.GetUUID(),
+		GoodsID:       req.GoodsID,
+		SkuID:         req.SkuID,
+		Price:         req.Price,
+		EffectiveTime: req.EffectiveTime,
+		Status:        PriceScheduleStatusPending,
+	}
+	if err := s.db.Create(schedule).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.SchedulePriceChangeResponse{
+		ScheduleID: schedule.ScheduleID,
+	}, nil
+}
+
+// 只能取消还没生效的调价
+func (s *Service) CancelPriceChange(ctx context.Context, req *proto.CancelPriceChangeRequest) (*proto.CancelPriceChangeResponse, error) {
+	if req.ScheduleID == "" {
+		return nil, errors.New("scheduleID is null")
+	}
+
+	var schedule model.PriceScheduleModel
+	if err := s.db.Where("schedule_id = ?", req.ScheduleID).First(&schedule).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.CancelPriceChangeResponse{
+				Code:    common.ErrPriceScheduleNotFound,
+				CodeMsg: "price schedule not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	code, err := s.checkGoodsOwner(ctx, schedule.GoodsID, true)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.CancelPriceChangeResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	db := s.db.Model(model.PriceScheduleModel{}).Where("id = ? AND status = ?", schedule.ID, PriceScheduleStatusPending).
+		Update("status", PriceScheduleStatusCancelled)
+	if err := db.Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return &proto.CancelPriceChangeResponse{
+			Code:    common.ErrPriceScheduleStatus,
+			CodeMsg: "price schedule already applied or cancelled",
+		}, nil
+	}
+
+	return &proto.CancelPriceChangeResponse{
+		CodeMsg: "cancel success",
+	}, nil
+}
+
+func (s *Service) ListPriceSchedules(ctx context.Context, req *proto.ListPriceSchedulesRequest) (*proto.ListPriceSchedulesResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	query := s.db.Where("goods_id = ?", req.GoodsID)
+	if len(req.Statuses) != 0 {
+		query = query.Where("status IN (?)", req.Statuses)
+	}
+
+	var schedules []*model.PriceScheduleModel
+	if err := query.Order("effective_time asc, id asc").Find(&schedules).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.ListPriceSchedulesResponse{}
+	for _, schedule := range schedules {
+		resp.Schedules = append(resp.Schedules, toPriceSchedule(schedule))
+	}
+	return resp, nil
+}
+
+// timestamp为0时查当前价格
+func (s *Service) GetPriceAt(ctx context.Context, req *proto.GetPriceAtRequest) (*proto.GetPriceAtResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	now := time.Now().Unix()
+	timestamp := req.Timestamp
+	if timestamp == 0 {
+		timestamp = now
+	}
+
+	if timestamp >= now {
+		if err := s.applyDuePriceSchedules(req.GoodsID); err != nil {
+			return nil, err
+		}
+	}
+
+	var history model.PriceHistoryModel
+	err := s.db.Where("goods_id = ? AND sku_id = ? AND effective_time <= ?", req.GoodsID, req.SkuID, timestamp).
+		Order("effective_time desc, id desc").First(&history).Error
+	if err == nil {
+		return &proto.GetPriceAtResponse{
+			Price:         history.Price,
+			EffectiveTime: history.EffectiveTime,
+		}, nil
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 早于第一条记录,用第一条记录修改前的价格
+	err = s.db.Where("goods_id = ? AND sku_id = ? AND effective_time > ?", req.GoodsID, req.SkuID, timestamp).
+		Order("effective_time asc, id asc").First(&history).Error
+	if err == nil {
+		if history.Source == PriceSourceCreate {
+			return &proto.GetPriceAtResponse{
+				Code:    common.ErrPriceNotFound,
+				CodeMsg: "goods not created yet",
+			}, nil
+		}
+		return &proto.GetPriceAtResponse{
+			Price: history.OldPrice,
+		}, nil
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 没有历史记录,用当前价格
+	var price float64
+	if req.SkuID == "" {
+		var goods model.GoogsModel
+		err = s.db.Select("price").Where("goods_id = ?", req.GoodsID).First(&goods).Error
+		price = goods.Price
+	} else {
+		var sku model.SkuModel
+		err = s.db.Select("price").Where("sku_id = ? AND goods_id = ?", req.SkuID, req.GoodsID).First(&sku).Error
+		price = sku.Price
+	}
+	if err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.GetPriceAtResponse{
+				Code:    common.ErrPriceNotFound,
+				CodeMsg: "price not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.GetPriceAtResponse{
+		Price: price,
+	}, nil
+}
+
+func (s *Service) GetPriceHistory(ctx context.Context, req *proto.GetPriceHistoryRequest) (*proto.GetPriceHistoryResponse, error) {
+	if req.GoodsID == "" || req.Limit < 0 {
+		return nil, errors.New("param error")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = PriceHistoryDefaultLimit
+	}
+	if limit > PriceHistoryMaxLimit {
+		limit = PriceHistoryMaxLimit
+	}
+
+	query := s.db.Where("goods_id = ? AND sku_id = ?", req.GoodsID, req.SkuID)
+	if req.StartTime != 0 {
+		query = query.Where("effective_time >= ?", req.StartTime)
+	}
+	if req.EndTime != 0 {
+		query = query.Where("effective_time < ?", req.EndTime)
+	}
+
+	var historyList []*model.PriceHistoryModel
+	if err := query.Order("effective_time desc, id desc").Limit(limit).Find(&historyList).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.GetPriceHistoryResponse{}
+	for _, history := range historyList {
+		resp.Records = append(resp.Records, &proto.PriceRecord{
+			GoodsID:       history.GoodsID,
+			SkuID:         history.SkuID,
+			Price:         history.Price,
+			OldPrice:      history.OldPrice,
+			EffectiveTime: history.EffectiveTime,
+			Source:        uint32(history.Source),
+			ScheduleID:    history.ScheduleID,
+		})
+	}
+	return resp, nil
+}
+
+// 商品下所有到期的调价按生效时间先后生效
+func (s *Service) applyDuePriceSchedules(goodsID string) error {
+	var schedules []*model.PriceScheduleModel
+	err := s.db.Where("goods_id = ? AND status = ? AND effective_time <= ?", goodsID, PriceScheduleStatusPending, time.Now().Unix()).
+		Order("effective_time asc, id asc").Find(&schedules).Error
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	for _, schedule := range schedules {
+		if _, err := s.applyPriceSchedule(schedule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+/*
+调价生效
+1.状态从待生效改为已生效,改成功的才修改价格,多个实例同时扫描只有一个生效
+2.商品或SKU已删除,或者商品调价后又加了SKU,调价取消
+*/
+func (s *Service) applyPriceSchedule(schedule *model.PriceScheduleModel) (bool, error) {
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return false, err
+	}
+
+	db := tx.Model(model.PriceScheduleModel{}).Where("id = ? AND status = ?", schedule.ID, PriceScheduleStatusPending).
+		Update("status", PriceScheduleStatusApplied)
+	if err := db.Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return false, err
+	}
+	if db.RowsAffected == 0 {
+		tx.Rollback()
+		return false, nil
+	}
+
+	var applied bool
+	var err error
+	if schedule.SkuID == "" {
+		applied, err = applyGoodsPrice(tx, schedule)
+	} else {
+		applied, err = applySkuPrice(tx, schedule)
+	}
+	if err != nil {
+		tx.Rollback()
+		return false, err
+	}
+
+	if !applied {
+		if err := tx.Model(model.PriceScheduleModel{}).Where("id = ?", schedule.ID).
+			Update("status", PriceScheduleStatusCancelled).Error; err != nil {
+			logger.Error(err)
+			tx.Rollback()
+			return false, err
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+
+	if applied {
+		s.notifyGoodsChanged(schedule.GoodsID)
+	}
+	return applied, nil
+}
+
+func applyGoodsPrice(tx *gorm.DB, schedule *model.PriceScheduleModel) (bool, error) {
+	var goods model.GoogsModel
+	if err := tx.Where("goods_id = ?", schedule.GoodsID).First(&goods).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		logger.Error(err)
+		return false, err
+	}
+
+	var count int64
+	if err := tx.Model(model.SkuModel{}).Where("goods_id = ?", schedule.GoodsID).Count(&count).Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+	if count != 0 {
+		return false, nil
+	}
+
+	if err := tx.Model(model.GoogsModel{}).Where("id = ?", goods.ID).Update("price", schedule.Price).Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+
+	if err := recordPrice(tx, schedule.GoodsID, "", goods.Price, schedule.Price, PriceSourceSchedule, schedule.ScheduleID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func applySkuPrice(tx *gorm.DB, schedule *model.PriceScheduleModel) (bool, error) {
+	var sku model.SkuModel
+	if err := tx.Where("sku_id = ? AND goods_id = ?", schedule.SkuID, schedule.GoodsID).First(&sku).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return false, nil
+		}
+		logger.Error(err)
+		return false, err
+	}
+
+	if err := tx.Model(model.SkuModel{}).Where("id = ?", sku.ID).Update("price", schedule.Price).Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+
+	if err := recordPrice(tx, schedule.GoodsID, schedule.SkuID, sku.Price, schedule.Price, PriceSourceSchedule, schedule.ScheduleID); err != nil {
+		return false, err
+	}
+
+	if err := syncGoodsFromSkus(tx, schedule.GoodsID); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 定时让到期的调价生效
+func PriceScheduleStartSweep(s *Service) {
+	go func() {
+		interval := time.Duration(priceScheduleSweepInterval(s.Config)) * time.Second
+		if interval <= 0 {
+			interval = PriceScheduleDefaultSweepInterval
+		}
+		ticker := time.NewTicker(interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.sweepDuePriceSchedules()
+			}
+		}
+	}()
+}
+
+func (s *Service) sweepDuePriceSchedules() {
+	var schedules []*model.PriceScheduleModel
+	err := s.db.Where("status = ? AND effective_time <= ?", PriceScheduleStatusPending, time.Now().Unix()).
+		Order("effective_time asc, id asc").Limit(PriceScheduleSweepBatchSize).Find(&schedules).Error
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	for _, schedule := range schedules {
+		applied, err := s.applyPriceSchedule(schedule)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		if applied {
+			logger.Info("apply price schedule", schedule.ScheduleID, schedule.GoodsID, schedule.SkuID, schedule.Price)
+		}
+	}
+}
@@ -0,0 +1,362 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/database/mgo"
+	"github.com/harveywangdao/ants/indexer"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/jinzhu/gorm"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+/*
+商品读模型(CQRS)
+1.写入仍然只写MySQL,写成功后通知ProjectionSyncer,后台按goodsID读取商品、SKU、分类,投影成一个文档写入MongoDB
+2.projection.readGoods为true时GetGoods先读MongoDB,读不到再读MySQL;search.backend为mongo时搜索也读MongoDB
+3.读模型有延迟,扣库存、预占等写操作的判断以MySQL为准
+4.启动参数-rebuildProjection全量重建,重建后删除没有被重建写过的文档,即已经删除的商品
+*/
+
+const (
+	ProjectionDefaultCollection = "product_col"
+	ProjectionScanBatchSize     = 500
+)
+
+type projectionSkuAttr struct {
+	Name   string   `bson:"name"`
+	Values []string `bson:"values"`
+}
+
+type projectionSkuAttrValue struct {
+	Name  string `bson:"name"`
+	Value string `bson:"value"`
+}
+
+type projectionSku struct {
+	SkuID string                    `bson:"sku_id"`
+	Attrs []*projectionSkuAttrValue `bson:"attrs"`
+	Price float64                   `bson:"price"`
+	Stock int32                     `bson:"stock"`
+}
+
+type goodsProjection struct {
+	ID                int64                `bson:"id"`
+	GoodsID           string               `bson:"goods_id"`
+	SellerID          string               `bson:"seller_id"`
+	GoodsName         string               `bson:"goods_name"`
+	Price             float64              `bson:"price"`
+	Category          uint32               `bson:"category"`
+	CategoryName      string               `bson:"category_name"`
+	CategoryPath      []uint32             `bson:"category_path"`
+	Stock             int32                `bson:"stock"`
+	Brand             string               `bson:"brand"`
+	SkuAttrs          []*projectionSkuAttr `bson:"sku_attrs"`
+	Skus              []*projectionSku     `bson:"skus"`
+	LowStockThreshold int32                `bson:"low_stock_threshold"`
+	SyncedAt          int64                `bson:"synced_at"`
+}
+
+// 写入MongoDB,alias是集合名,doc为nil时删除
+type projectionWriter struct {
+	client *mongo.Client
+	dbName string
+}
+
+func (p *projectionWriter) repository(collection string) *mgo.Repository {
+	return mgo.NewRepository(p.client, p.dbName, collection)
+}
+
+func (p *projectionWriter) Put(ctx context.Context, alias, id string, doc interface{}) error {
+	filter := mgo.NewFilter().Eq("goods_id", id)
+	if doc == nil {
+		_, err := p.repository(alias).DeleteMany(ctx, filter)
+		return err
+	}
+	return p.repository(alias).Upsert(ctx, filter, doc)
+}
+
+func (p *projectionWriter) ensureIndex(ctx context.Context, collection string) error {
+	return p.repository(collection).EnsureIndexes(ctx, mgo.Index{
+		Keys:   mgo.NewSort().Asc("goods_id"),
+		Unique: true,
+	})
+}
+
+// "/1/5/12/" -> [1 5 12]
+func parseCategoryPath(path string) []uint32 {
+	var ids []uint32
+	for _, v := range strings.Split(strings.Trim(path, "/"), "/") {
+		id, err := strconv.ParseUint(v, 10, 32)
+		if err != nil {
+			continue
+		}
+		ids = append(ids, uint32(id))
+	}
+	return ids
+}
+
+// 商品不存在或已删除时返回nil,读模型中删除
+func (s *Service) loadGoodsProjection(goodsID string) (interface{}, error) {
+	var goods model.GoogsModel
+	if err := s.db.Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	doc := &goodsProjection{
+		ID:                goods.ID,
+		GoodsID:           goods.GoodsID,
+		SellerID:          goods.SellerID,
+		GoodsName:         goods.GoodsName,
+		Price:             goods.Price,
+		Category:          goods.Category,
+		Stock:             goods.Stock,
+		Brand:             goods.Brand,
+		LowStockThreshold: goods.LowStockThreshold,
+		SyncedAt:          time.Now().UnixNano() / int64(time.Millisecond),
+	}
+
+	if goods.Category != 0 {
+		category, err := getCategory(s.db, goods.Category)
+		if err != nil && !gorm.IsRecordNotFoundError(err) {
+			logger.Error(err)
+			return nil, err
+		}
+		if err == nil {
+			doc.CategoryName = category.Name
+			doc.CategoryPath = parseCategoryPath(category.Path)
+		}
+	}
+
+	attrs, err := parseSkuAttrs(goods.SkuAttrs)
+	if err != nil {
+		return nil, err
+	}
+	for _, attr := range attrs {
+		doc.SkuAttrs = append(doc.SkuAttrs, &projectionSkuAttr{
+			Name:   attr.Name,
+			Values: attr.Values,
+		})
+	}
+
+	skus, err := s.getSkus(goodsID)
+	if err != nil {
+		return nil, err
+	}
+	for _, sku := range skus {
+		info, err := toSkuInfo(sku)
+		if err != nil {
+			return nil, err
+		}
+
+		projection := &projectionSku{
+			SkuID: info.SkuID,
+			Price: info.Price,
+			Stock: info.Stock,
+		}
+		for _, v := range info.Attrs {
+			projection.Attrs = append(projection.Attrs, &projectionSkuAttrValue{
+				Name:  v.Name,
+				Value: v.Value,
+			})
+		}
+		doc.Skus = append(doc.Skus, projection)
+	}
+
+	return doc, nil
+}
+
+func projectionCollection(config *Config) string {
+	if config.Projection == nil || config.Projection.Collection == "" {
+		return ProjectionDefaultCollection
+	}
+	return config.Projection.Collection
+}
+
+func (s *Service) initProjection() error {
+	config := s.Config.Projection
+	if config == nil || !config.Sync {
+		return nil
+	}
+
+	writer := &projectionWriter{
+		client: s.Mongo,
+		dbName: s.Config.Mongo.DbName,
+	}
+
+	collection := projectionCollection(s.Config)
+	if err := writer.ensureIndex(context.Background(), collection); err != nil {
+		return err
+	}
+
+	s.ProjectionSyncer = indexer.NewSyncer(writer, collection, s.loadGoodsProjection)
+	s.ProjectionSyncer.Start()
+
+	return nil
+}
+
+// 商品修改后通知搜索索引和读模型,没有开启的syncer为nil
+func (s *Service) notifyGoodsChanged(goodsIDs ...string) {
+	s.GoodsSyncer.Notify(goodsIDs...)
+	s.ProjectionSyncer.Notify(goodsIDs...)
+}
+
+// 分类改名或移动后,读模型里这些分类下商品的分类名称和路径要重新投影
+func (s *Service) notifyCategoryGoods(categoryIDs []uint32) {
+	if s.ProjectionSyncer == nil || len(categoryIDs) == 0 {
+		return
+	}
+
+	var goodsList []*model.GoogsModel
+	if err := s.db.Select("goods_id").Where("category IN (?)", categoryIDs).Find(&goodsList).Error; err != nil {
+		logger.Error(err)
+		return
+	}
+
+	for _, goods := range goodsList {
+		s.ProjectionSyncer.Notify(goods.GoodsID)
+	}
+}
+
+func (s *Service) getGoodsProjection(ctx context.Context, goodsID string) (*goodsProjection, error) {
+	repository := mgo.NewRepository(s.Mongo, s.Config.Mongo.DbName, projectionCollection(s.Config))
+
+	var doc goodsProjection
+	if err := repository.FindOne(ctx, mgo.NewFilter().Eq("goods_id", goodsID), &doc); err != nil {
+		if mgo.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &doc, nil
+}
+
+func projectionToGoodsResponse(doc *goodsProjection) *proto.GetGoodsResponse {
+	resp := &proto.GetGoodsResponse{
+		GoodsInfo: &proto.GoodsInfo{
+			Name:     doc.GoodsName,
+			Price:    doc.Price,
+			Stock:    doc.Stock,
+			Category: doc.Category,
+			Brand:    doc.Brand,
+		},
+		SellerID: doc.SellerID,
+	}
+
+	for _, attr := range doc.SkuAttrs {
+		resp.SkuAttrs = append(resp.SkuAttrs, &proto.SkuAttr{
+			Name:   attr.Name,
+			Values: attr.Values,
+		})
+	}
+
+	for _, sku := range doc.Skus {
+		info := &proto.SkuInfo{
+			SkuID:   sku.SkuID,
+			GoodsID: doc.GoodsID,
+			Price:   sku.Price,
+			Stock:   sku.Stock,
+		}
+		for _, v := range sku.Attrs {
+			info.Attrs = append(info.Attrs, &proto.SkuAttrValue{
+				Name:  v.Name,
+				Value: v.Value,
+			})
+		}
+		resp.Skus = append(resp.Skus, info)
+	}
+
+	return resp
+}
+
+// 全量重建读模型
+func StartRebuildProjection() error {
+	config, err := getConfig()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	App.Config = config
+
+	if App.Config.Mongo == nil {
+		return errors.New("mongo config is null")
+	}
+
+	if err := App.initDB(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	client, err := mgo.NewMgoClient(App.Config.Mongo.Address, App.Config.Mongo.Username, App.Config.Mongo.Password)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	App.Mongo = client
+
+	count, err := App.rebuildProjection(context.Background())
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	logger.Info("rebuild projection", projectionCollection(App.Config), "success, goods:", count)
+	return nil
+}
+
+func (s *Service) rebuildProjection(ctx context.Context) (int64, error) {
+	start := time.Now().UnixNano() / int64(time.Millisecond)
+
+	writer := &projectionWriter{
+		client: s.Mongo,
+		dbName: s.Config.Mongo.DbName,
+	}
+	collection := projectionCollection(s.Config)
+	if err := writer.ensureIndex(ctx, collection); err != nil {
+		return 0, err
+	}
+
+	var count, lastID int64
+	for {
+		var goodsList []*model.GoogsModel
+		if err := s.db.Select("id, goods_id").Where("id > ?", lastID).Order("id asc").Limit(ProjectionScanBatchSize).Find(&goodsList).Error; err != nil {
+			logger.Error(err)
+			return 0, err
+		}
+
+		for _, goods := range goodsList {
+			doc, err := s.loadGoodsProjection(goods.GoodsID)
+			if err != nil {
+				return 0, err
+			}
+			if err := writer.Put(ctx, collection, goods.GoodsID, doc); err != nil {
+				return 0, err
+			}
+			count++
+		}
+
+		if len(goodsList) < ProjectionScanBatchSize {
+			break
+		}
+		lastID = goodsList[len(goodsList)-1].ID
+	}
+
+	// 重建期间在线同步写入的文档synced_at也比start大,不会被删掉
+	deleted, err := writer.repository(collection).DeleteMany(ctx, mgo.NewFilter().Lt("synced_at", start))
+	if err != nil {
+		return 0, err
+	}
+	logger.Info("rebuild projection delete stale", deleted)
+
+	return count, nil
+}
@@ -0,0 +1,417 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/harveywangdao/ants/util"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	ReservationStatusReserved  = 0
+	ReservationStatusConfirmed = 1
+	ReservationStatusReleased  = 2
+
+	ReservationSweepBatchSize       = 100
+	ReservationDefaultSweepInterval = 10 * time.Second
+)
+
+/*
+库存预占
+1.下单时预占,goods_tb.stock直接减掉预占数量,避免未支付订单超卖
+2.支付成功后确认预占,写入购买记录,之后可以按支付号归还库存
+3.订单取消或预占过期后释放,库存加回
+*/
+func (s *Service) ReserveStock(ctx context.Context, req *proto.ReserveStockRequest) (*proto.ReserveStockResponse, error) {
+	if req.GoodsID == "" || req.OrderID == "" {
+		return nil, errors.New("param can not be null")
+	}
+
+	if req.Number == 0 {
+		return nil, errors.New("reserve stock number is 0")
+	}
+
+	code, err := s.checkStockSku(req.GoodsID, req.SkuID)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.ReserveStockResponse{
+			Code:    code,
+			CodeMsg: "sku not found or required",
+		}, nil
+	}
+
+	expireSeconds := req.ExpireSeconds
+	if expireSeconds <= 0 {
+		expireSeconds = s.Config.Reservation.ExpireSeconds
+	}
+
+	reservation := &model.StockReservationModel{
+		ReservationID: util.GetUUID(),
+		GoodsID:       req.GoodsID,
+		OrderID:       req.OrderID,
+		SkuID:         req.SkuID,
+		Number:        req.Number,
+		Status:        ReservationStatusReserved,
+		ExpireTime:    time.Now().Unix() + expireSeconds,
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(reservation).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+
+		if strings.Contains(err.Error(), "Duplicate entry") {
+			var existed model.StockReservationModel
+			if err := s.db.Where("order_id = ? AND goods_id = ? AND sku_id = ?", req.OrderID, req.GoodsID, req.SkuID).First(&existed).Error; err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+
+			return &proto.ReserveStockResponse{
+				Code:          common.ErrReserveStockRepeat,
+				CodeMsg:       "reserve stock repeat",
+				ReservationID: existed.ReservationID,
+				ExpireTime:    existed.ExpireTime,
+			}, nil
+		}
+
+		return nil, err
+	}
+
+	deducted, err := deductStock(tx, req.GoodsID, req.SkuID, req.Number, stockLedgerEntry{Type: StockLedgerReserve, RefID: reservation.ReservationID})
+	if err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if !deducted {
+		tx.Rollback()
+		return &proto.ReserveStockResponse{
+			Code:    common.ErrStockIsNotEnough,
+			CodeMsg: "stock is not enough",
+		}, nil
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	s.notifyGoodsChanged(req.GoodsID)
+	s.afterStockChange(req.GoodsID)
+
+	return &proto.ReserveStockResponse{
+		CodeMsg:       "reserve stock success",
+		ReservationID: reservation.ReservationID,
+		ExpireTime:    reservation.ExpireTime,
+	}, nil
+}
+
+// 一个订单多个商品一起预占,在同一个事务里执行,任一商品库存不足全部回滚
+func (s *Service) ReserveStocks(ctx context.Context, req *proto.ReserveStocksRequest) (*proto.ReserveStocksResponse, error) {
+	if req.OrderID == "" || len(req.Items) == 0 {
+		return nil, errors.New("param can not be null")
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range req.Items {
+		if item.GoodsID == "" || item.Number == 0 {
+			return nil, errors.New("goodsID or number is null")
+		}
+		if seen[item.GoodsID+item.SkuID] {
+			return nil, fmt.Errorf("goods %s sku %s repeated", item.GoodsID, item.SkuID)
+		}
+		seen[item.GoodsID+item.SkuID] = true
+	}
+
+	for _, item := range req.Items {
+		code, err := s.checkStockSku(item.GoodsID, item.SkuID)
+		if err != nil {
+			return nil, err
+		}
+		if code != 0 {
+			return &proto.ReserveStocksResponse{
+				Code:          code,
+				CodeMsg:       fmt.Sprintf("goods %s sku not found or required", item.GoodsID),
+				FailedGoodsID: item.GoodsID,
+				FailedSkuID:   item.SkuID,
+			}, nil
+		}
+	}
+
+	expireSeconds := req.ExpireSeconds
+	if expireSeconds <= 0 {
+		expireSeconds = s.Config.Reservation.ExpireSeconds
+	}
+	expireTime := time.Now().Unix() + expireSeconds
+
+	var existed model.StockReservationModel
+	err := s.db.Where("order_id = ?", req.OrderID).First(&existed).Error
+	if err == nil {
+		return &proto.ReserveStocksResponse{
+			Code:       common.ErrReserveStockRepeat,
+			CodeMsg:    "reserve stock repeat",
+			ExpireTime: existed.ExpireTime,
+		}, nil
+	}
+	if !gorm.IsRecordNotFoundError(err) {
+		logger.Error(err)
+		return nil, err
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	for _, item := range req.Items {
+		reservation := &model.StockReservationModel{
+			ReservationID: util.GetUUID(),
+			GoodsID:       item.GoodsID,
+			OrderID:       req.OrderID,
+			SkuID:         item.SkuID,
+			Number:        item.Number,
+			Status:        ReservationStatusReserved,
+			ExpireTime:    expireTime,
+		}
+
+		if err := tx.Create(reservation).Error; err != nil {
+			logger.Error(err)
+			tx.Rollback()
+
+			// 并发重复预占
+			if strings.Contains(err.Error(), "Duplicate entry") {
+				return &proto.ReserveStocksResponse{
+					Code:    common.ErrReserveStockRepeat,
+					CodeMsg: "reserve stock repeat",
+				}, nil
+			}
+
+			return nil, err
+		}
+
+		deducted, err := deductStock(tx, item.GoodsID, item.SkuID, item.Number, stockLedgerEntry{Type: StockLedgerReserve, RefID: reservation.ReservationID})
+		if err != nil {
+			logger.Error(err)
+			tx.Rollback()
+			return nil, err
+		}
+
+		if !deducted {
+			tx.Rollback()
+			return &proto.ReserveStocksResponse{
+				Code:          common.ErrStockIsNotEnough,
+				CodeMsg:       fmt.Sprintf("goods %s stock is not enough", item.GoodsID),
+				FailedGoodsID: item.GoodsID,
+				FailedSkuID:   item.SkuID,
+			}, nil
+		}
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	for _, item := range req.Items {
+		s.notifyGoodsChanged(item.GoodsID)
+		s.afterStockChange(item.GoodsID)
+	}
+
+	return &proto.ReserveStocksResponse{
+		CodeMsg:    "reserve stocks success",
+		ExpireTime: expireTime,
+	}, nil
+}
+
+func (s *Service) ConfirmReservation(ctx context.Context, req *proto.ConfirmReservationRequest) (*proto.ConfirmReservationResponse, error) {
+	if req.GoodsID == "" || req.OrderID == "" || req.PayID == "" {
+		return nil, errors.New("param can not be null")
+	}
+
+	var reservation model.StockReservationModel
+	if err := s.db.Where("order_id = ? AND goods_id = ? AND sku_id = ?", req.OrderID, req.GoodsID, req.SkuID).First(&reservation).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.ConfirmReservationResponse{
+				Code:    common.ErrReservationNotFound,
+				CodeMsg: "reservation not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	param := map[string]interface{}{
+		"status": ReservationStatusConfirmed,
+		"pay_id": req.PayID,
+	}
+	result := tx.Model(model.StockReservationModel{}).Where("id = ? AND status = ? AND expire_time >= ?",
+		reservation.ID, ReservationStatusReserved, time.Now().Unix()).Updates(param)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+
+		if reservation.Status == ReservationStatusConfirmed && reservation.PayID == req.PayID {
+			return &proto.ConfirmReservationResponse{
+				Code:    common.ErrDeductStockRepeat,
+				CodeMsg: "reservation already confirmed",
+			}, nil
+		}
+
+		return &proto.ConfirmReservationResponse{
+			Code:    common.ErrReservationExpired,
+			CodeMsg: "reservation expired or released",
+		}, nil
+	}
+
+	// 库存在预占时已经扣过,这里只补购买记录
+	if err := tx.Create(&model.PurchaseRecordModel{
+		GoodsID: req.GoodsID,
+		OrderID: req.OrderID,
+		PayID:   req.PayID,
+		SkuID:   reservation.SkuID,
+		Number:  reservation.Number,
+	}).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+
+	return &proto.ConfirmReservationResponse{
+		CodeMsg: "confirm reservation success",
+	}, nil
+}
+
+func (s *Service) ReleaseReservation(ctx context.Context, req *proto.ReleaseReservationRequest) (*proto.ReleaseReservationResponse, error) {
+	if req.GoodsID == "" || req.OrderID == "" {
+		return nil, errors.New("param can not be null")
+	}
+
+	var reservation model.StockReservationModel
+	if err := s.db.Where("order_id = ? AND goods_id = ? AND sku_id = ?", req.OrderID, req.GoodsID, req.SkuID).First(&reservation).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.ReleaseReservationResponse{
+				Code:    common.ErrReservationNotFound,
+				CodeMsg: "reservation not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	released, err := s.releaseReservation(&reservation)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !released {
+		return &proto.ReleaseReservationResponse{
+			CodeMsg: "reservation is not reserved",
+		}, nil
+	}
+
+	return &proto.ReleaseReservationResponse{
+		CodeMsg: "release reservation success",
+	}, nil
+}
+
+// 只有预占中的记录才会释放,多实例并发释放时只有一个能成功
+func (s *Service) releaseReservation(reservation *model.StockReservationModel) (bool, error) {
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return false, err
+	}
+
+	result := tx.Model(model.StockReservationModel{}).Where("id = ? AND status = ?", reservation.ID, ReservationStatusReserved).
+		Update("status", ReservationStatusReleased)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return false, err
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return false, nil
+	}
+
+	if err := addStock(tx, reservation.GoodsID, reservation.SkuID, reservation.Number, stockLedgerEntry{Type: StockLedgerRelease, RefID: reservation.ReservationID}); err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return false, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return false, err
+	}
+	s.notifyGoodsChanged(reservation.GoodsID)
+	s.afterStockChange(reservation.GoodsID)
+
+	return true, nil
+}
+
+// 定时释放过期的预占
+func ReservationStartSweep(s *Service) {
+	go func() {
+		interval := time.Duration(s.Config.Reservation.SweepInterval) * time.Second
+		if interval <= 0 {
+			interval = ReservationDefaultSweepInterval
+		}
+		ticker := time.NewTicker(interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.sweepExpiredReservations()
+			}
+		}
+	}()
+}
+
+func (s *Service) sweepExpiredReservations() {
+	var reservations []*model.StockReservationModel
+	err := s.db.Where("status = ? AND expire_time < ?", ReservationStatusReserved, time.Now().Unix()).
+		Order("expire_time asc").Limit(ReservationSweepBatchSize).Find(&reservations).Error
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	for _, reservation := range reservations {
+		released, err := s.releaseReservation(reservation)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		if released {
+			logger.Info("release expired reservation", reservation.ReservationID, reservation.GoodsID, reservation.OrderID)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+)
+
+/*
+商品搜索
+1.搜索后端可以配置,默认用MySQL FULLTEXT索引,也可以用elasticsearch或MongoDB读模型
+2.关键字匹配商品名称和品牌,可以按分类、品牌、价格区间、是否有货过滤
+3.游标分页,游标由各后端自己编码,调用方原样带回
+4.withFacets为true时返回分类和品牌的数量统计
+*/
+
+const (
+	SearchBackendMysql   = "mysql"
+	SearchBackendElastic = "elastic"
+	SearchBackendMongo   = "mongo"
+
+	SearchSortByNewest = "newest"
+	SearchSortByPrice  = "price"
+	SearchSortByStock  = "stock"
+
+	SearchDefaultNumPerPage = 20
+	SearchMaxNumPerPage     = 100
+	SearchMaxFacetBuckets   = 20
+
+	FacetFieldCategory = "category"
+	FacetFieldBrand    = "brand"
+)
+
+type GoodsSearcher interface {
+	Search(ctx context.Context, req *proto.SearchGoodsRequest) (*proto.SearchGoodsResponse, error)
+}
+
+func (s *Service) initSearcher() error {
+	config := s.Config.Search
+	if config == nil || config.Backend == "" || config.Backend == SearchBackendMysql {
+		s.Searcher = newMysqlGoodsSearcher(s.db)
+		return nil
+	}
+
+	if config.Backend == SearchBackendElastic {
+		searcher, err := newElasticGoodsSearcher(config.ElasticURL, config.GoodsIndex)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+		s.Searcher = searcher
+		return nil
+	}
+
+	if config.Backend == SearchBackendMongo {
+		s.Searcher = newMongoGoodsSearcher(s.Mongo, s.Config.Mongo.DbName, projectionCollection(s.Config))
+		return nil
+	}
+
+	return fmt.Errorf("search backend %s not supported", config.Backend)
+}
+
+func (s *Service) SearchGoods(ctx context.Context, req *proto.SearchGoodsRequest) (*proto.SearchGoodsResponse, error) {
+	if req.NumPerPage < 0 || req.MinPrice < 0 || req.MaxPrice < 0 {
+		return nil, errors.New("param error")
+	}
+
+	if req.MaxPrice > 0 && req.MinPrice > req.MaxPrice {
+		return nil, errors.New("minPrice can not be greater than maxPrice")
+	}
+
+	switch req.SortBy {
+	case "", SearchSortByNewest, SearchSortByPrice, SearchSortByStock:
+	default:
+		return nil, fmt.Errorf("sortBy %s not supported", req.SortBy)
+	}
+
+	if req.NumPerPage == 0 {
+		req.NumPerPage = SearchDefaultNumPerPage
+	}
+	if req.NumPerPage > SearchMaxNumPerPage {
+		req.NumPerPage = SearchMaxNumPerPage
+	}
+
+	resp, err := s.Searcher.Search(ctx, req)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 游标是上一页最后一条的排序值,json后base64编码
+func encodeSearchCursor(v interface{}) (string, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		logger.Error(err)
+		return "", err
+	}
+
+	return base64.URLEncoding.EncodeToString(data), nil
+}
+
+func decodeSearchCursor(cursor string, v interface{}) error {
+	data, err := base64.URLEncoding.DecodeString(cursor)
+	if err != nil {
+		logger.Error(err)
+		return errors.New("cursor is illegal")
+	}
+
+	if err := json.Unmarshal(data, v); err != nil {
+		logger.Error(err)
+		return errors.New("cursor is illegal")
+	}
+
+	return nil
+}
@@ -0,0 +1,179 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/olivere/elastic"
+)
+
+// 索引中的商品文档,字段名和goods_tb一致
+type goodsDocument struct {
+	ID        int64   `json:"id"`
+	GoodsID   string  `json:"goods_id"`
+	SellerID  string  `json:"seller_id"`
+	GoodsName string  `json:"goods_name"`
+	Price     float64 `json:"price"`
+	Category  uint32  `json:"category"`
+	Stock     int32   `json:"stock"`
+	Brand     string  `json:"brand"`
+}
+
+// 用法参考app/elastic,游标用search_after
+type elasticGoodsSearcher struct {
+	client *elastic.Client
+	index  string
+}
+
+func newElasticGoodsSearcher(url, index string) (*elasticGoodsSearcher, error) {
+	if url == "" || index == "" {
+		return nil, errors.New("elasticURL or goodsIndex is null")
+	}
+
+	client, err := elastic.NewClient(elastic.SetURL(url), elastic.SetSniff(false))
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &elasticGoodsSearcher{
+		client: client,
+		index:  index,
+	}, nil
+}
+
+func (e *elasticGoodsSearcher) query(req *proto.SearchGoodsRequest) elastic.Query {
+	boolQ := elastic.NewBoolQuery()
+
+	if req.Keyword != "" {
+		boolQ.Must(elastic.NewMultiMatchQuery(req.Keyword, "goods_name", "brand"))
+	}
+	if len(req.Categories) != 0 {
+		var categories []interface{}
+		for _, c := range req.Categories {
+			categories = append(categories, c)
+		}
+		boolQ.Filter(elastic.NewTermsQuery("category", categories...))
+	}
+	if len(req.Brands) != 0 {
+		var brands []interface{}
+		for _, b := range req.Brands {
+			brands = append(brands, b)
+		}
+		boolQ.Filter(elastic.NewTermsQuery("brand", brands...))
+	}
+	if req.MinPrice > 0 || req.MaxPrice > 0 {
+		priceQ := elastic.NewRangeQuery("price")
+		if req.MinPrice > 0 {
+			priceQ.Gte(req.MinPrice)
+		}
+		if req.MaxPrice > 0 {
+			priceQ.Lte(req.MaxPrice)
+		}
+		boolQ.Filter(priceQ)
+	}
+	if req.InStock {
+		boolQ.Filter(elastic.NewRangeQuery("stock").Gt(0))
+	}
+
+	return boolQ
+}
+
+func (e *elasticGoodsSearcher) Search(ctx context.Context, req *proto.SearchGoodsRequest) (*proto.SearchGoodsResponse, error) {
+	search := e.client.Search(e.index).Query(e.query(req)).Size(int(req.NumPerPage))
+
+	var sorters []elastic.Sorter
+	switch req.SortBy {
+	case SearchSortByPrice:
+		sorters = append(sorters, elastic.NewFieldSort("price").Order(req.Asc))
+	case SearchSortByStock:
+		sorters = append(sorters, elastic.NewFieldSort("stock").Order(req.Asc))
+	}
+	sorters = append(sorters, elastic.NewFieldSort("id").Order(req.Asc))
+	search = search.SortBy(sorters...)
+
+	if req.Cursor != "" {
+		var searchAfter []interface{}
+		if err := decodeSearchCursor(req.Cursor, &searchAfter); err != nil {
+			return nil, err
+		}
+		search = search.SearchAfter(searchAfter...)
+	}
+
+	if req.WithFacets {
+		search = search.Aggregation(FacetFieldCategory, elastic.NewTermsAggregation().Field("category").Size(SearchMaxFacetBuckets))
+		search = search.Aggregation(FacetFieldBrand, elastic.NewTermsAggregation().Field("brand").Size(SearchMaxFacetBuckets))
+	}
+
+	res, err := search.Do(ctx)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.SearchGoodsResponse{
+		Total: res.Hits.TotalHits,
+	}
+
+	var lastSort []interface{}
+	for _, hit := range res.Hits.Hits {
+		if hit.Source == nil {
+			continue
+		}
+
+		var doc goodsDocument
+		if err := json.Unmarshal(*hit.Source, &doc); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		resp.Goods = append(resp.Goods, &proto.GoodsSummary{
+			GoodsID: doc.GoodsID,
+			GoodsInfo: &proto.GoodsInfo{
+				Name:     doc.GoodsName,
+				Price:    doc.Price,
+				Stock:    doc.Stock,
+				Category: doc.Category,
+				Brand:    doc.Brand,
+			},
+		})
+		lastSort = hit.Sort
+	}
+
+	if int64(len(res.Hits.Hits)) == req.NumPerPage && lastSort != nil {
+		nextCursor, err := encodeSearchCursor(lastSort)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextCursor = nextCursor
+	}
+
+	if req.WithFacets {
+		for _, field := range []string{FacetFieldCategory, FacetFieldBrand} {
+			facet := &proto.Facet{Field: field}
+
+			items, ok := res.Aggregations.Terms(field)
+			if ok {
+				for _, bucket := range items.Buckets {
+					// 数字类型的key用原始文本,避免float64格式化成科学计数法
+					value := fmt.Sprint(bucket.Key)
+					if bucket.KeyNumber != "" {
+						value = bucket.KeyNumber.String()
+					}
+
+					facet.Buckets = append(facet.Buckets, &proto.FacetBucket{
+						Value: value,
+						Count: bucket.DocCount,
+					})
+				}
+			}
+			resp.Facets = append(resp.Facets, facet)
+		}
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,180 @@
+package service
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/harveywangdao/ants/database/mgo"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// 查询读模型集合,游标和mysql后端一样
+type mongoGoodsSearcher struct {
+	repository *mgo.Repository
+}
+
+func newMongoGoodsSearcher(client *mongo.Client, dbName, collection string) *mongoGoodsSearcher {
+	return &mongoGoodsSearcher{
+		repository: mgo.NewRepository(client, dbName, collection),
+	}
+}
+
+func (m *mongoGoodsSearcher) filter(req *proto.SearchGoodsRequest) *mgo.Filter {
+	filter := mgo.NewFilter()
+
+	if req.Keyword != "" {
+		filter.Or(
+			mgo.NewFilter().Contains("goods_name", req.Keyword),
+			mgo.NewFilter().Contains("brand", req.Keyword),
+		)
+	}
+	if len(req.Categories) != 0 {
+		filter.In("category", req.Categories)
+	}
+	if len(req.Brands) != 0 {
+		filter.In("brand", req.Brands)
+	}
+
+	var min, max interface{}
+	if req.MinPrice > 0 {
+		min = req.MinPrice
+	}
+	if req.MaxPrice > 0 {
+		max = req.MaxPrice
+	}
+	filter.Between("price", min, max)
+
+	if req.InStock {
+		filter.Gt("stock", 0)
+	}
+
+	return filter
+}
+
+func projectionSortValue(doc *goodsProjection, sortBy string) float64 {
+	switch sortBy {
+	case SearchSortByPrice:
+		return doc.Price
+	case SearchSortByStock:
+		return float64(doc.Stock)
+	}
+	return 0
+}
+
+func (m *mongoGoodsSearcher) Search(ctx context.Context, req *proto.SearchGoodsRequest) (*proto.SearchGoodsResponse, error) {
+	resp := &proto.SearchGoodsResponse{}
+
+	total, err := m.repository.Count(ctx, m.filter(req))
+	if err != nil {
+		return nil, err
+	}
+	resp.Total = total
+
+	if req.WithFacets {
+		facets, err := m.facets(ctx, req)
+		if err != nil {
+			return nil, err
+		}
+		resp.Facets = facets
+	}
+
+	filter := m.filter(req)
+
+	var cursor mysqlSearchCursor
+	if req.Cursor != "" {
+		if err := decodeSearchCursor(req.Cursor, &cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	after := func(f *mgo.Filter, key string, value interface{}) *mgo.Filter {
+		if req.Asc {
+			return f.Gt(key, value)
+		}
+		return f.Lt(key, value)
+	}
+
+	field := ""
+	switch req.SortBy {
+	case SearchSortByPrice:
+		field = "price"
+	case SearchSortByStock:
+		field = "stock"
+	}
+
+	sort := mgo.NewSort()
+	if field != "" {
+		if req.Cursor != "" {
+			filter.And(mgo.NewFilter().Or(
+				after(mgo.NewFilter(), field, cursor.Value),
+				after(mgo.NewFilter().Eq(field, cursor.Value), "id", cursor.ID),
+			))
+		}
+		sort.By(field, req.Asc)
+	} else if req.Cursor != "" {
+		filter.And(after(mgo.NewFilter(), "id", cursor.ID))
+	}
+	sort.By("id", req.Asc)
+
+	var docs []*goodsProjection
+	if err := m.repository.Find(ctx, filter, sort, req.NumPerPage, &docs); err != nil {
+		return nil, err
+	}
+
+	for _, doc := range docs {
+		resp.Goods = append(resp.Goods, &proto.GoodsSummary{
+			GoodsID: doc.GoodsID,
+			GoodsInfo: &proto.GoodsInfo{
+				Name:     doc.GoodsName,
+				Price:    doc.Price,
+				Stock:    doc.Stock,
+				Category: doc.Category,
+				Brand:    doc.Brand,
+			},
+		})
+	}
+
+	if int64(len(docs)) == req.NumPerPage {
+		last := docs[len(docs)-1]
+		nextCursor, err := encodeSearchCursor(&mysqlSearchCursor{Value: projectionSortValue(last, req.SortBy), ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+		resp.NextCursor = nextCursor
+	}
+
+	return resp, nil
+}
+
+func (m *mongoGoodsSearcher) facets(ctx context.Context, req *proto.SearchGoodsRequest) ([]*proto.Facet, error) {
+	var facets []*proto.Facet
+	for _, field := range []string{FacetFieldCategory, FacetFieldBrand} {
+		pipeline := bson.A{
+			bson.M{"$match": m.filter(req).Bson()},
+			bson.M{"$group": bson.M{"_id": "$" + field, "count": bson.M{"$sum": 1}}},
+			bson.M{"$sort": bson.D{{Key: "count", Value: -1}, {Key: "_id", Value: 1}}},
+			bson.M{"$limit": SearchMaxFacetBuckets},
+		}
+
+		var counts []struct {
+			Value interface{} `bson:"_id"`
+			Count int64       `bson:"count"`
+		}
+		if err := m.repository.Aggregate(ctx, pipeline, &counts); err != nil {
+			return nil, err
+		}
+
+		facet := &proto.Facet{Field: field}
+		for _, c := range counts {
+			facet.Buckets = append(facet.Buckets, &proto.FacetBucket{
+				Value: fmt.Sprint(c.Value),
+				Count: c.Count,
+			})
+		}
+		facets = append(facets, facet)
+	}
+
+	return facets, nil
+}
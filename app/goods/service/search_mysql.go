@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/jinzhu/gorm"
+)
+
+// 关键字用goods_tb的FULLTEXT索引ft_goods_name_brand
+type mysqlGoodsSearcher struct {
+	db *gorm.DB
+}
+
+type mysqlSearchCursor struct {
+	Value float64 `json:"value"`
+	ID    int64   `json:"id"`
+}
+
+type facetCount struct {
+	Value string `gorm:"column:value"`
+	Count int64  `gorm:"column:count"`
+}
+
+func newMysqlGoodsSearcher(db *gorm.DB) *mysqlGoodsSearcher {
+	return &mysqlGoodsSearcher{
+		db: db,
+	}
+}
+
+func (m *mysqlGoodsSearcher) filter(req *proto.SearchGoodsRequest) *gorm.DB {
+	query := m.db.Model(model.GoogsModel{})
+
+	if req.Keyword != "" {
+		query = query.Where("MATCH(goods_name, brand) AGAINST(? IN BOOLEAN MODE)", req.Keyword)
+	}
+	if len(req.Categories) != 0 {
+		query = query.Where("category in (?)", req.Categories)
+	}
+	if len(req.Brands) != 0 {
+		query = query.Where("brand in (?)", req.Brands)
+	}
+	if req.MinPrice > 0 {
+		query = query.Where("price >= ?", req.MinPrice)
+	}
+	if req.MaxPrice > 0 {
+		query = query.Where("price <= ?", req.MaxPrice)
+	}
+	if req.InStock {
+		query = query.Where("stock > ?", 0)
+	}
+
+	return query
+}
+
+func sortValue(goods *model.GoogsModel, sortBy string) float64 {
+	switch sortBy {
+	case SearchSortByPrice:
+		return goods.Price
+	case SearchSortByStock:
+		return float64(goods.Stock)
+	}
+	return 0
+}
+
+func (m *mysqlGoodsSearcher) Search(ctx context.Context, req *proto.SearchGoodsRequest) (*proto.SearchGoodsResponse, error) {
+	resp := &proto.SearchGoodsResponse{}
+
+	if err := m.filter(req).Count(&resp.Total).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if req.WithFacets {
+		facets, err := m.facets(req)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+		resp.Facets = facets
+	}
+
+	query := m.filter(req)
+
+	cmp, direction := "<", "desc"
+	if req.Asc {
+		cmp, direction = ">", "asc"
+	}
+
+	var cursor mysqlSearchCursor
+	if req.Cursor != "" {
+		if err := decodeSearchCursor(req.Cursor, &cursor); err != nil {
+			return nil, err
+		}
+	}
+
+	// 和ListOrders一样,排序值相同时用id保证顺序稳定
+	column := ""
+	switch req.SortBy {
+	case SearchSortByPrice:
+		column = "price"
+	case SearchSortByStock:
+		column = "stock"
+	}
+
+	if column != "" {
+		if req.Cursor != "" {
+			query = query.Where(column+" "+cmp+" ? or ("+column+" = ? and id "+cmp+" ?)", cursor.Value, cursor.Value, cursor.ID)
+		}
+		query = query.Order(column + " " + direction).Order("id " + direction)
+	} else {
+		if req.Cursor != "" {
+			query = query.Where("id "+cmp+" ?", cursor.ID)
+		}
+		query = query.Order("id " + direction)
+	}
+
+	var goodsList []*model.GoogsModel
+	if err := query.Limit(req.NumPerPage).Find(&goodsList).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	for _, goods := range goodsList {
+		resp.Goods = append(resp.Goods, toGoodsSummary(goods))
+	}
+
+	if int64(len(goodsList)) == req.NumPerPage {
+		last := goodsList[len(goodsList)-1]
+		nextCursor, err := encodeSearchCursor(&mysqlSearchCursor{Value: sortValue(last, req.SortBy), ID: last.ID})
+		if err != nil {
+			return nil, err
+		}
+		resp.NextCursor = nextCursor
+	}
+
+	return resp, nil
+}
+
+func (m *mysqlGoodsSearcher) facets(req *proto.SearchGoodsRequest) ([]*proto.Facet, error) {
+	var facets []*proto.Facet
+	for _, field := range []string{FacetFieldCategory, FacetFieldBrand} {
+		var counts []*facetCount
+		err := m.filter(req).Select(field + " as value, count(*) as count").Group(field).Order("count desc").Limit(SearchMaxFacetBuckets).Scan(&counts).Error
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		facet := &proto.Facet{Field: field}
+		for _, c := range counts {
+			facet.Buckets = append(facet.Buckets, &proto.FacetBucket{
+				Value: c.Value,
+				Count: c.Count,
+			})
+		}
+		facets = append(facets, facet)
+	}
+
+	return facets, nil
+}
+
+func toGoodsSummary(goods *model.GoogsModel) *proto.GoodsSummary {
+	return &proto.GoodsSummary{
+		GoodsID: goods.GoodsID,
+		GoodsInfo: &proto.GoodsInfo{
+			Name:     goods.GoodsName,
+			Price:    goods.Price,
+			Stock:    goods.Stock,
+			Category: goods.Category,
+			Brand:    goods.Brand,
+		},
+	}
+}
@@ -0,0 +1,99 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/harveywangdao/ants/seller"
+)
+
+/*
+商家接口
+1.商家ID从gRPC metadata取,见seller包
+2.新增商品时记录商家,修改、删除、恢复商品和维护SKU只能由商品所属的商家操作,没有记录商家的旧商品不限制
+3.扣库存、预占等内部接口不检查商家
+*/
+
+// unscoped为true时包括已删除的商品,恢复商品时用
+func (s *Service) checkGoodsOwner(ctx context.Context, goodsID string, unscoped bool) (uint32, error) {
+	sellerID, err := seller.Require(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	query := s.db
+	if unscoped {
+		query = query.Unscoped()
+	}
+
+	var goods model.GoogsModel
+	if err := query.Select("seller_id").Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	// 旧商品没有记录商家,不检查,和发货时的旧订单一样
+	if goods.SellerID != "" && goods.SellerID != sellerID {
+		return common.ErrGoodsNotOwned, nil
+	}
+	return 0, nil
+}
+
+// 我的商品,按id倒序,游标同SearchGoods
+func (s *Service) ListSellerGoods(ctx context.Context, req *proto.ListSellerGoodsRequest) (*proto.ListSellerGoodsResponse, error) {
+	sellerID, err := seller.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.NumPerPage < 0 {
+		return nil, errors.New("param error")
+	}
+
+	numPerPage := req.NumPerPage
+	if numPerPage == 0 {
+		numPerPage = SearchDefaultNumPerPage
+	}
+	if numPerPage > SearchMaxNumPerPage {
+		numPerPage = SearchMaxNumPerPage
+	}
+
+	resp := &proto.ListSellerGoodsResponse{}
+	if err := s.db.Model(model.GoogsModel{}).Where("seller_id = ?", sellerID).Count(&resp.Total).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	query := s.db.Where("seller_id = ?", sellerID)
+	if req.Cursor != "" {
+		var cursor mysqlSearchCursor
+		if err := decodeSearchCursor(req.Cursor, &cursor); err != nil {
+			return nil, err
+		}
+		query = query.Where("id < ?", cursor.ID)
+	}
+
+	var goodsList []*model.GoogsModel
+	if err := query.Order("id desc").Limit(numPerPage).Find(&goodsList).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	for _, goods := range goodsList {
+		resp.Goods = append(resp.Goods, toGoodsSummary(goods))
+	}
+
+	if int64(len(goodsList)) == numPerPage {
+		nextCursor, err := encodeSearchCursor(&mysqlSearchCursor{ID: goodsList[len(goodsList)-1].ID})
+		if err != nil {
+			return nil, err
+		}
+		resp.NextCursor = nextCursor
+	}
+
+	return resp, nil
+}
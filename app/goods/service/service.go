@@ -7,9 +7,14 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/harveywangdao/ants/app/goods/model"
 	"github.com/harveywangdao/ants/cache/redis"
 	"github.com/harveywangdao/ants/database/mgo"
+	"github.com/harveywangdao/ants/database/softdelete"
+	"github.com/harveywangdao/ants/idempotency"
+	"github.com/harveywangdao/ants/indexer"
 	"github.com/harveywangdao/ants/logger"
+	"github.com/harveywangdao/ants/notify"
 	"github.com/harveywangdao/ants/register"
 	"github.com/harveywangdao/ants/register/discovery"
 	proto "github.com/harveywangdao/ants/rpc/goods"
@@ -27,6 +32,17 @@ type Service struct {
 	db        *gorm.DB
 	RedisPool *redis.RedisPool
 	Mongo     *mongo.Client
+
+	Idempotency idempotency.Store
+	Searcher    GoodsSearcher
+
+	Indexer     *indexer.Indexer
+	GoodsSyncer *indexer.Syncer
+
+	ProjectionSyncer *indexer.Syncer
+
+	RestockSender       notify.Sender
+	StockEventPublisher StockEventPublisher
 }
 
 var (
@@ -65,19 +81,10 @@ func initService() error {
 	}
 	App.discovery = dis
 
-	dbConfig := App.Config.Database
-	dbParam := dbConfig.Username + ":" + dbConfig.Password + "@tcp(" + dbConfig.Address + ")/" + dbConfig.DbName + "?charset=utf8&parseTime=True&loc=Local"
-	db, err := gorm.Open(dbConfig.DriverName, dbParam)
-	if err != nil {
+	if err := App.initDB(); err != nil {
 		logger.Error(err)
 		return err
 	}
-	// defer db.Close()
-	db.DB().SetMaxIdleConns(10)
-	db.DB().SetMaxOpenConns(100)
-	db.DB().SetConnMaxLifetime(time.Hour)
-
-	App.db = db
 
 	// Redis
 	pool, err := redis.NewRedisPool(App.Config.Redis.Address, App.Config.Redis.Password)
@@ -86,6 +93,7 @@ func initService() error {
 		return err
 	}
 	App.RedisPool = pool
+	App.Idempotency = idempotency.NewRedisStore(pool, idempotencyTTLSeconds(App.Config))
 
 	// MongoDB
 	mgodb, err := mgo.NewMgoClient(App.Config.Mongo.Address, App.Config.Mongo.Username, App.Config.Mongo.Password)
@@ -95,6 +103,55 @@ func initService() error {
 	}
 	App.Mongo = mgodb
 
+	if err := App.initSearcher(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := App.initIndexer(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := App.initProjection(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := App.initRestockSender(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := App.initStockEventPublisher(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	ReservationStartSweep(App)
+	PriceScheduleStartSweep(App)
+	StockReconcileStart(App)
+	softdelete.StartPurge(softDeleteRetentionDays(App.Config), softdelete.PurgeTables(App.db, model.GoogsModel{}.TableName(), model.CategoryModel{}.TableName(), model.SkuModel{}.TableName()))
+
+	return nil
+}
+
+func (s *Service) initDB() error {
+	dbConfig := s.Config.Database
+	dbParam := dbConfig.Username + ":" + dbConfig.Password + "@tcp(" + dbConfig.Address + ")/" + dbConfig.DbName + "?charset=utf8&parseTime=True&loc=Local"
+	db, err := gorm.Open(dbConfig.DriverName, dbParam)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	// defer db.Close()
+	db.DB().SetMaxIdleConns(10)
+	db.DB().SetMaxOpenConns(100)
+	db.DB().SetConnMaxLifetime(time.Hour)
+	softdelete.Register(db)
+
+	s.db = db
+
 	return nil
 }
 
@@ -138,7 +195,7 @@ func StartService() error {
 	}
 	logger.Info("rpc server:", lis.Addr())
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(idempotency.UnaryServerInterceptor(App.Idempotency)))
 	proto.RegisterGoodsServiceServer(s, App)
 	reflection.Register(s)
 
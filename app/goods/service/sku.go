@@ -0,0 +1,616 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/harveywangdao/ants/util"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+商品SKU
+1.goods_tb是SPU,sku_attrs定义有哪些属性以及可选值,如颜色:红/蓝,尺码:S/M/L
+2.每个SKU的属性值必须覆盖全部属性,同一商品下不能重复
+3.有SKU的商品,goods_tb.stock是所有SKU库存之和,price是SKU最低价,由SKU的修改同步,不能直接修改
+4.扣库存、预占带skuID时操作SKU库存,同时扣商品库存;没有SKU的商品不带skuID,和以前一样
+*/
+
+const (
+	SkuLockKey = "Sku"
+)
+
+func parseSkuAttrs(data string) ([]*proto.SkuAttr, error) {
+	var attrs []*proto.SkuAttr
+	if data == "" {
+		return attrs, nil
+	}
+
+	if err := json.Unmarshal([]byte(data), &attrs); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	return attrs, nil
+}
+
+func toSkuInfo(sku *model.SkuModel) (*proto.SkuInfo, error) {
+	var attrs []*proto.SkuAttrValue
+	if sku.Attrs != "" {
+		if err := json.Unmarshal([]byte(sku.Attrs), &attrs); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
+	return &proto.SkuInfo{
+		SkuID:   sku.SkuID,
+		GoodsID: sku.GoodsID,
+		Attrs:   attrs,
+		Price:   sku.Price,
+		Stock:   sku.Stock,
+	}, nil
+}
+
+func validateSkuAttrs(attrs []*proto.SkuAttr) error {
+	names := make(map[string]bool)
+	for _, attr := range attrs {
+		if attr.Name == "" || len(attr.Values) == 0 {
+			return errors.New("sku attr name or values is null")
+		}
+		if names[attr.Name] {
+			return fmt.Errorf("sku attr %s repeated", attr.Name)
+		}
+		names[attr.Name] = true
+
+		values := make(map[string]bool)
+		for _, v := range attr.Values {
+			if v == "" || values[v] {
+				return fmt.Errorf("sku attr %s value is null or repeated", attr.Name)
+			}
+			values[v] = true
+		}
+	}
+	return nil
+}
+
+// 按属性定义的顺序生成SKU的属性值,同样的属性值生成的结果相同
+func normalizeSkuAttrValues(attrs []*proto.SkuAttr, values []*proto.SkuAttrValue) (string, error) {
+	if len(attrs) == 0 {
+		return "", errors.New("goods sku attrs is null")
+	}
+	if len(values) != len(attrs) {
+		return "", errors.New("sku attrs do not match goods sku attrs")
+	}
+
+	given := make(map[string]string)
+	for _, v := range values {
+		given[v.Name] = v.Value
+	}
+
+	var normalized []*proto.SkuAttrValue
+	for _, attr := range attrs {
+		value, ok := given[attr.Name]
+		if !ok {
+			return "", fmt.Errorf("sku attr %s is null", attr.Name)
+		}
+
+		found := false
+		for _, v := range attr.Values {
+			if v == value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return "", fmt.Errorf("sku attr %s value %s illegal", attr.Name, value)
+		}
+
+		normalized = append(normalized, &proto.SkuAttrValue{Name: attr.Name, Value: value})
+	}
+
+	data, err := json.Marshal(normalized)
+	if err != nil {
+		logger.Error(err)
+		return "", err
+	}
+	return string(data), nil
+}
+
+func (s *Service) getSkus(goodsID string) ([]*model.SkuModel, error) {
+	var skus []*model.SkuModel
+	if err := s.db.Where("goods_id = ?", goodsID).Order("id asc").Find(&skus).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	return skus, nil
+}
+
+func (s *Service) goodsHasSkus(goodsID string) (bool, error) {
+	var count int64
+	if err := s.db.Model(model.SkuModel{}).Where("goods_id = ?", goodsID).Count(&count).Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+	return count != 0, nil
+}
+
+// 检查扣库存、预占时的skuID,返回不为0的错误码表示不能操作
+func (s *Service) checkStockSku(goodsID, skuID string) (uint32, error) {
+	if skuID == "" {
+		hasSkus, err := s.goodsHasSkus(goodsID)
+		if err != nil {
+			return 0, err
+		}
+		if hasSkus {
+			return common.ErrSkuRequired, nil
+		}
+		return 0, nil
+	}
+
+	var sku model.SkuModel
+	if err := s.db.Where("sku_id = ? AND goods_id = ?", skuID, goodsID).First(&sku).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return common.ErrSkuNotFound, nil
+		}
+		logger.Error(err)
+		return 0, err
+	}
+	return 0, nil
+}
+
+// 扣库存并记录流水,库存不足返回false
+func deductStock(tx *gorm.DB, goodsID, skuID string, number uint32, entry stockLedgerEntry) (bool, error) {
+	if skuID != "" {
+		result := tx.Exec("UPDATE sku_tb SET stock = stock - ? WHERE sku_id = ? AND goods_id = ? AND stock >= ? AND is_delete = 0", number, skuID, goodsID, number)
+		if err := result.Error; err != nil {
+			logger.Error(err)
+			return false, err
+		}
+		if result.RowsAffected == 0 {
+			return false, nil
+		}
+	}
+
+	result := tx.Exec("UPDATE goods_tb SET stock = stock - ? WHERE goods_id = ? AND stock >= ?", number, goodsID, number)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+	if result.RowsAffected == 0 {
+		return false, nil
+	}
+
+	if err := recordStock(tx, goodsID, skuID, -int32(number), entry); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func addStock(tx *gorm.DB, goodsID, skuID string, number uint32, entry stockLedgerEntry) error {
+	if skuID != "" {
+		if err := tx.Exec("UPDATE sku_tb SET stock = stock + ? WHERE sku_id = ? AND goods_id = ?", number, skuID, goodsID).Error; err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	if err := tx.Exec("UPDATE goods_tb SET stock = stock + ? WHERE goods_id = ?", number, goodsID).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+	return recordStock(tx, goodsID, skuID, int32(number), entry)
+}
+
+// SKU修改后重新汇总商品的库存和价格,没有SKU时库存为0,价格不变
+// 汇总引起的库存变化记一条商品级的调整流水,如加入第一个SKU时原来的商品库存清零,删除SKU时减掉SKU的库存
+func syncGoodsFromSkus(tx *gorm.DB, goodsID string) error {
+	// 先锁住商品行,汇总前后的差值里不会混进并发的扣减
+	var goods model.GoogsModel
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Select("price, stock").Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+	oldPrice, oldStock := goods.Price, goods.Stock
+
+	err := tx.Exec(`UPDATE goods_tb SET
+		stock = (SELECT COALESCE(SUM(stock), 0) FROM sku_tb WHERE goods_id = ? AND is_delete = 0),
+		price = COALESCE((SELECT MIN(price) FROM sku_tb WHERE goods_id = ? AND is_delete = 0), price)
+		WHERE goods_id = ?`, goodsID, goodsID, goodsID).Error
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := tx.Select("price, stock").Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := recordStock(tx, goodsID, "", goods.Stock-oldStock, stockLedgerEntry{Type: StockLedgerAdjust, Reason: "sku sync"}); err != nil {
+		return err
+	}
+	return recordPrice(tx, goodsID, "", oldPrice, goods.Price, PriceSourceSku, "")
+}
+
+func (s *Service) lockSku(goodsID string) (*redis.DistLock, error) {
+	lock := redis.NewDistLock(s.RedisPool, SkuLockKey+goodsID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	return lock, nil
+}
+
+// 修改属性定义时已有的SKU必须仍然合法
+func (s *Service) SetSkuAttrs(ctx context.Context, req *proto.SetSkuAttrsRequest) (*proto.SetSkuAttrsResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	if err := validateSkuAttrs(req.Attrs); err != nil {
+		return &proto.SetSkuAttrsResponse{
+			Code:    common.ErrSkuAttrIllegal,
+			CodeMsg: err.Error(),
+		}, nil
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.SetSkuAttrsResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	lock, err := s.lockSku(req.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	skus, err := s.getSkus(req.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, sku := range skus {
+		info, err := toSkuInfo(sku)
+		if err != nil {
+			return nil, err
+		}
+
+		if _, err := normalizeSkuAttrValues(req.Attrs, info.Attrs); err != nil {
+			return &proto.SetSkuAttrsResponse{
+				Code:    common.ErrSkuAttrIllegal,
+				CodeMsg: fmt.Sprintf("sku %s: %v", sku.SkuID, err),
+			}, nil
+		}
+	}
+
+	data, err := json.Marshal(req.Attrs)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if err := s.db.Model(model.GoogsModel{}).Where("goods_id = ?", req.GoodsID).Update("sku_attrs", string(data)).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.SetSkuAttrsResponse{
+		CodeMsg: "set sku attrs success",
+	}, nil
+}
+
+// 商品的第一个SKU加入后,商品库存改为SKU库存之和
+func (s *Service) AddSku(ctx context.Context, req *proto.AddSkuRequest) (*proto.AddSkuResponse, error) {
+	if req.GoodsID == "" || req.Price < 0 || req.Stock < 0 {
+		return nil, errors.New("param error")
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.AddSkuResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	lock, err := s.lockSku(req.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	var goods model.GoogsModel
+	if err := s.db.Where("goods_id = ?", req.GoodsID).First(&goods).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	attrs, err := parseSkuAttrs(goods.SkuAttrs)
+	if err != nil {
+		return nil, err
+	}
+
+	attrValues, err := normalizeSkuAttrValues(attrs, req.Attrs)
+	if err != nil {
+		return &proto.AddSkuResponse{
+			Code:    common.ErrSkuAttrIllegal,
+			CodeMsg: err.Error(),
+		}, nil
+	}
+
+	var count int64
+	if err := s.db.Model(model.SkuModel{}).Where("goods_id = ? AND attrs = ?", req.GoodsID, attrValues).Count(&count).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	if count != 0 {
+		return &proto.AddSkuResponse{
+			Code:    common.ErrSkuRepeat,
+			CodeMsg: "sku repeat",
+		}, nil
+	}
+
+	// 库存通过addStock加上,同时记录入库流水
+	sku := &model.SkuModel{
+		SkuID:   util.GetUUID(),
+		GoodsID: req.GoodsID,
+		Attrs:   attrValues,
+		Price:   req.Price,
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(sku).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := recordPrice(tx, req.GoodsID, sku.SkuID, 0, sku.Price, PriceSourceCreate, ""); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := addStock(tx, req.GoodsID, sku.SkuID, uint32(req.Stock), stockLedgerEntry{Type: StockLedgerReceive, Reason: "add sku"}); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := syncGoodsFromSkus(tx, req.GoodsID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	s.notifyGoodsChanged(req.GoodsID)
+	s.afterStockChange(req.GoodsID)
+
+	return &proto.AddSkuResponse{
+		SkuID: sku.SkuID,
+	}, nil
+}
+
+func (s *Service) GetSku(ctx context.Context, req *proto.GetSkuRequest) (*proto.GetSkuResponse, error) {
+	if req.SkuID == "" {
+		return nil, errors.New("skuID is null")
+	}
+
+	var sku model.SkuModel
+	if err := s.db.Where("sku_id = ?", req.SkuID).First(&sku).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.GetSkuResponse{
+				Code:    common.ErrSkuNotFound,
+				CodeMsg: "sku not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	info, err := toSkuInfo(&sku)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.GetSkuResponse{
+		SkuInfo: info,
+	}, nil
+}
+
+func (s *Service) ListSkus(ctx context.Context, req *proto.ListSkusRequest) (*proto.ListSkusResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	skus, err := s.getSkus(req.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &proto.ListSkusResponse{}
+	for _, sku := range skus {
+		info, err := toSkuInfo(sku)
+		if err != nil {
+			return nil, err
+		}
+		resp.Skus = append(resp.Skus, info)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) ModifySku(ctx context.Context, req *proto.ModifySkuRequest) (*proto.ModifySkuResponse, error) {
+	if req.SkuID == "" || req.Price < 0 || req.Stock < 0 {
+		return nil, errors.New("param error")
+	}
+
+	var sku model.SkuModel
+	if err := s.db.Where("sku_id = ?", req.SkuID).First(&sku).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.ModifySkuResponse{
+				Code:    common.ErrSkuNotFound,
+				CodeMsg: "sku not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	code, err := s.checkGoodsOwner(ctx, sku.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.ModifySkuResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	lock, err := s.lockSku(sku.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	// 在行锁内重新读出库存和价格,下单扣减也要等这行的锁
+	if err := tx.Set("gorm:query_option", "FOR UPDATE").Where("id = ?", sku.ID).First(&sku).Error; err != nil {
+		tx.Rollback()
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.ModifySkuResponse{
+				Code:    common.ErrSkuNotFound,
+				CodeMsg: "sku not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	if err := tx.Model(model.SkuModel{}).Where("id = ?", sku.ID).Update("price", req.Price).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	// 差值基于行锁内读到的库存,读和改之间不会插入并发的扣减
+	changed, err := changeStock(tx, sku.GoodsID, sku.SkuID, req.Stock-sku.Stock, stockLedgerEntry{Type: StockLedgerAdjust, Reason: "modify sku"})
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !changed {
+		tx.Rollback()
+		return &proto.ModifySkuResponse{
+			Code:    common.ErrStockIsNotEnough,
+			CodeMsg: "stock is not enough",
+		}, nil
+	}
+
+	if err := recordPrice(tx, sku.GoodsID, sku.SkuID, sku.Price, req.Price, PriceSourceManual, ""); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := syncGoodsFromSkus(tx, sku.GoodsID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	s.notifyGoodsChanged(sku.GoodsID)
+	s.afterStockChange(sku.GoodsID)
+
+	return &proto.ModifySkuResponse{
+		CodeMsg: "modify success",
+	}, nil
+}
+
+func (s *Service) DelSku(ctx context.Context, req *proto.DelSkuRequest) (*proto.DelSkuResponse, error) {
+	if req.SkuID == "" {
+		return nil, errors.New("skuID is null")
+	}
+
+	var sku model.SkuModel
+	if err := s.db.Where("sku_id = ?", req.SkuID).First(&sku).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return &proto.DelSkuResponse{
+				Code:    common.ErrSkuNotFound,
+				CodeMsg: "sku not found",
+			}, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	code, err := s.checkGoodsOwner(ctx, sku.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.DelSkuResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	lock, err := s.lockSku(sku.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	if err := tx.Where("id = ?", sku.ID).Delete(model.SkuModel{}).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := syncGoodsFromSkus(tx, sku.GoodsID); err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	s.notifyGoodsChanged(sku.GoodsID)
+	s.afterStockChange(sku.GoodsID)
+
+	return &proto.DelSkuResponse{
+		CodeMsg: "delete success",
+	}, nil
+}
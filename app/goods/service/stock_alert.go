@@ -0,0 +1,422 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	"github.com/harveywangdao/ants/notify"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/jinzhu/gorm"
+	nsq "github.com/nsqio/go-nsq"
+)
+
+const (
+	LowStockEventChannel = "LowStockEventChannel"
+
+	StockAlertPublisherRedis = "redis"
+	StockAlertPublisherNsq   = "nsq"
+	StockAlertPublisherKafka = "kafka"
+
+	RestockStatusWaiting   = 0
+	RestockStatusNotified  = 1
+	RestockStatusCancelled = 2
+
+	RestockNotifyBatchSize = 100
+)
+
+/*
+低库存告警和到货通知
+1.库存低于商品的低库存阈值时发一次LowStockEvent,和订单服务的扣库存事件一样可以走redis、nsq或kafka,库存恢复到阈值以上后才会再发
+  nsq和kafka的producer在服务启动时创建,所有事件共用
+2.用户订阅到货通知,商品有库存时通过notify.Sender通知等待中的订阅,每个订阅只通知一次,再次订阅重新等待
+3.库存变化的事务提交后调用afterStockChange,失败只记日志,不影响库存操作
+*/
+
+type LowStockEvent struct {
+	GoodsID   string `json:"goodsID"`
+	SellerID  string `json:"sellerID"`
+	GoodsName string `json:"goodsName"`
+	Stock     int32  `json:"stock"`
+	Threshold int32  `json:"threshold"`
+	Time      int64  `json:"time"`
+}
+
+func (s *Service) initRestockSender() error {
+	conf := s.Config.Restock
+	if conf == nil || conf.Sender == "" || conf.Sender == notify.SenderLog {
+		s.RestockSender = notify.NewLogSender()
+		return nil
+	}
+
+	switch conf.Sender {
+	case notify.SenderFile:
+		sender, err := notify.NewFileSender(conf.FilePath)
+		if err != nil {
+			return err
+		}
+		s.RestockSender = sender
+	default:
+		return fmt.Errorf("restock sender %s not supported", conf.Sender)
+	}
+
+	return nil
+}
+
+func (s *Service) afterStockChange(goodsIDs ...string) {
+	for _, goodsID := range goodsIDs {
+		var goods model.GoogsModel
+		if err := s.db.Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		if err := s.checkLowStock(&goods); err != nil {
+			logger.Error(err)
+		}
+
+		if goods.Stock > 0 {
+			if err := s.notifyRestock(&goods); err != nil {
+				logger.Error(err)
+			}
+		}
+	}
+}
+
+// 告警标记用条件更新,并发时只有一个会发事件
+func (s *Service) checkLowStock(goods *model.GoogsModel) error {
+	if goods.LowStockAlerted != 0 && goods.Stock >= goods.LowStockThreshold {
+		err := s.db.Model(model.GoogsModel{}).Where("id = ? AND stock >= low_stock_threshold", goods.ID).
+			Update("low_stock_alerted", 0).Error
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+		return nil
+	}
+
+	if goods.LowStockThreshold <= 0 || goods.LowStockAlerted != 0 || goods.Stock >= goods.LowStockThreshold {
+		return nil
+	}
+
+	db := s.db.Model(model.GoogsModel{}).Where("id = ? AND low_stock_alerted = 0 AND stock < low_stock_threshold", goods.ID).
+		Update("low_stock_alerted", 1)
+	if err := db.Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+	if db.RowsAffected == 0 {
+		return nil
+	}
+
+	data, err := json.Marshal(&LowStockEvent{
+		GoodsID:   goods.GoodsID,
+		SellerID:  goods.SellerID,
+		GoodsName: goods.GoodsName,
+		Stock:     goods.Stock,
+		Threshold: goods.LowStockThreshold,
+		Time:      time.Now().Unix(),
+	})
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	// 发送失败清掉告警标记,下次库存变化时重发
+	if err := s.publishStockEvent(data); err != nil {
+		if err := s.db.Model(model.GoogsModel{}).Where("id = ?", goods.ID).Update("low_stock_alerted", 0).Error; err != nil {
+			logger.Error(err)
+		}
+		return err
+	}
+
+	logger.Info("low stock", goods.GoodsID, goods.Stock, goods.LowStockThreshold)
+	return nil
+}
+
+// 低库存事件的发送方,服务启动时按配置创建一次,nsq和kafka的producer可以并发使用
+type StockEventPublisher interface {
+	Publish(data []byte) error
+}
+
+type redisStockEventPublisher struct {
+	pool  *redis.RedisPool
+	topic string
+}
+
+func (p *redisStockEventPublisher) Publish(data []byte) error {
+	conn, err := p.pool.Get()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer conn.Close()
+
+	if err := conn.Publish(p.topic, string(data)); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+type nsqStockEventPublisher struct {
+	producer *nsq.Producer
+	topic    string
+}
+
+func (p *nsqStockEventPublisher) Publish(data []byte) error {
+	if err := p.producer.Publish(p.topic, data); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+type kafkaStockEventPublisher struct {
+	producer sarama.SyncProducer
+	topic    string
+}
+
+func (p *kafkaStockEventPublisher) Publish(data []byte) error {
+	if _, _, err := p.producer.SendMessage(&sarama.ProducerMessage{
+		Topic: p.topic,
+		Key:   sarama.StringEncoder("LowStock"),
+		Value: sarama.ByteEncoder(data),
+	}); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (s *Service) initStockEventPublisher() error {
+	conf := s.Config.StockAlert
+	if conf == nil {
+		conf = &StockAlertConfig{}
+	}
+
+	topic := conf.Topic
+	if topic == "" {
+		topic = LowStockEventChannel
+	}
+
+	switch conf.Publisher {
+	case "", StockAlertPublisherRedis:
+		s.StockEventPublisher = &redisStockEventPublisher{
+			pool:  s.RedisPool,
+			topic: topic,
+		}
+
+	case StockAlertPublisherNsq:
+		producer, err := nsq.NewProducer(conf.NsqAddr, nsq.NewConfig())
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+		producer.SetLogger(nil, nsq.LogLevelError)
+
+		s.StockEventPublisher = &nsqStockEventPublisher{
+			producer: producer,
+			topic:    topic,
+		}
+
+	case StockAlertPublisherKafka:
+		config := sarama.NewConfig()
+		config.Producer.Return.Successes = true
+
+		producer, err := sarama.NewSyncProducer(conf.KafkaAddrs, config)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		s.StockEventPublisher = &kafkaStockEventPublisher{
+			producer: producer,
+			topic:    topic,
+		}
+
+	default:
+		return fmt.Errorf("stock alert publisher %s not supported", conf.Publisher)
+	}
+
+	return nil
+}
+
+func (s *Service) publishStockEvent(data []byte) error {
+	if s.StockEventPublisher == nil {
+		return errors.New("stock event publisher is not initialized")
+	}
+	return s.StockEventPublisher.Publish(data)
+}
+
+// 先把订阅改成已通知再发送,发送失败改回等待,下次库存变化时重试
+// 按id分批处理,直到没有等待中的订阅
+func (s *Service) notifyRestock(goods *model.GoogsModel) error {
+	var lastID int64
+	for {
+		var subscriptions []*model.RestockSubscriptionModel
+		err := s.db.Where("goods_id = ? AND status = ? AND id > ?", goods.GoodsID, RestockStatusWaiting, lastID).
+			Order("id asc").Limit(RestockNotifyBatchSize).Find(&subscriptions).Error
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		for _, subscription := range subscriptions {
+			lastID = subscription.ID
+			if err := s.notifyRestockSubscription(goods, subscription); err != nil {
+				return err
+			}
+		}
+
+		if len(subscriptions) < RestockNotifyBatchSize {
+			return nil
+		}
+	}
+}
+
+func (s *Service) notifyRestockSubscription(goods *model.GoogsModel, subscription *model.RestockSubscriptionModel) error {
+	now := time.Now().Unix()
+	db := s.db.Model(model.RestockSubscriptionModel{}).Where("id = ? AND status = ?", subscription.ID, RestockStatusWaiting).
+		Updates(map[string]interface{}{
+			"status":      RestockStatusNotified,
+			"notify_time": now,
+		})
+	if err := db.Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+	if db.RowsAffected == 0 {
+		return nil
+	}
+
+	err := s.RestockSender.Send(context.Background(), &notify.Message{
+		UserID:  subscription.UserID,
+		Subject: "back in stock",
+		Content: fmt.Sprintf("%s(%s) is back in stock", goods.GoodsName, goods.GoodsID),
+		Time:    now,
+	})
+	if err != nil {
+		logger.Error(err)
+		if err := s.db.Model(model.RestockSubscriptionModel{}).Where("id = ?", subscription.ID).
+			Update("status", RestockStatusWaiting).Error; err != nil {
+			logger.Error(err)
+		}
+		return err
+	}
+
+	return nil
+}
+
+// 阈值为0时不告警,修改阈值后重新判断一次
+func (s *Service) SetLowStockThreshold(ctx context.Context, req *proto.SetLowStockThresholdRequest) (*proto.SetLowStockThresholdResponse, error) {
+	if req.GoodsID == "" || req.Threshold < 0 {
+		return nil, errors.New("param error")
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.SetLowStockThresholdResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	param := map[string]interface{}{
+		"low_stock_threshold": req.Threshold,
+		"low_stock_alerted":   0,
+	}
+	if err := s.db.Model(model.GoogsModel{}).Where("goods_id = ?", req.GoodsID).Updates(param).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	s.afterStockChange(req.GoodsID)
+
+	return &proto.SetLowStockThresholdResponse{
+		CodeMsg: "set success",
+	}, nil
+}
+
+// 有库存时不能订阅,已经订阅过的重新等待
+func (s *Service) SubscribeRestock(ctx context.Context, req *proto.SubscribeRestockRequest) (*proto.SubscribeRestockResponse, error) {
+	if req.GoodsID == "" || req.UserID == "" {
+		return nil, errors.New("goodsID or userID is null")
+	}
+
+	var goods model.GoogsModel
+	if err := s.db.Select("stock").Where("goods_id = ?", req.GoodsID).First(&goods).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	if goods.Stock > 0 {
+		return &proto.SubscribeRestockResponse{
+			Code:    common.ErrGoodsInStock,
+			CodeMsg: "goods in stock",
+		}, nil
+	}
+
+	var subscription model.RestockSubscriptionModel
+	err := s.db.Where("goods_id = ? AND user_id = ?", req.GoodsID, req.UserID).First(&subscription).Error
+	if err == nil {
+		param := map[string]interface{}{
+			"status":      RestockStatusWaiting,
+			"notify_time": 0,
+		}
+		if err := s.db.Model(model.RestockSubscriptionModel{}).Where("id = ?", subscription.ID).Updates(param).Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	} else if gorm.IsRecordNotFoundError(err) {
+		err := s.db.Create(&model.RestockSubscriptionModel{
+			GoodsID: req.GoodsID,
+			UserID:  req.UserID,
+			Status:  RestockStatusWaiting,
+		}).Error
+		// 并发重复订阅
+		if err != nil && !strings.Contains(err.Error(), "Duplicate entry") {
+			logger.Error(err)
+			return nil, err
+		}
+	} else {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.SubscribeRestockResponse{
+		CodeMsg: "subscribe success",
+	}, nil
+}
+
+func (s *Service) UnsubscribeRestock(ctx context.Context, req *proto.UnsubscribeRestockRequest) (*proto.UnsubscribeRestockResponse, error) {
+	if req.GoodsID == "" || req.UserID == "" {
+		return nil, errors.New("goodsID or userID is null")
+	}
+
+	db := s.db.Model(model.RestockSubscriptionModel{}).Where("goods_id = ? AND user_id = ? AND status = ?", req.GoodsID, req.UserID, RestockStatusWaiting).
+		Update("status", RestockStatusCancelled)
+	if err := db.Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	if db.RowsAffected == 0 {
+		return &proto.UnsubscribeRestockResponse{
+			Code:    common.ErrRestockSubscriptionNotFound,
+			CodeMsg: "restock subscription not found",
+		}, nil
+	}
+
+	return &proto.UnsubscribeRestockResponse{
+		CodeMsg: "unsubscribe success",
+	}, nil
+}
@@ -0,0 +1,372 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/harveywangdao/ants/app/goods/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/goods"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	StockLedgerReceive = 0
+	StockLedgerDeduct  = 1
+	StockLedgerRestore = 2
+	StockLedgerAdjust  = 3
+	StockLedgerReserve = 4
+	StockLedgerRelease = 5
+
+	StockLedgerDefaultLimit = 100
+	StockLedgerMaxLimit     = 1000
+
+	StockReconcileLockKey         = "StockReconcile"
+	StockReconcileBatchSize       = 500
+	StockReconcileDefaultInterval = time.Hour
+)
+
+/*
+库存流水
+1.库存的每次变化都在stock_ledger_tb追加一条,数量有正负,只追加不修改
+2.扣减、预占、归还、释放通过deductStock/addStock记录,修改商品和SKU的库存按差值记调整
+3.SKU的流水sku_id不为空,同时也是商品库存的变化,商品的全部流水之和等于goods_tb.stock,SKU的流水之和等于sku_tb.stock
+4.对账任务定时检查上面两个等式,不相等只记录日志,不自动修正
+5.流水表上线前的商品由goods.sql补期初流水,期初数量是上线时的库存减去已有的流水,上线前后有库存变化也能对上;
+  之后仍然没有流水的商品第一次对账时补
+*/
+
+type stockLedgerEntry struct {
+	Type   uint8
+	RefID  string
+	Reason string
+}
+
+// 数量为0时不记录
+func recordStock(tx *gorm.DB, goodsID, skuID string, quantity int32, entry stockLedgerEntry) error {
+	if quantity == 0 {
+		return nil
+	}
+
+	if err := tx.Create(&model.StockLedgerModel{
+		GoodsID:    goodsID,
+		SkuID:      skuID,
+		Type:       entry.Type,
+		Quantity:   quantity,
+		RefID:      entry.RefID,
+		Reason:     entry.Reason,
+		ChangeTime: time.Now().Unix(),
+	}).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 按差值增减库存,减少时库存不足返回false
+func changeStock(tx *gorm.DB, goodsID, skuID string, delta int32, entry stockLedgerEntry) (bool, error) {
+	if delta < 0 {
+		return deductStock(tx, goodsID, skuID, uint32(-delta), entry)
+	}
+
+	if err := addStock(tx, goodsID, skuID, uint32(delta), entry); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// 入库时数量必须大于0,调整时可以为负数
+func (s *Service) AdjustStock(ctx context.Context, req *proto.AdjustStockRequest) (*proto.AdjustStockResponse, error) {
+	if req.GoodsID == "" || req.Quantity == 0 || req.Reason == "" {
+		return nil, errors.New("param error")
+	}
+
+	if req.Receive && req.Quantity < 0 {
+		return nil, errors.New("receive quantity must be positive")
+	}
+
+	code, err := s.checkGoodsOwner(ctx, req.GoodsID, false)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.AdjustStockResponse{
+			Code:    code,
+			CodeMsg: "goods not owned by seller",
+		}, nil
+	}
+
+	code, err = s.checkStockSku(req.GoodsID, req.SkuID)
+	if err != nil {
+		return nil, err
+	}
+	if code != 0 {
+		return &proto.AdjustStockResponse{
+			Code:    code,
+			CodeMsg: "sku not found or required",
+		}, nil
+	}
+
+	entry := stockLedgerEntry{
+		Type:   StockLedgerAdjust,
+		RefID:  req.RefID,
+		Reason: req.Reason,
+	}
+	if req.Receive {
+		entry.Type = StockLedgerReceive
+	}
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return nil, err
+	}
+
+	changed, err := changeStock(tx, req.GoodsID, req.SkuID, req.Quantity, entry)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	if !changed {
+		tx.Rollback()
+		return &proto.AdjustStockResponse{
+			Code:    common.ErrStockIsNotEnough,
+			CodeMsg: "stock is not enough",
+		}, nil
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return nil, err
+	}
+	s.notifyGoodsChanged(req.GoodsID)
+	s.afterStockChange(req.GoodsID)
+
+	return &proto.AdjustStockResponse{
+		CodeMsg: "adjust stock success",
+	}, nil
+}
+
+// 按id倒序,skuID为空时查商品的全部流水
+func (s *Service) ListStockLedger(ctx context.Context, req *proto.ListStockLedgerRequest) (*proto.ListStockLedgerResponse, error) {
+	if req.GoodsID == "" || req.Limit < 0 {
+		return nil, errors.New("param error")
+	}
+
+	limit := req.Limit
+	if limit == 0 {
+		limit = StockLedgerDefaultLimit
+	}
+	if limit > StockLedgerMaxLimit {
+		limit = StockLedgerMaxLimit
+	}
+
+	query := s.db.Where("goods_id = ?", req.GoodsID)
+	if req.SkuID != "" {
+		query = query.Where("sku_id = ?", req.SkuID)
+	}
+	if req.LastID != 0 {
+		query = query.Where("id < ?", req.LastID)
+	}
+
+	var ledgers []*model.StockLedgerModel
+	if err := query.Order("id desc").Limit(limit).Find(&ledgers).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.ListStockLedgerResponse{}
+	for _, ledger := range ledgers {
+		resp.Records = append(resp.Records, &proto.StockLedgerRecord{
+			Id:         ledger.ID,
+			GoodsID:    ledger.GoodsID,
+			SkuID:      ledger.SkuID,
+			Type:       uint32(ledger.Type),
+			Quantity:   ledger.Quantity,
+			RefID:      ledger.RefID,
+			Reason:     ledger.Reason,
+			ChangeTime: ledger.ChangeTime,
+		})
+	}
+	return resp, nil
+}
+
+// goodsID为空时对账全部商品
+func (s *Service) ReconcileStock(ctx context.Context, req *proto.ReconcileStockRequest) (*proto.ReconcileStockResponse, error) {
+	checked, mismatches, err := s.reconcileStock(req.GoodsID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &proto.ReconcileStockResponse{
+		Checked:    checked,
+		Mismatches: mismatches,
+	}, nil
+}
+
+type stockBalance struct {
+	ID          int64  `gorm:"column:id"`
+	GoodsID     string `gorm:"column:goods_id"`
+	SkuID       string `gorm:"column:sku_id"`
+	Stock       int32  `gorm:"column:stock"`
+	LedgerStock int64  `gorm:"column:ledger_stock"`
+	LedgerCount int64  `gorm:"column:ledger_count"`
+}
+
+// 多个实例同时对账时只有一个执行
+func (s *Service) reconcileStock(goodsID string) (int64, []*proto.StockMismatch, error) {
+	lock := redis.NewDistLock(s.RedisPool, StockReconcileLockKey, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return 0, nil, err
+	}
+	defer lock.Unlock()
+
+	var checked int64
+	var mismatches []*proto.StockMismatch
+	var lastID int64
+	for {
+		var balances []*stockBalance
+		query := s.db.Table("goods_tb g").
+			Select("g.id, g.goods_id, g.stock, COALESCE(SUM(l.quantity), 0) AS ledger_stock, COUNT(l.id) AS ledger_count").
+			Joins("LEFT JOIN stock_ledger_tb l ON l.goods_id = g.goods_id").
+			Where("g.id > ? AND g.is_delete = 0", lastID)
+		if goodsID != "" {
+			query = query.Where("g.goods_id = ?", goodsID)
+		}
+		err := query.Group("g.id, g.goods_id, g.stock").Order("g.id asc").Limit(StockReconcileBatchSize).Scan(&balances).Error
+		if err != nil {
+			logger.Error(err)
+			return 0, nil, err
+		}
+
+		for _, balance := range balances {
+			if balance.LedgerCount == 0 {
+				if err := s.openStockLedger(balance.GoodsID); err != nil {
+					return 0, nil, err
+				}
+				checked++
+				continue
+			}
+
+			if int64(balance.Stock) != balance.LedgerStock {
+				mismatches = append(mismatches, toStockMismatch(balance))
+			}
+
+			skuMismatches, err := s.reconcileSkuStock(balance.GoodsID)
+			if err != nil {
+				return 0, nil, err
+			}
+			mismatches = append(mismatches, skuMismatches...)
+			checked++
+		}
+
+		if len(balances) < StockReconcileBatchSize {
+			break
+		}
+		lastID = balances[len(balances)-1].ID
+	}
+
+	for _, mismatch := range mismatches {
+		logger.Error("stock mismatch, goodsID:", mismatch.GoodsID, "skuID:", mismatch.SkuID, "stock:", mismatch.Stock, "ledger:", mismatch.LedgerStock)
+	}
+	return checked, mismatches, nil
+}
+
+func (s *Service) reconcileSkuStock(goodsID string) ([]*proto.StockMismatch, error) {
+	var balances []*stockBalance
+	err := s.db.Table("sku_tb k").
+		Select("k.id, k.goods_id, k.sku_id, k.stock, COALESCE(SUM(l.quantity), 0) AS ledger_stock, COUNT(l.id) AS ledger_count").
+		Joins("LEFT JOIN stock_ledger_tb l ON l.goods_id = k.goods_id AND l.sku_id = k.sku_id").
+		Where("k.goods_id = ? AND k.is_delete = 0", goodsID).
+		Group("k.id, k.goods_id, k.sku_id, k.stock").Scan(&balances).Error
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var mismatches []*proto.StockMismatch
+	for _, balance := range balances {
+		if int64(balance.Stock) != balance.LedgerStock {
+			mismatches = append(mismatches, toStockMismatch(balance))
+		}
+	}
+	return mismatches, nil
+}
+
+func toStockMismatch(balance *stockBalance) *proto.StockMismatch {
+	return &proto.StockMismatch{
+		GoodsID:     balance.GoodsID,
+		SkuID:       balance.SkuID,
+		Stock:       balance.Stock,
+		LedgerStock: balance.LedgerStock,
+	}
+}
+
+// 没有流水的老商品按当前库存补期初流水,每个SKU一条,商品库存和SKU库存之和的差额记在商品上
+func (s *Service) openStockLedger(goodsID string) error {
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	var goods model.GoogsModel
+	if err := tx.Select("stock").Where("goods_id = ?", goodsID).First(&goods).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+
+	var skus []*model.SkuModel
+	if err := tx.Select("sku_id, stock").Where("goods_id = ?", goodsID).Find(&skus).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+
+	entry := stockLedgerEntry{Type: StockLedgerAdjust, Reason: "opening balance"}
+	rest := goods.Stock
+	for _, sku := range skus {
+		if err := recordStock(tx, goodsID, sku.SkuID, sku.Stock, entry); err != nil {
+			tx.Rollback()
+			return err
+		}
+		rest -= sku.Stock
+	}
+
+	if err := recordStock(tx, goodsID, "", rest, entry); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	logger.Info("open stock ledger", goodsID, goods.Stock)
+	return nil
+}
+
+// 定时对账
+func StockReconcileStart(s *Service) {
+	go func() {
+		interval := time.Duration(stockReconcileInterval(s.Config)) * time.Second
+		if interval <= 0 {
+			interval = StockReconcileDefaultInterval
+		}
+		ticker := time.NewTicker(interval)
+
+		for {
+			select {
+			case <-ticker.C:
+				checked, mismatches, err := s.reconcileStock("")
+				if err != nil {
+					logger.Error(err)
+					continue
+				}
+				logger.Info("reconcile stock, checked:", checked, "mismatches:", len(mismatches))
+			}
+		}
+	}()
+}
@@ -7,26 +7,180 @@ CREATE TABLE IF NOT EXISTS `goods_tb`(
    `category` INT(11) NOT NULL DEFAULT 0 COMMENT '商品种类',
    `stock` INT(11) NOT NULL DEFAULT 0 COMMENT '商品库存',
    `brand` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '品牌',
+   `sku_attrs` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT 'SKU属性定义,json',
+   `low_stock_threshold` INT(11) NOT NULL DEFAULT 0 COMMENT '低库存阈值,库存低于阈值时告警,0为不告警',
+   `low_stock_alerted` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否已发低库存告警,库存恢复到阈值以上后清零',
    `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
    `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
    `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
    `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
    PRIMARY KEY (`id`),
    INDEX `index_goods_name` (`goods_name`),
+   FULLTEXT INDEX `ft_goods_name_brand` (`goods_name`, `brand`) WITH PARSER ngram,
    UNIQUE INDEX `unique_goods_id` (`goods_id`)
 )ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '商品表';
 
+-- 老库升级用,新建的库上面的建表语句已经包含这些改动,不用执行
+ALTER TABLE goods_tb ADD FULLTEXT INDEX `ft_goods_name_brand` (`goods_name`, `brand`) WITH PARSER ngram;
+ALTER TABLE goods_tb ADD COLUMN `sku_attrs` VARCHAR(1000) NOT NULL DEFAULT '' COMMENT 'SKU属性定义,json' AFTER `brand`;
+ALTER TABLE goods_tb ADD COLUMN `low_stock_threshold` INT(11) NOT NULL DEFAULT 0 COMMENT '低库存阈值,库存低于阈值时告警,0为不告警' AFTER `sku_attrs`, ADD COLUMN `low_stock_alerted` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否已发低库存告警,库存恢复到阈值以上后清零' AFTER `low_stock_threshold`;
+
 CREATE TABLE IF NOT EXISTS `purchase_record_tb`(
    `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
    `goods_id` VARCHAR(50) NOT NULL COMMENT '商品唯一标识',
    `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
    `pay_id` VARCHAR(50) NOT NULL COMMENT '支付号',
+   `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU唯一标识,没有SKU的商品为空',
+   `number` INT(11) NOT NULL DEFAULT 0 COMMENT '扣减数量',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:已扣减 1:已归还',
    `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
    `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
    `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
    `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
    PRIMARY KEY (`id`),
    INDEX `index_goods_id` (`goods_id`),
-   UNIQUE INDEX `unique_order_id` (`order_id`),
-   UNIQUE INDEX `unique_pay_id` (`pay_id`)
-)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '购买记录表';
\ No newline at end of file
+   INDEX `index_order_id` (`order_id`),
+   UNIQUE INDEX `unique_pay_id_goods_id_sku_id` (`pay_id`, `goods_id`, `sku_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '购买记录表';
+
+-- 老库升级用,新建的库上面的建表语句已经包含这些改动,不用执行
+ALTER TABLE purchase_record_tb ADD COLUMN `number` INT(11) NOT NULL DEFAULT 0 COMMENT '扣减数量' AFTER `pay_id`;
+ALTER TABLE purchase_record_tb DROP INDEX `unique_order_id`, DROP INDEX `unique_pay_id`, ADD INDEX `index_order_id` (`order_id`), ADD UNIQUE INDEX `unique_pay_id_goods_id` (`pay_id`, `goods_id`);
+ALTER TABLE purchase_record_tb ADD COLUMN `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:已扣减 1:已归还' AFTER `number`;
+ALTER TABLE purchase_record_tb ADD COLUMN `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU唯一标识,没有SKU的商品为空' AFTER `pay_id`, DROP INDEX `unique_pay_id_goods_id`, ADD UNIQUE INDEX `unique_pay_id_goods_id_sku_id` (`pay_id`, `goods_id`, `sku_id`);
+
+CREATE TABLE IF NOT EXISTS `stock_reservation_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `reservation_id` VARCHAR(50) NOT NULL COMMENT '预占唯一标识',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品唯一标识',
+   `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
+   `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU唯一标识,没有SKU的商品为空',
+   `pay_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '支付号,确认后填写',
+   `number` INT(11) NOT NULL COMMENT '预占数量',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:预占中 1:已确认 2:已释放',
+   `expire_time` BIGINT(20) NOT NULL COMMENT '过期时间,unix时间戳',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_status_expire_time` (`status`, `expire_time`),
+   UNIQUE INDEX `unique_reservation_id` (`reservation_id`),
+   UNIQUE INDEX `unique_order_id_goods_id_sku_id` (`order_id`, `goods_id`, `sku_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '库存预占表';
+
+CREATE TABLE IF NOT EXISTS `sku_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `sku_id` VARCHAR(50) NOT NULL COMMENT 'SKU唯一标识',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品唯一标识',
+   `attrs` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '属性值,json,按商品sku_attrs的顺序',
+   `price` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '价格',
+   `stock` INT(11) NOT NULL DEFAULT 0 COMMENT '库存',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_goods_id` (`goods_id`),
+   UNIQUE INDEX `unique_sku_id` (`sku_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '商品SKU表';
+
+CREATE TABLE IF NOT EXISTS `category_tb`(
+   `id` INT(11) NOT NULL AUTO_INCREMENT COMMENT '主键,即商品表的category',
+   `parent_id` INT(11) NOT NULL DEFAULT 0 COMMENT '父分类ID,0为顶级分类',
+   `name` VARCHAR(50) NOT NULL COMMENT '分类名称',
+   `path` VARCHAR(255) NOT NULL COMMENT '从顶级分类到自己的ID路径,如/1/5/12/',
+   `level` TINYINT(4) NOT NULL DEFAULT 1 COMMENT '层级,顶级分类为1',
+   `sort` INT(11) NOT NULL DEFAULT 0 COMMENT '同级排序,越小越靠前',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_parent_id` (`parent_id`),
+   INDEX `index_path` (`path`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '商品分类表';
+
+CREATE TABLE IF NOT EXISTS `price_history_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品ID',
+   `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU ID,为空是商品价格',
+   `price` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '新价格',
+   `old_price` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '原价格',
+   `effective_time` BIGINT(20) NOT NULL COMMENT '生效时间,unix时间戳',
+   `source` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '来源 0:手动修改 1:定时调价 2:SKU汇总',
+   `schedule_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '定时调价ID',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_goods_sku_effective_time` (`goods_id`, `sku_id`, `effective_time`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '商品价格历史表';
+
+CREATE TABLE IF NOT EXISTS `price_schedule_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `schedule_id` VARCHAR(50) NOT NULL COMMENT '定时调价ID',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品ID',
+   `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU ID,为空是没有SKU的商品',
+   `price` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '调整后价格',
+   `effective_time` BIGINT(20) NOT NULL COMMENT '生效时间,unix时间戳',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:待生效 1:已生效 2:已取消',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   UNIQUE INDEX `unique_schedule_id` (`schedule_id`),
+   INDEX `index_goods_id` (`goods_id`),
+   INDEX `index_status_effective_time` (`status`, `effective_time`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '商品定时调价表';
+
+CREATE TABLE IF NOT EXISTS `stock_ledger_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品ID',
+   `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU ID,为空是商品库存',
+   `type` TINYINT(1) NOT NULL COMMENT '类型 0:入库 1:扣减 2:归还 3:调整 4:预占 5:释放预占',
+   `quantity` INT(11) NOT NULL COMMENT '变化数量,减少为负数',
+   `ref_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '关联单号,如支付号、预占ID',
+   `reason` VARCHAR(200) NOT NULL DEFAULT '' COMMENT '原因',
+   `change_time` BIGINT(20) NOT NULL COMMENT '变化时间,unix时间戳',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_goods_id_sku_id` (`goods_id`, `sku_id`),
+   INDEX `index_ref_id` (`ref_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '库存流水表,只追加不修改';
+
+-- 流水表上线前的商品补期初流水,期初数量是当前库存减去已有的流水,重复执行不会重复补
+-- 先补SKU,再按商品库存和全部流水的差额补商品
+INSERT INTO `stock_ledger_tb`(`goods_id`, `sku_id`, `type`, `quantity`, `reason`, `change_time`)
+SELECT t.`goods_id`, t.`sku_id`, 3, t.`quantity`, 'opening balance', UNIX_TIMESTAMP() FROM (
+   SELECT k.`goods_id`, k.`sku_id`, k.`stock` - COALESCE((SELECT SUM(l.`quantity`) FROM `stock_ledger_tb` l WHERE l.`goods_id` = k.`goods_id` AND l.`sku_id` = k.`sku_id`), 0) AS `quantity`
+   FROM `sku_tb` k
+   WHERE NOT EXISTS (SELECT 1 FROM `stock_ledger_tb` l WHERE l.`goods_id` = k.`goods_id` AND l.`sku_id` = k.`sku_id` AND l.`reason` = 'opening balance')
+) t WHERE t.`quantity` <> 0;
+
+INSERT INTO `stock_ledger_tb`(`goods_id`, `sku_id`, `type`, `quantity`, `reason`, `change_time`)
+SELECT t.`goods_id`, '', 3, t.`quantity`, 'opening balance', UNIX_TIMESTAMP() FROM (
+   SELECT g.`goods_id`, g.`stock` - COALESCE((SELECT SUM(l.`quantity`) FROM `stock_ledger_tb` l WHERE l.`goods_id` = g.`goods_id`), 0) AS `quantity`
+   FROM `goods_tb` g
+   WHERE NOT EXISTS (SELECT 1 FROM `stock_ledger_tb` l WHERE l.`goods_id` = g.`goods_id` AND l.`sku_id` = '' AND l.`reason` = 'opening balance')
+) t WHERE t.`quantity` <> 0;
+
+CREATE TABLE IF NOT EXISTS `restock_subscription_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品ID',
+   `user_id` VARCHAR(50) NOT NULL COMMENT '用户ID',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:等待到货 1:已通知 2:已取消',
+   `notify_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '通知时间,unix时间戳',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   UNIQUE INDEX `unique_goods_id_user_id` (`goods_id`, `user_id`),
+   INDEX `index_goods_id_status` (`goods_id`, `status`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '到货通知订阅表';
\ No newline at end of file
@@ -0,0 +1,35 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+type OrderStatusHistoryModel struct {
+	ID         int64     `gorm:"column:id"`
+	OrderID    string    `gorm:"column:order_id"`
+	FromStatus uint8     `gorm:"column:from_status"`
+	ToStatus   uint8     `gorm:"column:to_status"`
+	Actor      string    `gorm:"column:actor"`
+	Reason     string    `gorm:"column:reason"`
+	LogTime    int64     `gorm:"column:log_time"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m OrderStatusHistoryModel) TableName() string {
+	return "order_status_history_tb"
+}
+
+func GetOrderStatusHistories(db *gorm.DB, orderID string) ([]*OrderStatusHistoryModel, error) {
+	var histories []*OrderStatusHistoryModel
+	if err := db.Where("order_id = ?", orderID).Order("id asc").Find(&histories).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return histories, nil
+}
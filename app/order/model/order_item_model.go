@@ -0,0 +1,57 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+type OrderItemModel struct {
+	ID         int64     `gorm:"column:id"`
+	OrderID    string    `gorm:"column:order_id"`
+	GoodsID    string    `gorm:"column:goods_id"`
+	SkuID      string    `gorm:"column:sku_id"`
+	GoodsName  string    `gorm:"column:goods_name"`
+	Price      float64   `gorm:"column:price"`
+	Count      uint32    `gorm:"column:count"`
+	Amount     float64   `gorm:"column:amount"`
+	ActivityID string    `gorm:"column:activity_id"`
+	Discount   float64   `gorm:"column:discount"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m OrderItemModel) TableName() string {
+	return "order_item_tb"
+}
+
+func GetOrderItems(db *gorm.DB, orderID string) ([]*OrderItemModel, error) {
+	var items []*OrderItemModel
+	if err := db.Where("order_id = ?", orderID).Order("id asc").Find(&items).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return items, nil
+}
+
+func GetOrderItemsByOrderIDs(db *gorm.DB, orderIDs []string) (map[string][]*OrderItemModel, error) {
+	itemsMap := make(map[string][]*OrderItemModel)
+	if len(orderIDs) == 0 {
+		return itemsMap, nil
+	}
+
+	var items []*OrderItemModel
+	if err := db.Where("order_id in (?)", orderIDs).Order("id asc").Find(&items).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	for _, item := range items {
+		itemsMap[item.OrderID] = append(itemsMap[item.OrderID], item)
+	}
+
+	return itemsMap, nil
+}
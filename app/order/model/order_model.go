@@ -1,28 +1,110 @@
 package model
 
 import (
-	//"github.com/harveywangdao/ants/logger"
-	//"github.com/jinzhu/gorm"
 	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
 )
 
 type OrderModel struct {
-	ID         int64     `gorm:"column:id"`
-	OrderID    string    `gorm:"column:order_id"`
-	SellerID   string    `gorm:"column:seller_id"`
-	BuyerID    string    `gorm:"column:buyer_id"`
-	GoodsID    string    `gorm:"column:goods_id"`
-	GoodsName  string    `gorm:"column:goods_name"`
-	Count      uint32    `gorm:"column:count"`
-	Price      float64   `gorm:"column:price"`
-	Pay        float64   `gorm:"column:pay"`
-	Status     uint8     `gorm:"column:status"`
-	Remark     string    `gorm:"column:remark"`
-	CreateTime time.Time `gorm:"column:create_time;-"`
-	UpdateTime time.Time `gorm:"column:update_time;-"`
-	IsDelete   uint8     `gorm:"column:is_delete"`
+	ID             int64     `gorm:"column:id"`
+	OrderID        string    `gorm:"column:order_id"`
+	SellerID       string    `gorm:"column:seller_id"`
+	BuyerID        string    `gorm:"column:buyer_id"`
+	GoodsID        string    `gorm:"column:goods_id"`
+	GoodsName      string    `gorm:"column:goods_name"`
+	Count          uint32    `gorm:"column:count"`
+	Price          float64   `gorm:"column:price"`
+	Pay            float64   `gorm:"column:pay"`
+	Status         uint8     `gorm:"column:status"`
+	PayID          string    `gorm:"column:pay_id"`
+	Remark         string    `gorm:"column:remark"`
+	ActivityID     string    `gorm:"column:activity_id"`
+	Discount       float64   `gorm:"column:discount"`
+	CouponID       string    `gorm:"column:coupon_id"`
+	CouponDiscount float64   `gorm:"column:coupon_discount"`
+	CreateTime     time.Time `gorm:"column:create_time;-"`
+	UpdateTime     time.Time `gorm:"column:update_time;-"`
+	IsDelete       uint8     `gorm:"column:is_delete"`
 }
 
 func (m OrderModel) TableName() string {
 	return "order_tb"
 }
+
+const (
+	OrderSortByCreateTime = "createTime"
+	OrderSortByPrice      = "price"
+)
+
+type OrderFilter struct {
+	BuyerID   string
+	SellerID  string
+	GoodsID   string
+	Statuses  []uint32 // 不用[]uint8,gorm会当成[]byte
+	StartTime int64    // unix时间戳,包含
+	EndTime   int64    // unix时间戳,不包含
+}
+
+/*
+游标分页,和GetArticlesByPage3一样不用offset
+1.按创建时间排序时用自增id代替,游标是上一页最后一条的id
+2.按价格排序时游标是上一页最后一条的(price, id),id保证价格相同时顺序稳定
+3.lastID为0表示第一页
+*/
+type OrderPage struct {
+	SortBy     string
+	Asc        bool
+	LastID     int64
+	LastPrice  float64
+	NumPerPage int64
+}
+
+func ListOrders(db *gorm.DB, filter *OrderFilter, page *OrderPage) ([]*OrderModel, error) {
+	query := db.Where("is_delete = ?", 0)
+
+	if filter.BuyerID != "" {
+		query = query.Where("buyer_id = ?", filter.BuyerID)
+	}
+	if filter.SellerID != "" {
+		query = query.Where("seller_id = ?", filter.SellerID)
+	}
+	if filter.GoodsID != "" {
+		query = query.Where("goods_id = ? or order_id in (select order_id from order_item_tb where goods_id = ?)", filter.GoodsID, filter.GoodsID)
+	}
+	if len(filter.Statuses) != 0 {
+		query = query.Where("status in (?)", filter.Statuses)
+	}
+	if filter.StartTime > 0 {
+		query = query.Where("create_time >= ?", time.Unix(filter.StartTime, 0))
+	}
+	if filter.EndTime > 0 {
+		query = query.Where("create_time < ?", time.Unix(filter.EndTime, 0))
+	}
+
+	cmp, direction := "<", "desc"
+	if page.Asc {
+		cmp, direction = ">", "asc"
+	}
+
+	if page.SortBy == OrderSortByPrice {
+		if page.LastID > 0 {
+			query = query.Where("price "+cmp+" ? or (price = ? and id "+cmp+" ?)", page.LastPrice, page.LastPrice, page.LastID)
+		}
+		query = query.Order("price " + direction).Order("id " + direction)
+	} else {
+		if page.LastID > 0 {
+			query = query.Where("id "+cmp+" ?", page.LastID)
+		}
+		query = query.Order("id " + direction)
+	}
+
+	var orders []*OrderModel
+	if err := query.Limit(page.NumPerPage).Find(&orders).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return orders, nil
+}
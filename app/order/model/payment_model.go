@@ -0,0 +1,25 @@
+package model
+
+import (
+	"time"
+)
+
+type PaymentModel struct {
+	ID             int64     `gorm:"column:id"`
+	PayID          string    `gorm:"column:pay_id"`
+	OrderID        string    `gorm:"column:order_id"`
+	Provider       string    `gorm:"column:provider"`
+	TradeNo        string    `gorm:"column:trade_no"`
+	Amount         float64   `gorm:"column:amount"`
+	RefundedAmount float64   `gorm:"column:refunded_amount"`
+	Status         uint8     `gorm:"column:status"`
+	ExpireTime     int64     `gorm:"column:expire_time"`
+	NotifyTime     int64     `gorm:"column:notify_time"`
+	CreateTime     time.Time `gorm:"column:create_time;-"`
+	UpdateTime     time.Time `gorm:"column:update_time;-"`
+	IsDelete       uint8     `gorm:"column:is_delete"`
+}
+
+func (m PaymentModel) TableName() string {
+	return "payment_tb"
+}
@@ -0,0 +1,24 @@
+package model
+
+import (
+	"time"
+)
+
+type RefundModel struct {
+	ID         int64     `gorm:"column:id"`
+	RefundID   string    `gorm:"column:refund_id"`
+	OrderID    string    `gorm:"column:order_id"`
+	PayID      string    `gorm:"column:pay_id"`
+	Amount     float64   `gorm:"column:amount"`
+	Reason     string    `gorm:"column:reason"`
+	Actor      string    `gorm:"column:actor"`
+	Status     uint8     `gorm:"column:status"`
+	FinishTime int64     `gorm:"column:finish_time"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m RefundModel) TableName() string {
+	return "refund_tb"
+}
@@ -0,0 +1,56 @@
+package model
+
+import (
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+type SagaModel struct {
+	ID         int64     `gorm:"column:id"`
+	SagaID     string    `gorm:"column:saga_id"`
+	SagaType   string    `gorm:"column:saga_type"`
+	OrderID    string    `gorm:"column:order_id"`
+	PayID      string    `gorm:"column:pay_id"`
+	Step       int       `gorm:"column:step"`
+	Status     uint8     `gorm:"column:status"`
+	RetryTimes uint32    `gorm:"column:retry_times"`
+	LastError  string    `gorm:"column:last_error"`
+	Remark     string    `gorm:"column:remark"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m SagaModel) TableName() string {
+	return "saga_tb"
+}
+
+type SagaLogModel struct {
+	ID         int64     `gorm:"column:id"`
+	SagaID     string    `gorm:"column:saga_id"`
+	Step       int       `gorm:"column:step"`
+	StepName   string    `gorm:"column:step_name"`
+	Action     uint8     `gorm:"column:action"`
+	Result     uint8     `gorm:"column:result"`
+	ErrMsg     string    `gorm:"column:err_msg"`
+	LogTime    int64     `gorm:"column:log_time"`
+	CreateTime time.Time `gorm:"column:create_time;-"`
+	UpdateTime time.Time `gorm:"column:update_time;-"`
+	IsDelete   uint8     `gorm:"column:is_delete"`
+}
+
+func (m SagaLogModel) TableName() string {
+	return "saga_log_tb"
+}
+
+func GetSagaLogs(db *gorm.DB, sagaID string) ([]*SagaLogModel, error) {
+	var logs []*SagaLogModel
+	if err := db.Where("saga_id = ?", sagaID).Order("id asc").Find(&logs).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return logs, nil
+}
@@ -0,0 +1,327 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/util"
+)
+
+/*
+实时统计,订单支付成功时写入redis,按天和小时分桶
+1.支付人数用HyperLogLog,每个桶一个key,多个桶一起PFCOUNT得到去重后的人数
+2.GMV和订单数用hash,GMV按分累加避免浮点误差
+3.商品销量用有序集合按天保存,多天的排行先ZUNIONSTORE到临时key再取前N名
+4.所有key在保留天数后过期
+*/
+
+const (
+	AnalyticsPayersPrefix     = "AnalyticsPayers"     // hyperloglog,支付人数
+	AnalyticsSalesPrefix      = "AnalyticsSales"      // hash,gmv和订单数
+	AnalyticsGoodsSalesPrefix = "AnalyticsGoodsSales" // zset,商品销量
+	AnalyticsTopGoodsTemp     = "AnalyticsTopGoodsTemp"
+
+	AnalyticsGranularityDay  = "day"
+	AnalyticsGranularityHour = "hour"
+
+	AnalyticsDayLayout  = "20060102"
+	AnalyticsHourLayout = "2006010215"
+
+	AnalyticsDefaultRetentionDays = 90
+	AnalyticsMaxBuckets           = 31 * 24
+	AnalyticsDefaultTopN          = 10
+	AnalyticsMaxTopN              = 100
+	AnalyticsTempExpireSeconds    = 60
+)
+
+func analyticsBucket(t time.Time, granularity string) string {
+	if granularity == AnalyticsGranularityHour {
+		return AnalyticsGranularityHour + ":" + t.Format(AnalyticsHourLayout)
+	}
+	return AnalyticsGranularityDay + ":" + t.Format(AnalyticsDayLayout)
+}
+
+func (s *Service) analyticsExpireSeconds() int64 {
+	days := analyticsRetentionDays(s.Config)
+	if days <= 0 {
+		days = AnalyticsDefaultRetentionDays
+	}
+	return days * 24 * 3600
+}
+
+// 订单支付成功后记录统计数据,统计失败不影响订单
+func (s *Service) recordPaidOrder(order *model.OrderModel) error {
+	items, err := s.getOrderItems(order)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer conn.Close()
+
+	now := time.Now()
+	expire := s.analyticsExpireSeconds()
+	gmv := int64(math.Round(order.Price * 100))
+
+	for _, granularity := range []string{AnalyticsGranularityDay, AnalyticsGranularityHour} {
+		bucket := analyticsBucket(now, granularity)
+
+		payersKey := AnalyticsPayersPrefix + ":" + bucket
+		if err := conn.HyperLogLogAdd(payersKey, order.BuyerID); err != nil {
+			logger.Error(err)
+			return err
+		}
+		conn.Expire(payersKey, expire)
+
+		salesKey := AnalyticsSalesPrefix + ":" + bucket
+		if _, err := conn.HincrBy(salesKey, "gmv", gmv); err != nil {
+			logger.Error(err)
+			return err
+		}
+		if _, err := conn.HincrBy(salesKey, "orders", 1); err != nil {
+			logger.Error(err)
+			return err
+		}
+		conn.Expire(salesKey, expire)
+	}
+
+	goodsKey := AnalyticsGoodsSalesPrefix + ":" + analyticsBucket(now, AnalyticsGranularityDay)
+	for _, item := range items {
+		if _, err := conn.ZsetIncrBy(goodsKey, item.GoodsID, int64(item.Count)); err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+	conn.Expire(goodsKey, expire)
+
+	if order.ActivityID != "" {
+		activityKey := AnalyticsPayersPrefix + ":activity:" + order.ActivityID
+		if err := conn.HyperLogLogAdd(activityKey, order.BuyerID); err != nil {
+			logger.Error(err)
+			return err
+		}
+		conn.Expire(activityKey, expire)
+	}
+
+	return nil
+}
+
+// 时间范围内的所有桶,包含开始时间所在的桶,不包含结束时间
+func analyticsBuckets(granularity, startTime, endTime string) ([]time.Time, error) {
+	if granularity == "" {
+		granularity = AnalyticsGranularityDay
+	}
+	if granularity != AnalyticsGranularityDay && granularity != AnalyticsGranularityHour {
+		return nil, fmt.Errorf("granularity %s not supported", granularity)
+	}
+
+	if startTime == "" || endTime == "" {
+		return nil, errors.New("startTime or endTime is null")
+	}
+
+	start, err := time.ParseInLocation(ActivityTimeLayout, startTime, time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	end, err := time.ParseInLocation(ActivityTimeLayout, endTime, time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if granularity == AnalyticsGranularityHour {
+		start = time.Date(start.Year(), start.Month(), start.Day(), start.Hour(), 0, 0, 0, time.Local)
+	} else {
+		start = time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+	}
+
+	var buckets []time.Time
+	for t := start; t.Before(end); {
+		if len(buckets) >= AnalyticsMaxBuckets {
+			return nil, fmt.Errorf("time range can not have more than %d buckets", AnalyticsMaxBuckets)
+		}
+		buckets = append(buckets, t)
+
+		if granularity == AnalyticsGranularityHour {
+			t = t.Add(time.Hour)
+		} else {
+			t = t.AddDate(0, 0, 1)
+		}
+	}
+
+	return buckets, nil
+}
+
+// 支付人数,指定活动时只返回活动的总人数
+func (s *Service) GetUniquePayers(ctx context.Context, req *proto.GetUniquePayersRequest) (*proto.GetUniquePayersResponse, error) {
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	if req.ActivityID != "" {
+		total, err := conn.HyperLogLogCount(AnalyticsPayersPrefix + ":activity:" + req.ActivityID)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		return &proto.GetUniquePayersResponse{
+			Total: total,
+		}, nil
+	}
+
+	buckets, err := analyticsBuckets(req.Granularity, req.StartTime, req.EndTime)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.GetUniquePayersResponse{}
+	if len(buckets) == 0 {
+		return resp, nil
+	}
+
+	var keys []string
+	for _, t := range buckets {
+		key := AnalyticsPayersPrefix + ":" + analyticsBucket(t, req.Granularity)
+		keys = append(keys, key)
+
+		payers, err := conn.HyperLogLogCount(key)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		resp.Buckets = append(resp.Buckets, &proto.PayersBucket{
+			Time:   t.Format(ActivityTimeLayout),
+			Payers: payers,
+		})
+	}
+
+	// 跨桶去重
+	resp.Total, err = conn.HyperLogLogCount(keys...)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetSalesStats(ctx context.Context, req *proto.GetSalesStatsRequest) (*proto.GetSalesStatsResponse, error) {
+	buckets, err := analyticsBuckets(req.Granularity, req.StartTime, req.EndTime)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	var totalGmv int64
+	resp := &proto.GetSalesStatsResponse{}
+	for _, t := range buckets {
+		values, err := conn.Hmget(AnalyticsSalesPrefix+":"+analyticsBucket(t, req.Granularity), []string{"gmv", "orders"})
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		// 没有数据的桶取到空字符串
+		gmv, _ := strconv.ParseInt(values[0], 10, 64)
+		orders, _ := strconv.ParseInt(values[1], 10, 64)
+		totalGmv += gmv
+		resp.OrderCount += orders
+
+		resp.Buckets = append(resp.Buckets, &proto.SalesBucket{
+			Time:       t.Format(ActivityTimeLayout),
+			Gmv:        float64(gmv) / 100,
+			OrderCount: orders,
+		})
+	}
+	resp.Gmv = float64(totalGmv) / 100
+
+	return resp, nil
+}
+
+// 商品销量排行,按天统计
+func (s *Service) GetTopGoods(ctx context.Context, req *proto.GetTopGoodsRequest) (*proto.GetTopGoodsResponse, error) {
+	if req.TopN > AnalyticsMaxTopN {
+		return nil, fmt.Errorf("topN can not be more than %d", AnalyticsMaxTopN)
+	}
+
+	topN := int(req.TopN)
+	if topN == 0 {
+		topN = AnalyticsDefaultTopN
+	}
+
+	buckets, err := analyticsBuckets(AnalyticsGranularityDay, req.StartTime, req.EndTime)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.GetTopGoodsResponse{}
+	if len(buckets) == 0 {
+		return resp, nil
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	key := AnalyticsGoodsSalesPrefix + ":" + analyticsBucket(buckets[0], AnalyticsGranularityDay)
+	if len(buckets) > 1 {
+		var keys []string
+		for _, t := range buckets {
+			keys = append(keys, AnalyticsGoodsSalesPrefix+":"+analyticsBucket(t, AnalyticsGranularityDay))
+		}
+
+		key = AnalyticsTopGoodsTemp + ":" + util.GetUUID()
+		if err := conn.ZsetUnionStore(key, keys...); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+		defer conn.DeleteKey(key)
+		conn.Expire(key, AnalyticsTempExpireSeconds)
+	}
+
+	goodsIDs, sales, err := conn.ZsetRevRangeWithScores(key, 0, topN-1)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	for i := range goodsIDs {
+		resp.Goods = append(resp.Goods, &proto.GoodsSales{
+			GoodsID: goodsIDs[i],
+			Sales:   sales[i],
+		})
+	}
+
+	return resp, nil
+}
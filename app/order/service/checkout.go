@@ -0,0 +1,246 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	goodspb "github.com/harveywangdao/ants/rpc/goods"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/util"
+)
+
+const (
+	CheckoutMaxItems = 50
+)
+
+/*
+购物车结算,一个订单多个商品
+1.按下单时的商品名称和下单时刻生效的单价(有SKU的按SKU价格)生成订单商品快照,退款按订单快照金额,不受之后调价影响
+2.每个商品和单独下单一样选择优惠最多的促销
+3.所有商品在商品服务的同一个事务里预占库存,任一商品库存不足整单取消
+4.订单表的goods_id只在单个商品时填写,goods_name是商品名称拼接,count和price是合计
+*/
+func (s *Service) Checkout(ctx context.Context, req *proto.CheckoutRequest) (*proto.CheckoutResponse, error) {
+	if req.BuyerID == "" {
+		return nil, errors.New("buyerID is null")
+	}
+
+	if len(req.Items) == 0 {
+		return nil, errors.New("cart is empty")
+	}
+
+	// 同一个商品的同一个SKU合并数量
+	var cartItems []*proto.CartItem
+	merged := make(map[string]*proto.CartItem)
+	for _, cartItem := range req.Items {
+		if cartItem.GoodsID == "" || cartItem.Count == 0 {
+			return nil, errors.New("goodsID or count is null")
+		}
+
+		key := cartItem.GoodsID + "/" + cartItem.SkuID
+		if m, ok := merged[key]; ok {
+			m.Count += cartItem.Count
+			continue
+		}
+		m := &proto.CartItem{
+			GoodsID: cartItem.GoodsID,
+			SkuID:   cartItem.SkuID,
+			Count:   cartItem.Count,
+		}
+		merged[key] = m
+		cartItems = append(cartItems, m)
+	}
+
+	if len(cartItems) > CheckoutMaxItems {
+		return nil, fmt.Errorf("cart can not have more than %d goods", CheckoutMaxItems)
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	now := time.Now()
+	items, sellerID, err := s.checkoutItems(ctx, conn, req.BuyerID, cartItems, now)
+	if err != nil {
+		return nil, err
+	}
+
+	order := &model.OrderModel{
+		OrderID:  util.GetUUID(),
+		SellerID: sellerID,
+		BuyerID:  req.BuyerID,
+		Status:   OrderStatusCreated,
+	}
+	var names []string
+	for _, item := range items {
+		order.Count += item.Count
+		order.Price += item.Amount
+		order.Discount += item.Discount
+		names = append(names, item.GoodsName)
+		// 多个商品用了不同的促销时订单上记第一个,明细在订单商品上
+		if order.ActivityID == "" {
+			order.ActivityID = item.ActivityID
+		}
+	}
+	order.Discount = roundPrice(order.Discount)
+	if len(items) == 1 {
+		order.GoodsID = items[0].GoodsID
+	}
+	// goods_name最长100个字符
+	goodsName := []rune(strings.Join(names, ","))
+	if len(goodsName) > 100 {
+		goodsName = goodsName[:100]
+	}
+	order.GoodsName = string(goodsName)
+
+	if req.CouponID != "" {
+		code, codeMsg, err := s.redeemOrderCoupon(ctx, order, req.CouponID)
+		if err != nil || code != 0 {
+			s.releaseItemsPromotion(req.BuyerID, items)
+			if err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+			return &proto.CheckoutResponse{
+				Code:    code,
+				CodeMsg: codeMsg,
+			}, nil
+		}
+	}
+
+	if err := s.createOrder(order, items, "buyer:"+req.BuyerID, "checkout"); err != nil {
+		logger.Error(err)
+		s.releaseItemsPromotion(req.BuyerID, items)
+		s.returnOrderCoupon(order)
+		return nil, err
+	}
+
+	reserveStocksResp, err := s.reserveOrderStock(ctx, order, items, "buyer:"+req.BuyerID)
+	if err != nil {
+		logger.Error(err)
+		s.cancelUnreservedOrder(order, "buyer:"+req.BuyerID)
+		return nil, err
+	}
+
+	if reserveStocksResp.Code != 0 && reserveStocksResp.Code != common.ErrReserveStockRepeat {
+		return &proto.CheckoutResponse{
+			Code:          reserveStocksResp.Code,
+			CodeMsg:       reserveStocksResp.CodeMsg,
+			OrderID:       order.OrderID,
+			FailedGoodsID: reserveStocksResp.FailedGoodsID,
+			FailedSkuID:   reserveStocksResp.FailedSkuID,
+		}, nil
+	}
+
+	return &proto.CheckoutResponse{
+		CodeMsg: "checkout success",
+		OrderID: order.OrderID,
+	}, nil
+}
+
+// 生成订单商品,失败时归还已经占用的促销限购数量
+func (s *Service) checkoutItems(ctx context.Context, conn *redis.Redis, buyerID string, cartItems []*proto.CartItem, now time.Time) (items []*model.OrderItemModel, sellerID string, err error) {
+	defer func() {
+		if err != nil {
+			s.releaseItemsPromotion(buyerID, items)
+		}
+	}()
+
+	for _, cartItem := range cartItems {
+		goodsID := cartItem.GoodsID
+
+		// 秒杀商品库存在redis,只能单独下单
+		fs, err := s.getFlashSale(conn, goodsID)
+		if err != nil {
+			logger.Error(err)
+			return items, "", err
+		}
+		if fs != nil && fs.isActive(now) {
+			return items, "", fmt.Errorf("goods %s is in flash sale, please order it separately", goodsID)
+		}
+
+		getGoodsResp, err := s.GoodsServiceClient.GetGoods(ctx, &goodspb.GetGoodsRequest{GoodsID: goodsID, FromDB: true})
+		if err != nil {
+			logger.Error(err)
+			return items, "", err
+		}
+
+		// 一个订单只属于一个商家
+		if len(items) != 0 && getGoodsResp.SellerID != sellerID {
+			return items, "", fmt.Errorf("goods %s belongs to another seller, please checkout separately", goodsID)
+		}
+		sellerID = getGoodsResp.SellerID
+
+		price, err := s.goodsPriceAt(ctx, goodsID, cartItem.SkuID, now)
+		if err != nil {
+			return items, "", err
+		}
+
+		categories, err := s.goodsCategoryPath(ctx, getGoodsResp.GoodsInfo.Category)
+		if err != nil {
+			return items, "", err
+		}
+
+		count := cartItem.Count
+		p, amount, err := s.applyBestPromotion(conn, buyerID, goodsID, categories, price, count)
+		if err != nil {
+			logger.Error(err)
+			return items, "", err
+		}
+
+		item := &model.OrderItemModel{
+			GoodsID:   goodsID,
+			SkuID:     cartItem.SkuID,
+			GoodsName: getGoodsResp.GoodsInfo.Name,
+			Price:     price,
+			Count:     count,
+			Amount:    amount,
+		}
+		if p != nil {
+			item.ActivityID = p.ActivityID
+			item.Discount = roundPrice(price*float64(count) - amount)
+		}
+		items = append(items, item)
+	}
+
+	return items, sellerID, nil
+}
+
+// 没有订单商品的旧订单按订单表的商品生成一条
+func (s *Service) getOrderItems(order *model.OrderModel) ([]*model.OrderItemModel, error) {
+	items, err := model.GetOrderItems(s.db, order.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if len(items) != 0 {
+		return items, nil
+	}
+
+	var price float64
+	if order.Count != 0 {
+		price = order.Price / float64(order.Count)
+	}
+
+	return []*model.OrderItemModel{
+		{
+			OrderID:   order.OrderID,
+			GoodsID:   order.GoodsID,
+			GoodsName: order.GoodsName,
+			Price:     price,
+			Count:     order.Count,
+			Amount:    order.Price,
+		},
+	}, nil
+}
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/harveywangdao/ants/logger"
+	couponpb "github.com/harveywangdao/ants/rpc/coupon"
 	goodspb "github.com/harveywangdao/ants/rpc/goods"
 	"google.golang.org/grpc"
 )
@@ -42,3 +43,16 @@ func (s *Service) initGoodsServiceClient() error {
 
 	return nil
 }
+
+func (s *Service) initCouponServiceClient() error {
+	conn, err := s.getServiceClientConn(s.Config.Client.CouponServiceName)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	//defer conn.Close()
+
+	s.CouponServiceClient = couponpb.NewCouponServiceClient(conn)
+
+	return nil
+}
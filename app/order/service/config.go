@@ -38,8 +38,9 @@ type DatabaseConfig struct {
 }
 
 type ClientConfig struct {
-	UserServiceName  string `yaml:"userServiceName" json:"userServiceName"`
-	GoodsServiceName string `yaml:"goodsServiceName" json:"goodsServiceName"`
+	UserServiceName   string `yaml:"userServiceName" json:"userServiceName"`
+	GoodsServiceName  string `yaml:"goodsServiceName" json:"goodsServiceName"`
+	CouponServiceName string `yaml:"couponServiceName" json:"couponServiceName"`
 }
 
 type RedisConfig struct {
@@ -57,16 +58,47 @@ type NsqConfig struct {
 	NodeAddrs    []string `yaml:"nodeAddrs" json:"nodeAddrs"`
 }
 
+type OrderConfig struct {
+	ReserveExpireSeconds int64 `yaml:"reserveExpireSeconds" json:"reserveExpireSeconds"`
+	PayExpireSeconds     int64 `yaml:"payExpireSeconds" json:"payExpireSeconds"`
+}
+
+type PaymentConfig struct {
+	Provider          string  `yaml:"provider" json:"provider"`
+	NotifyURL         string  `yaml:"notifyURL" json:"notifyURL"`
+	Secret            string  `yaml:"secret" json:"secret"`
+	ExpireSeconds     int64   `yaml:"expireSeconds" json:"expireSeconds"`
+	MockCallbackDelay int64   `yaml:"mockCallbackDelay" json:"mockCallbackDelay"`
+	MockFailRate      float64 `yaml:"mockFailRate" json:"mockFailRate"`
+}
+
+type IdempotencyConfig struct {
+	TTLSeconds int64 `yaml:"ttlSeconds" json:"ttlSeconds"`
+}
+
+type SoftDeleteConfig struct {
+	RetentionDays int64 `yaml:"retentionDays" json:"retentionDays"`
+}
+
+type AnalyticsConfig struct {
+	RetentionDays int64 `yaml:"retentionDays" json:"retentionDays"`
+}
+
 type Config struct {
-	Log        *LogConfig        `yaml:"log" json:"log"`
-	Etcd       *EtcdConfig       `yaml:"etcd" json:"etcd"`
-	Server     *ServerConfig     `yaml:"server" json:"server"`
-	HttpServer *HttpServerConfig `yaml:"httpServer" json:"httpServer"`
-	Database   *DatabaseConfig   `yaml:"database" json:"database"`
-	Client     *ClientConfig     `yaml:"client" json:"client"`
-	Redis      *RedisConfig      `yaml:"redis" json:"redis"`
-	Kafka      *KafkaConfig      `yaml:"kafka" json:"kafka"`
-	Nsq        *NsqConfig        `yaml:"nsq" json:"nsq"`
+	Log         *LogConfig         `yaml:"log" json:"log"`
+	Etcd        *EtcdConfig        `yaml:"etcd" json:"etcd"`
+	Server      *ServerConfig      `yaml:"server" json:"server"`
+	HttpServer  *HttpServerConfig  `yaml:"httpServer" json:"httpServer"`
+	Database    *DatabaseConfig    `yaml:"database" json:"database"`
+	Client      *ClientConfig      `yaml:"client" json:"client"`
+	Redis       *RedisConfig       `yaml:"redis" json:"redis"`
+	Kafka       *KafkaConfig       `yaml:"kafka" json:"kafka"`
+	Nsq         *NsqConfig         `yaml:"nsq" json:"nsq"`
+	Order       *OrderConfig       `yaml:"order" json:"order"`
+	Payment     *PaymentConfig     `yaml:"payment" json:"payment"`
+	Idempotency *IdempotencyConfig `yaml:"idempotency" json:"idempotency"`
+	SoftDelete  *SoftDeleteConfig  `yaml:"softDelete" json:"softDelete"`
+	Analytics   *AnalyticsConfig   `yaml:"analytics" json:"analytics"`
 }
 
 func getConfig() (*Config, error) {
@@ -87,3 +119,24 @@ func getConfig() (*Config, error) {
 	logger.Debug("config:", string(data))
 	return &config, nil
 }
+
+func idempotencyTTLSeconds(config *Config) int64 {
+	if config.Idempotency == nil {
+		return 0
+	}
+	return config.Idempotency.TTLSeconds
+}
+
+func softDeleteRetentionDays(config *Config) int64 {
+	if config.SoftDelete == nil {
+		return 0
+	}
+	return config.SoftDelete.RetentionDays
+}
+
+func analyticsRetentionDays(config *Config) int64 {
+	if config.Analytics == nil {
+		return 0
+	}
+	return config.Analytics.RetentionDays
+}
@@ -0,0 +1,62 @@
+package service
+
+import (
+	"context"
+	"errors"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	couponpb "github.com/harveywangdao/ants/rpc/coupon"
+)
+
+// 用优惠券抵扣订单金额,必须在订单落库前调用,code不为0表示优惠券不可用
+func (s *Service) redeemOrderCoupon(ctx context.Context, order *model.OrderModel, couponID string) (uint32, string, error) {
+	redeemReq := &couponpb.RedeemCouponRequest{
+		CouponID: couponID,
+		UserID:   order.BuyerID,
+		OrderID:  order.OrderID,
+		Amount:   order.Price,
+	}
+	redeemResp, err := s.CouponServiceClient.RedeemCoupon(ctx, redeemReq)
+	if err != nil {
+		logger.Error(err)
+		// 调用失败时不确定是否已核销,order.CouponID还没设置,按券ID和订单号直接退回一次
+		s.returnOrderCoupon(&model.OrderModel{OrderID: order.OrderID, CouponID: couponID})
+		return 0, "", err
+	}
+
+	if redeemResp.Code != 0 {
+		return redeemResp.Code, redeemResp.CodeMsg, nil
+	}
+
+	order.CouponID = couponID
+	order.CouponDiscount = redeemResp.Discount
+	order.Price = roundPrice(order.Price - redeemResp.Discount)
+
+	return 0, "", nil
+}
+
+// 订单取消或退款后退回优惠券,已经退回的不算失败
+func (s *Service) returnOrderCoupon(order *model.OrderModel) error {
+	if order.CouponID == "" {
+		return nil
+	}
+
+	returnReq := &couponpb.ReturnCouponRequest{
+		CouponID: order.CouponID,
+		OrderID:  order.OrderID,
+	}
+	returnResp, err := s.CouponServiceClient.ReturnCoupon(context.Background(), returnReq)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if returnResp.Code != 0 && returnResp.Code != common.ErrCouponNotRedeemed {
+		logger.Error("Code:", returnResp.Code, "CodeMsg:", returnResp.CodeMsg)
+		return errors.New(returnResp.CodeMsg)
+	}
+
+	return nil
+}
@@ -0,0 +1,187 @@
+package service
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+延时任务
+1.任务存在redis有序集合里,成员是"任务类型:业务ID",分数是到期的时间戳
+2.多实例时用分布式锁保证同一时间只有一个实例在取任务
+3.任务执行失败的一分钟后重试,处理函数返回的时间不为0时按这个时间重新排期
+*/
+
+const (
+	DelayJobQueue = "OrderDelayJobQueue"
+	DelayJobLock  = "OrderDelayJobLock"
+
+	DelayJobTimerDuration = time.Second
+	DelayJobBatchSize     = 100
+	DelayJobRetrySeconds  = 60
+
+	DelayJobOrderExpire = "orderExpire"
+)
+
+const (
+	OrderDefaultPayExpireSeconds = 900
+)
+
+// 返回下次执行的时间戳,0表示任务完成
+type delayJobHandler func(ctx context.Context, id string) (int64, error)
+
+func (s *Service) delayJobHandlers() map[string]delayJobHandler {
+	return map[string]delayJobHandler{
+		DelayJobOrderExpire: s.expireOrder,
+	}
+}
+
+func (s *Service) addDelayJob(conn *redis.Redis, jobType, id string, dueTime int64) error {
+	return conn.ZsetAdd(DelayJobQueue, jobType+":"+id, dueTime)
+}
+
+func DelayJobStartRun(s *Service) {
+	go func() {
+		ticker := time.NewTicker(DelayJobTimerDuration)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.runDelayJobs()
+			}
+		}
+	}()
+}
+
+func (s *Service) runDelayJobs() {
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+	defer conn.Close()
+
+	now := time.Now().Unix()
+	if n, err := conn.ZsetLenBetweenScores(DelayJobQueue, 0, now); err != nil || n == 0 {
+		return
+	}
+
+	lock := redis.NewDistLock(s.RedisPool, DelayJobLock, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		return
+	}
+	defer lock.Unlock()
+
+	jobs, err := conn.ZsetRangeByScore(DelayJobQueue, 0, now, DelayJobBatchSize)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	handlers := s.delayJobHandlers()
+	for _, job := range jobs {
+		fields := strings.SplitN(job, ":", 2)
+		handler, ok := handlers[fields[0]]
+		if len(fields) != 2 || !ok {
+			logger.Error("unknown delay job", job)
+			conn.ZsetRemove(DelayJobQueue, job)
+			continue
+		}
+
+		next, err := handler(context.Background(), fields[1])
+		if err != nil {
+			logger.Error("delay job", job, "fail:", err)
+			next = time.Now().Unix() + DelayJobRetrySeconds
+		}
+
+		if next != 0 {
+			err = conn.ZsetAdd(DelayJobQueue, job, next)
+		} else {
+			err = conn.ZsetRemove(DelayJobQueue, job)
+		}
+		if err != nil {
+			logger.Error(err)
+		}
+	}
+}
+
+func (s *Service) orderPayExpireSeconds() int64 {
+	if s.Config.Order != nil && s.Config.Order.PayExpireSeconds > 0 {
+		return s.Config.Order.PayExpireSeconds
+	}
+	return OrderDefaultPayExpireSeconds
+}
+
+/*
+超时未支付的订单自动取消,并释放预占的库存
+1.正在支付的订单等支付单超时后再处理
+2.支付成功但订单状态还没修改的,等saga推进后再检查
+3.已取消的订单只释放库存,释放接口可以重复调用
+*/
+func (s *Service) expireOrder(ctx context.Context, orderID string) (int64, error) {
+	lock, err := s.lockOrderPayment(orderID)
+	if err != nil {
+		return 0, err
+	}
+	defer lock.Unlock()
+
+	order, err := s.getOrderIfExisted(orderID)
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	if order == nil {
+		return 0, nil
+	}
+
+	// 上次取消后释放库存失败的重试
+	if order.Status == OrderStatusCancelled {
+		return 0, s.releaseOrderReservation(ctx, order)
+	}
+
+	if !isOrderUnpaid(order) {
+		return 0, nil
+	}
+
+	now := time.Now().Unix()
+
+	var p model.PaymentModel
+	err = s.db.Where("order_id = ?", orderID).Order("id desc").First(&p).Error
+	if err == nil {
+		if p.Status == PaymentStatusPending && p.ExpireTime > now {
+			return p.ExpireTime, nil
+		}
+		if p.Status == PaymentStatusSucceeded {
+			return now + DelayJobRetrySeconds, nil
+		}
+	} else if !gorm.IsRecordNotFoundError(err) {
+		logger.Error(err)
+		return 0, err
+	}
+
+	err = s.changeOrderStatus(order, OrderStatusCancelled, OrderActorSystem, "payment timeout", nil)
+	switch err {
+	case nil:
+	case ErrOrderStatusChanged:
+		return now, nil
+	case ErrOrderStatusIllegal:
+		return 0, nil
+	default:
+		logger.Error(err)
+		return 0, err
+	}
+
+	if err := s.releaseOrderReservation(ctx, order); err != nil {
+		return 0, err
+	}
+
+	logger.Info("order", orderID, "expired")
+	return 0, nil
+}
@@ -320,12 +320,13 @@ func (s *Service) deductStockEvent(data []byte) error {
 		logger.Error(err)
 		return err
 	}
-	if order.Status != OrderStatusUnpaid {
+	if !isOrderUnpaid(&order) {
 		return nil
 	}
 
 	getGoodsReq := &goodspb.GetGoodsRequest{
 		GoodsID: order.GoodsID,
+		FromDB:  true,
 	}
 	getGoodsResp, err := s.GoodsServiceClient.GetGoods(ctx, getGoodsReq)
 	if err != nil {
@@ -361,10 +362,9 @@ func (s *Service) deductStockEvent(data []byte) error {
 
 	// 修改订单状态
 	param := map[string]interface{}{
-		"status": OrderStatusPaid,
-		"pay":    order.Price,
+		"pay": order.Price,
 	}
-	if err := s.db.Model(model.OrderModel{}).Where("order_id = ?", req.OrderID).Updates(param).Error; err != nil {
+	if err := s.changeOrderStatus(&order, OrderStatusPaid, OrderActorSystem, "deduct stock event", param); err != nil {
 		logger.Error(err)
 		// 要不要重试
 		return nil
@@ -0,0 +1,551 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	goodspb "github.com/harveywangdao/ants/rpc/goods"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/util"
+)
+
+/*
+秒杀
+1.开启秒杀时把MySQL库存预加载到redis
+2.下单时用lua脚本原子地预扣redis库存并把订单放入队列,不访问MySQL
+3.后台任务用RPOPLPUSH把订单从队列移到处理中队列,预占MySQL库存并落库后再从处理中队列删除,进程崩溃或落库失败的订单下次先处理,连续失败多次的移到死信队列,不再阻塞后面的订单
+4.定时在队列为空时用MySQL库存校准redis库存,活动结束后自动关闭秒杀
+*/
+
+const (
+	FlashSalePrefix      = "FlashSale"      // hash,秒杀配置
+	FlashSaleStockPrefix = "FlashSaleStock" // string,redis中的秒杀库存
+	FlashSaleGoodsSet    = "FlashSaleGoodsSet"
+	FlashSaleOrderList   = "FlashSaleOrderList"
+	FlashSaleProcessList = "FlashSaleProcessList" // list,正在落库的订单
+	FlashSaleRetryHash   = "FlashSaleRetryHash"   // hash,订单落库失败次数
+	FlashSaleDeadList    = "FlashSaleDeadList"    // list,多次落库失败的订单,人工处理
+	FlashSaleLock        = "FlashSaleLock"
+
+	FlashSalePersistTimerDuration   = 1 * time.Second
+	FlashSaleReconcileTimerDuration = 30 * time.Second
+	FlashSalePersistBatchSize       = 500
+	FlashSalePersistMaxRetry        = 5
+
+	ActivityTimeLayout = "2006-01-02 15:04:05"
+)
+
+// KEYS[1]:库存 KEYS[2]:订单队列 ARGV[1]:数量 ARGV[2]:订单
+const flashSaleDeductScript = `
+local stock = tonumber(redis.call("get", KEYS[1]))
+if stock == nil then
+    return -2
+end
+if stock < tonumber(ARGV[1]) then
+    return -1
+end
+redis.call("decrby", KEYS[1], ARGV[1])
+redis.call("lpush", KEYS[2], ARGV[2])
+return stock - tonumber(ARGV[1])`
+
+// 队列或处理中队列里还有未落库的订单时不校准
+const flashSaleReconcileScript = `
+if redis.call("llen", KEYS[2]) > 0 or redis.call("llen", KEYS[3]) > 0 then
+    return 0
+end
+redis.call("set", KEYS[1], ARGV[1])
+return 1`
+
+type flashSale struct {
+	ActivityID string
+	GoodsID    string
+	SellerID   string
+	GoodsName  string
+	Price      float64
+	StartTime  time.Time
+	EndTime    time.Time
+}
+
+type flashSaleOrder struct {
+	OrderID    string  `json:"orderID"`
+	BuyerID    string  `json:"buyerID"`
+	GoodsID    string  `json:"goodsID"`
+	SellerID   string  `json:"sellerID"`
+	GoodsName  string  `json:"goodsName"`
+	Count      uint32  `json:"count"`
+	Price      float64 `json:"price"`
+	ActivityID string  `json:"activityID"`
+}
+
+func (s *Service) getActivityTime(conn *redis.Redis, activityID string) (time.Time, time.Time, error) {
+	if !conn.IsKeyExist(ActivityPrefix + activityID) {
+		return time.Time{}, time.Time{}, fmt.Errorf("activity %s not existed", activityID)
+	}
+
+	activityInfo, err := conn.Hgetall(ActivityPrefix + activityID)
+	if err != nil {
+		logger.Error(err)
+		return time.Time{}, time.Time{}, err
+	}
+
+	startTime, err := time.ParseInLocation(ActivityTimeLayout, activityInfo["startTime"], time.Local)
+	if err != nil {
+		logger.Error(err)
+		return time.Time{}, time.Time{}, err
+	}
+
+	endTime, err := time.ParseInLocation(ActivityTimeLayout, activityInfo["endTime"], time.Local)
+	if err != nil {
+		logger.Error(err)
+		return time.Time{}, time.Time{}, err
+	}
+
+	return startTime, endTime, nil
+}
+
+// 没有开启秒杀时返回nil
+func (s *Service) getFlashSale(conn *redis.Redis, goodsID string) (*flashSale, error) {
+	if !conn.IsKeyExist(FlashSalePrefix + goodsID) {
+		return nil, nil
+	}
+
+	info, err := conn.Hgetall(FlashSalePrefix + goodsID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	price, err := strconv.ParseFloat(info["price"], 64)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	startTime, endTime, err := s.getActivityTime(conn, info["activityID"])
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &flashSale{
+		ActivityID: info["activityID"],
+		GoodsID:    goodsID,
+		SellerID:   info["sellerID"],
+		GoodsName:  info["goodsName"],
+		Price:      price,
+		StartTime:  startTime,
+		EndTime:    endTime,
+	}, nil
+}
+
+func (f *flashSale) isActive(now time.Time) bool {
+	return !now.Before(f.StartTime) && now.Before(f.EndTime)
+}
+
+func (s *Service) SetFlashSale(ctx context.Context, req *proto.SetFlashSaleRequest) (*proto.SetFlashSaleResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	lock := redis.NewDistLock(s.RedisPool, "SetFlashSale"+req.GoodsID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	if !req.Enable {
+		if err := s.disableFlashSale(conn, req.GoodsID); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		return &proto.SetFlashSaleResponse{
+			CodeMsg: "disable flash sale success",
+		}, nil
+	}
+
+	if req.ActivityID == "" {
+		return nil, errors.New("activityID is null")
+	}
+
+	_, endTime, err := s.getActivityTime(conn, req.ActivityID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !time.Now().Before(endTime) {
+		return nil, fmt.Errorf("activity %s already ended", req.ActivityID)
+	}
+
+	getGoodsResp, err := s.GoodsServiceClient.GetGoods(ctx, &goodspb.GetGoodsRequest{GoodsID: req.GoodsID, FromDB: true})
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	price := req.Price
+	if price <= 0 {
+		price = getGoodsResp.GoodsInfo.Price
+	}
+
+	m := map[string]interface{}{
+		"activityID": req.ActivityID,
+		"sellerID":   getGoodsResp.SellerID,
+		"goodsName":  getGoodsResp.GoodsInfo.Name,
+		"price":      strconv.FormatFloat(price, 'f', -1, 64),
+	}
+	if err := conn.Hmset(FlashSalePrefix+req.GoodsID, m); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 预加载库存,已经加载过的由定时校准
+	if !conn.IsKeyExist(FlashSaleStockPrefix + req.GoodsID) {
+		if err := conn.Set(FlashSaleStockPrefix+req.GoodsID, strconv.Itoa(int(getGoodsResp.GoodsInfo.Stock))); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
+	if err := conn.SetAdd(FlashSaleGoodsSet, req.GoodsID); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.SetFlashSaleResponse{
+		CodeMsg: "enable flash sale success",
+	}, nil
+}
+
+// 已经进入队列的订单不受影响,仍然会落库
+func (s *Service) disableFlashSale(conn *redis.Redis, goodsID string) error {
+	if err := conn.DeleteKey(FlashSalePrefix + goodsID); err != nil {
+		return err
+	}
+
+	if err := conn.DeleteKey(FlashSaleStockPrefix + goodsID); err != nil {
+		return err
+	}
+
+	return conn.SetRemove(FlashSaleGoodsSet, goodsID)
+}
+
+func (s *Service) GetFlashSale(ctx context.Context, req *proto.GetFlashSaleRequest) (*proto.GetFlashSaleResponse, error) {
+	if req.GoodsID == "" {
+		return nil, errors.New("goodsID is null")
+	}
+
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	fs, err := s.getFlashSale(conn, req.GoodsID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if fs == nil {
+		return &proto.GetFlashSaleResponse{
+			GoodsID: req.GoodsID,
+		}, nil
+	}
+
+	stock, err := conn.GetInt64(FlashSaleStockPrefix + req.GoodsID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.GetFlashSaleResponse{
+		ActivityID: fs.ActivityID,
+		GoodsID:    fs.GoodsID,
+		Enable:     fs.isActive(time.Now()),
+		Price:      fs.Price,
+		Stock:      stock,
+	}, nil
+}
+
+// 秒杀下单只预扣redis库存,订单异步落库
+func (s *Service) addFlashSaleOrder(ctx context.Context, conn *redis.Redis, req *proto.AddOrderRequest, fs *flashSale) (*proto.AddOrderResponse, error) {
+	order := &flashSaleOrder{
+		OrderID:    util.GetUUID(),
+		BuyerID:    req.BuyerID,
+		GoodsID:    req.GoodsID,
+		SellerID:   fs.SellerID,
+		GoodsName:  fs.GoodsName,
+		Count:      req.Count,
+		Price:      fs.Price * float64(req.Count),
+		ActivityID: fs.ActivityID,
+	}
+
+	data, err := json.Marshal(order)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	left, err := conn.EvalInt64(2, flashSaleDeductScript, FlashSaleStockPrefix+req.GoodsID, FlashSaleOrderList, req.Count, string(data))
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	switch {
+	case left == -2:
+		return nil, errors.New("flash sale stock is not loaded")
+	case left < 0:
+		return &proto.AddOrderResponse{
+			Code:    common.ErrStockIsNotEnough,
+			CodeMsg: "stock is not enough",
+		}, nil
+	}
+
+	return &proto.AddOrderResponse{
+		OrderID: order.OrderID,
+		Queued:  true,
+	}, nil
+}
+
+// 秒杀订单落库,启动时先处理上次没有处理完的订单
+func FlashSaleStartPersist(s *Service) {
+	go func() {
+		s.persistFlashSaleOrders()
+
+		ticker := time.NewTicker(FlashSalePersistTimerDuration)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.persistFlashSaleOrders()
+			}
+		}
+	}()
+}
+
+func (s *Service) persistFlashSaleOrders() {
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+	defer conn.Close()
+
+	n, err := conn.GetListLen(FlashSaleOrderList)
+	if err != nil {
+		return
+	}
+	m, err := conn.GetListLen(FlashSaleProcessList)
+	if err != nil || n+m == 0 {
+		return
+	}
+
+	// 和校准互斥,多实例时同一时间只有一个实例在落库
+	lock := redis.NewDistLock(s.RedisPool, FlashSaleLock, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		return
+	}
+	defer lock.Unlock()
+
+	for i := 0; i < FlashSalePersistBatchSize; i++ {
+		// 持有锁时处理中队列里的是崩溃或失败留下的,按顺序先处理
+		data, err := conn.ListIndex(FlashSaleProcessList, -1)
+		if err != nil {
+			return
+		}
+
+		if data == "" {
+			if data, err = conn.ListPopPush(FlashSaleOrderList, FlashSaleProcessList); err != nil || data == "" {
+				return
+			}
+		}
+
+		// 失败时留在处理中队列,下次重试
+		if err := s.persistFlashSaleOrder([]byte(data)); err != nil {
+			logger.Error(err)
+			if !s.moveFlashSaleOrderToDead(conn, data) {
+				return
+			}
+		}
+
+		if err := conn.ListRemove(FlashSaleProcessList, data); err != nil {
+			return
+		}
+		if err := conn.Hdel(FlashSaleRetryHash, data); err != nil {
+			logger.Error(err)
+		}
+	}
+}
+
+// 记录失败次数,达到上限后放入死信队列,返回true表示可以从处理中队列删除
+func (s *Service) moveFlashSaleOrderToDead(conn *redis.Redis, data string) bool {
+	retries, err := conn.HincrBy(FlashSaleRetryHash, data, 1)
+	if err != nil {
+		logger.Error(err)
+		return false
+	}
+	if retries < FlashSalePersistMaxRetry {
+		return false
+	}
+
+	logger.Error("flash sale order failed", retries, "times, move to dead list:", data)
+	if err := conn.ListPush(FlashSaleDeadList, data); err != nil {
+		logger.Error(err)
+		return false
+	}
+	return true
+}
+
+func (s *Service) persistFlashSaleOrder(data []byte) error {
+	ctx := context.Background()
+
+	fo := &flashSaleOrder{}
+	if err := json.Unmarshal(data, fo); err != nil {
+		logger.Error(err)
+		return nil
+	}
+
+	// 重试时订单可能已经落库
+	order, err := s.getOrderIfExisted(fo.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	var items []*model.OrderItemModel
+	if order == nil {
+		items = []*model.OrderItemModel{
+			{
+				GoodsID:   fo.GoodsID,
+				GoodsName: fo.GoodsName,
+				Price:     fo.Price / float64(fo.Count),
+				Count:     fo.Count,
+				Amount:    fo.Price,
+			},
+		}
+
+		order = &model.OrderModel{
+			OrderID:    fo.OrderID,
+			SellerID:   fo.SellerID,
+			BuyerID:    fo.BuyerID,
+			GoodsID:    fo.GoodsID,
+			GoodsName:  fo.GoodsName,
+			Count:      fo.Count,
+			Price:      fo.Price,
+			Status:     OrderStatusCreated,
+			ActivityID: fo.ActivityID,
+		}
+
+		if err := s.createOrder(order, items, "buyer:"+fo.BuyerID, "add flash sale order"); err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	if order.Status != OrderStatusCreated {
+		return nil
+	}
+
+	if items == nil {
+		if items, err = s.getOrderItems(order); err != nil {
+			return err
+		}
+	}
+
+	// redis库存比MySQL多时订单直接取消,等待校准
+	if _, err := s.reserveOrderStock(ctx, order, items, OrderActorSystem); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// 定时校准redis和MySQL库存
+func FlashSaleStartReconcile(s *Service) {
+	go func() {
+		ticker := time.NewTicker(FlashSaleReconcileTimerDuration)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.reconcileFlashSale()
+			}
+		}
+	}()
+}
+
+func (s *Service) reconcileFlashSale() {
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+	defer conn.Close()
+
+	goodsIDList, err := conn.SetMembers(FlashSaleGoodsSet)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	lock := redis.NewDistLock(s.RedisPool, FlashSaleLock, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		return
+	}
+	defer lock.Unlock()
+
+	now := time.Now()
+	for _, goodsID := range goodsIDList {
+		fs, err := s.getFlashSale(conn, goodsID)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		if fs == nil || !now.Before(fs.EndTime) {
+			logger.Info("flash sale end, goodsID:", goodsID)
+			if err := s.disableFlashSale(conn, goodsID); err != nil {
+				logger.Error(err)
+			}
+			continue
+		}
+
+		getGoodsResp, err := s.GoodsServiceClient.GetGoods(context.Background(), &goodspb.GetGoodsRequest{GoodsID: goodsID, FromDB: true})
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		reconciled, err := conn.EvalInt64(3, flashSaleReconcileScript, FlashSaleStockPrefix+goodsID, FlashSaleOrderList, FlashSaleProcessList, getGoodsResp.GoodsInfo.Stock)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		if reconciled == 0 {
+			// 还有订单没落库,下次再校准
+			return
+		}
+	}
+}
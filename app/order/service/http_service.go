@@ -9,8 +9,10 @@ import (
 	"reflect"
 
 	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/idempotency"
 	"github.com/harveywangdao/ants/logger"
 	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/seller"
 )
 
 type HttpServer struct {
@@ -23,6 +25,7 @@ func (h *HttpServer) StartHttpServer(httpService *HttpService) {
 
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
+	router.Use(idempotency.GinMiddleware(httpService.ServiceApp.Idempotency))
 
 	router.POST("/ants/v1/"+h.ServiceName+"/:funcName", func(c *gin.Context) {
 		funcName := c.Param("funcName")
@@ -61,8 +64,12 @@ func (h *HttpServer) StartHttpServer(httpService *HttpService) {
 			return
 		}
 
-		params := make([]reflect.Value, 1)
-		params[0] = reflect.ValueOf(body)
+		// 商家接口从metadata取商家ID
+		ctx := seller.NewIncomingContext(c.Request.Context(), c.GetHeader(seller.HeaderKey))
+
+		params := make([]reflect.Value, 2)
+		params[0] = reflect.ValueOf(ctx)
+		params[1] = reflect.ValueOf(body)
 		resp := elem.MethodByName(funcName).Call(params)
 
 		if len(resp) != 2 {
@@ -85,6 +92,9 @@ func (h *HttpServer) StartHttpServer(httpService *HttpService) {
 		})
 	})
 
+	// 支付渠道回调
+	router.POST("/ants/callback/"+h.ServiceName+"/payment", httpService.ServiceApp.paymentCallback)
+
 	if err := router.Run(":" + h.Port); err != nil {
 		logger.Panicln(err)
 	}
@@ -94,7 +104,7 @@ type HttpService struct {
 	ServiceApp *Service
 }
 
-func (h *HttpService) AddOrder(reqData []byte) (interface{}, error) {
+func (h *HttpService) AddOrder(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.AddOrderRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -102,10 +112,21 @@ func (h *HttpService) AddOrder(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.AddOrder(context.Background(), req)
+	return h.ServiceApp.AddOrder(ctx, req)
 }
 
-func (h *HttpService) GetOrder(reqData []byte) (interface{}, error) {
+func (h *HttpService) Checkout(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.CheckoutRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.Checkout(ctx, req)
+}
+
+func (h *HttpService) GetOrder(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.GetOrderRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -113,10 +134,21 @@ func (h *HttpService) GetOrder(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.GetOrder(context.Background(), req)
+	return h.ServiceApp.GetOrder(ctx, req)
 }
 
-func (h *HttpService) DelOrder(reqData []byte) (interface{}, error) {
+func (h *HttpService) ListOrders(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ListOrdersRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ListOrders(ctx, req)
+}
+
+func (h *HttpService) DelOrder(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.DelOrderRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -124,10 +156,21 @@ func (h *HttpService) DelOrder(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.DelOrder(context.Background(), req)
+	return h.ServiceApp.DelOrder(ctx, req)
+}
+
+func (h *HttpService) RestoreOrder(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.RestoreOrderRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RestoreOrder(ctx, req)
 }
 
-func (h *HttpService) PayOrder(reqData []byte) (interface{}, error) {
+func (h *HttpService) PayOrder(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.PayOrderRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -135,10 +178,109 @@ func (h *HttpService) PayOrder(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.PayOrder(context.Background(), req)
+	return h.ServiceApp.PayOrder(ctx, req)
+}
+
+func (h *HttpService) GetPayment(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetPaymentRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetPayment(ctx, req)
+}
+
+func (h *HttpService) CancelOrder(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.CancelOrderRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.CancelOrder(ctx, req)
+}
+
+func (h *HttpService) RefundOrder(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.RefundOrderRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RefundOrder(ctx, req)
+}
+
+func (h *HttpService) GetOrderSaga(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetOrderSagaRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetOrderSaga(ctx, req)
 }
 
-func (h *HttpService) SetActivity(reqData []byte) (interface{}, error) {
+func (h *HttpService) SetFlashSale(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.SetFlashSaleRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.SetFlashSale(ctx, req)
+}
+
+func (h *HttpService) GetFlashSale(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetFlashSaleRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetFlashSale(ctx, req)
+}
+
+func (h *HttpService) ShipOrder(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.ShipOrderRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.ShipOrder(ctx, req)
+}
+
+func (h *HttpService) CompleteOrder(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.CompleteOrderRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.CompleteOrder(ctx, req)
+}
+
+func (h *HttpService) GetOrderHistory(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetOrderHistoryRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetOrderHistory(ctx, req)
+}
+
+func (h *HttpService) SetActivity(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.SetActivityRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -146,10 +288,10 @@ func (h *HttpService) SetActivity(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.SetActivity(context.Background(), req)
+	return h.ServiceApp.SetActivity(ctx, req)
 }
 
-func (h *HttpService) GetActivity(reqData []byte) (interface{}, error) {
+func (h *HttpService) GetActivity(ctx context.Context, reqData []byte) (interface{}, error) {
 	req := &proto.GetActivityRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
@@ -157,16 +299,38 @@ func (h *HttpService) GetActivity(reqData []byte) (interface{}, error) {
 		return nil, err
 	}
 
-	return h.ServiceApp.GetActivity(context.Background(), req)
+	return h.ServiceApp.GetActivity(ctx, req)
+}
+
+func (h *HttpService) GetUniquePayers(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetUniquePayersRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetUniquePayers(ctx, req)
+}
+
+func (h *HttpService) GetSalesStats(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetSalesStatsRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.GetSalesStats(ctx, req)
 }
 
-func (h *HttpService) GetPayOrderPersonTime(reqData []byte) (interface{}, error) {
-	req := &proto.GetPayOrderPersonTimeRequest{}
+func (h *HttpService) GetTopGoods(ctx context.Context, reqData []byte) (interface{}, error) {
+	req := &proto.GetTopGoodsRequest{}
 
 	if err := json.Unmarshal(reqData, req); err != nil {
 		logger.Error(err)
 		return nil, err
 	}
 
-	return h.ServiceApp.GetPayOrderPersonTime(context.Background(), req)
+	return h.ServiceApp.GetTopGoods(ctx, req)
 }
@@ -2,6 +2,7 @@ package service
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"time"
@@ -9,19 +10,33 @@ import (
 	"github.com/harveywangdao/ants/app/order/model"
 	"github.com/harveywangdao/ants/cache/redis"
 	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/database/softdelete"
 	"github.com/harveywangdao/ants/logger"
 	goodspb "github.com/harveywangdao/ants/rpc/goods"
 	proto "github.com/harveywangdao/ants/rpc/order"
 	"github.com/harveywangdao/ants/util"
 )
 
-const (
-	OrderStatusUnpaid = 0
-	OrderStatusPaid   = 1
-	OrderStatusRevoke = 2
-)
+// 下单时刻生效的商品价格,有SKU的商品按SKU价格,定时调价到期但还没扫描到的也按新价格
+func (s *Service) goodsPriceAt(ctx context.Context, goodsID, skuID string, t time.Time) (float64, error) {
+	resp, err := s.GoodsServiceClient.GetPriceAt(ctx, &goodspb.GetPriceAtRequest{
+		GoodsID:   goodsID,
+		SkuID:     skuID,
+		Timestamp: t.Unix(),
+	})
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	if resp.Code != 0 {
+		logger.Error("Code:", resp.Code, "CodeMsg:", resp.CodeMsg)
+		return 0, errors.New(resp.CodeMsg)
+	}
+	return resp.Price, nil
+}
 
-// 增加订单并不会扣库存
+// 增加订单只预占库存,支付成功后才确认扣减,预占过期后由商品服务释放
 func (s *Service) AddOrder(ctx context.Context, req *proto.AddOrderRequest) (*proto.AddOrderResponse, error) {
 	if req.BuyerID == "" || req.GoodsID == "" {
 		return nil, errors.New("buyerID or goodsID is null")
@@ -31,8 +46,35 @@ func (s *Service) AddOrder(ctx context.Context, req *proto.AddOrderRequest) (*pr
 		return nil, errors.New("count can not be 0")
 	}
 
+	// 秒杀中的商品走redis预扣库存
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	fs, err := s.getFlashSale(conn, req.GoodsID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	now := time.Now()
+	if fs != nil && fs.isActive(now) {
+		if req.CouponID != "" {
+			return nil, errors.New("coupon can not be used in flash sale")
+		}
+		// 秒杀库存按商品计算
+		if req.SkuID != "" {
+			return nil, errors.New("sku can not be chosen in flash sale")
+		}
+		return s.addFlashSaleOrder(ctx, conn, req, fs)
+	}
+
 	getGoodsReq := &goodspb.GetGoodsRequest{
 		GoodsID: req.GoodsID,
+		FromDB:  true,
 	}
 	getGoodsResp, err := s.GoodsServiceClient.GetGoods(ctx, getGoodsReq)
 	if err != nil {
@@ -40,35 +82,169 @@ func (s *Service) AddOrder(ctx context.Context, req *proto.AddOrderRequest) (*pr
 		return nil, err
 	}
 
-	// 这里不解决高并发下数据不一致的问题,有可能库存取出之后就被改变了
-	if getGoodsResp.GoodsInfo.Stock < int32(req.Count) {
-		return nil, errors.New("stock number is not enough")
+	goodsInfo := getGoodsResp.GoodsInfo
+	goodsInfo.Price, err = s.goodsPriceAt(ctx, req.GoodsID, req.SkuID, now)
+	if err != nil {
+		return nil, err
+	}
+
+	categories, err := s.goodsCategoryPath(ctx, goodsInfo.Category)
+	if err != nil {
+		return nil, err
+	}
+
+	p, amount, err := s.applyBestPromotion(conn, req.BuyerID, req.GoodsID, categories, goodsInfo.Price, req.Count)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
 	}
 
-	orderID := util.GetUUID()
+	item := &model.OrderItemModel{
+		GoodsID:   req.GoodsID,
+		SkuID:     req.SkuID,
+		GoodsName: goodsInfo.Name,
+		Price:     goodsInfo.Price,
+		Count:     req.Count,
+		Amount:    amount,
+	}
+	if p != nil {
+		item.ActivityID = p.ActivityID
+		item.Discount = roundPrice(goodsInfo.Price*float64(req.Count) - amount)
+	}
+	items := []*model.OrderItemModel{item}
 
 	order := &model.OrderModel{
-		OrderID:   orderID,
-		SellerID:  "",
+		OrderID:   util.GetUUID(),
+		SellerID:  getGoodsResp.SellerID,
 		BuyerID:   req.BuyerID,
 		GoodsID:   req.GoodsID,
-		GoodsName: getGoodsResp.GoodsInfo.Name,
-		Count:     req.Count,
-		Price:     getGoodsResp.GoodsInfo.Price * float64(req.Count),
-		Status:    OrderStatusUnpaid,
+		GoodsName: item.GoodsName,
+		Count:     item.Count,
+		Price:     item.Amount,
+		Status:    OrderStatusCreated,
+	}
+
+	order.ActivityID, order.Discount = item.ActivityID, item.Discount
+
+	if req.CouponID != "" {
+		code, codeMsg, err := s.redeemOrderCoupon(ctx, order, req.CouponID)
+		if err != nil || code != 0 {
+			s.releaseItemsPromotion(req.BuyerID, items)
+			if err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+			return &proto.AddOrderResponse{
+				Code:    code,
+				CodeMsg: codeMsg,
+			}, nil
+		}
+	}
+
+	if err := s.createOrder(order, items, "buyer:"+req.BuyerID, "add order"); err != nil {
+		logger.Error(err)
+		s.releaseItemsPromotion(req.BuyerID, items)
+		s.returnOrderCoupon(order)
+		return nil, err
 	}
 
-	err = s.db.Create(order).Error
+	reserveStocksResp, err := s.reserveOrderStock(ctx, order, items, "buyer:"+req.BuyerID)
 	if err != nil {
 		logger.Error(err)
+		s.cancelUnreservedOrder(order, "buyer:"+req.BuyerID)
 		return nil, err
 	}
 
+	if reserveStocksResp.Code != 0 && reserveStocksResp.Code != common.ErrReserveStockRepeat {
+		return &proto.AddOrderResponse{
+			Code:    reserveStocksResp.Code,
+			CodeMsg: reserveStocksResp.CodeMsg,
+			OrderID: order.OrderID,
+		}, nil
+	}
+
 	return &proto.AddOrderResponse{
-		OrderID: orderID,
+		OrderID: order.OrderID,
 	}, nil
 }
 
+// 订单的所有商品一起预占库存,成功后订单变为reserved,任一商品库存不足或SKU不对订单直接取消,退回优惠券和限购数量
+func (s *Service) reserveOrderStock(ctx context.Context, order *model.OrderModel, items []*model.OrderItemModel, actor string) (*goodspb.ReserveStocksResponse, error) {
+	reserveStocksReq := &goodspb.ReserveStocksRequest{
+		OrderID:       order.OrderID,
+		ExpireSeconds: s.Config.Order.ReserveExpireSeconds,
+	}
+	for _, item := range items {
+		reserveStocksReq.Items = append(reserveStocksReq.Items, &goodspb.StockItem{
+			GoodsID: item.GoodsID,
+			SkuID:   item.SkuID,
+			Number:  item.Count,
+		})
+	}
+
+	reserveStocksResp, err := s.GoodsServiceClient.ReserveStocks(ctx, reserveStocksReq)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	switch reserveStocksResp.Code {
+	case 0, common.ErrReserveStockRepeat:
+		err = s.changeOrderStatus(order, OrderStatusReserved, actor, "reserve stock", nil)
+	default:
+		logger.Error("Code:", reserveStocksResp.Code, "CodeMsg:", reserveStocksResp.CodeMsg)
+		err = s.changeOrderStatus(order, OrderStatusCancelled, actor, reserveStocksResp.CodeMsg, nil)
+	}
+
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return reserveStocksResp, nil
+}
+
+// 预占库存调用失败时不确定是否已预占,取消订单并退回优惠券和限购数量,再释放可能存在的预占
+// 订单状态已被并发修改时不动预占,释放失败的预占到期后也会自动释放
+func (s *Service) cancelUnreservedOrder(order *model.OrderModel, actor string) {
+	if err := s.changeOrderStatus(order, OrderStatusCancelled, actor, "reserve stock failed", nil); err != nil {
+		logger.Error(err)
+		return
+	}
+
+	if err := s.releaseOrderReservation(context.Background(), order); err != nil {
+		logger.Error(err)
+	}
+}
+
+// 释放订单所有商品预占的库存,没有预占记录视为已释放
+func (s *Service) releaseOrderReservation(ctx context.Context, order *model.OrderModel) error {
+	items, err := s.getOrderItems(order)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		releaseReq := &goodspb.ReleaseReservationRequest{
+			GoodsID: item.GoodsID,
+			SkuID:   item.SkuID,
+			OrderID: order.OrderID,
+		}
+		releaseResp, err := s.GoodsServiceClient.ReleaseReservation(ctx, releaseReq)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		if releaseResp.Code != 0 && releaseResp.Code != common.ErrReservationNotFound {
+			logger.Error("Code:", releaseResp.Code, "CodeMsg:", releaseResp.CodeMsg)
+			return errors.New(releaseResp.CodeMsg)
+		}
+	}
+
+	return nil
+}
+
 func (s *Service) GetOrder(ctx context.Context, req *proto.GetOrderRequest) (*proto.GetOrderResponse, error) {
 	if req.OrderID == "" {
 		return nil, errors.New("orderID is null")
@@ -83,26 +259,147 @@ func (s *Service) GetOrder(ctx context.Context, req *proto.GetOrderRequest) (*pr
 
 	logger.Info(order)
 
+	items, err := s.getOrderItems(&order)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
 	return &proto.GetOrderResponse{
-		OrderInfo: &proto.OrderInfo{
-			SellerID:  order.SellerID,
-			BuyerID:   order.BuyerID,
-			GoodsID:   order.GoodsID,
-			GoodsName: order.GoodsName,
-			Count:     order.Count,
-			Price:     order.Price,
-			Pay:       order.Pay,
-			Status:    uint32(order.Status),
-		},
+		OrderInfo: toOrderInfo(&order, items),
 	}, nil
 }
 
+func toOrderInfo(order *model.OrderModel, items []*model.OrderItemModel) *proto.OrderInfo {
+	orderInfo := &proto.OrderInfo{
+		OrderID:        order.OrderID,
+		SellerID:       order.SellerID,
+		BuyerID:        order.BuyerID,
+		GoodsID:        order.GoodsID,
+		GoodsName:      order.GoodsName,
+		Count:          order.Count,
+		Price:          order.Price,
+		Pay:            order.Pay,
+		Status:         uint32(order.Status),
+		PayID:          order.PayID,
+		ActivityID:     order.ActivityID,
+		Discount:       order.Discount,
+		CouponID:       order.CouponID,
+		CouponDiscount: order.CouponDiscount,
+	}
+
+	for _, item := range items {
+		orderInfo.Items = append(orderInfo.Items, &proto.OrderItem{
+			GoodsID:    item.GoodsID,
+			SkuID:      item.SkuID,
+			GoodsName:  item.GoodsName,
+			Price:      item.Price,
+			Count:      item.Count,
+			Amount:     item.Amount,
+			ActivityID: item.ActivityID,
+			Discount:   item.Discount,
+		})
+	}
+
+	return orderInfo
+}
+
+const (
+	ListOrdersDefaultNumPerPage = 20
+	ListOrdersMaxNumPerPage     = 100
+)
+
+// 订单列表,游标分页,下一页把返回的lastID和lastPrice带回来
+func (s *Service) ListOrders(ctx context.Context, req *proto.ListOrdersRequest) (*proto.ListOrdersResponse, error) {
+	if req.NumPerPage < 0 || req.LastID < 0 {
+		return nil, errors.New("param error")
+	}
+
+	if req.SortBy != "" && req.SortBy != model.OrderSortByCreateTime && req.SortBy != model.OrderSortByPrice {
+		return nil, fmt.Errorf("sortBy %s not supported", req.SortBy)
+	}
+
+	numPerPage := req.NumPerPage
+	if numPerPage == 0 {
+		numPerPage = ListOrdersDefaultNumPerPage
+	}
+	if numPerPage > ListOrdersMaxNumPerPage {
+		numPerPage = ListOrdersMaxNumPerPage
+	}
+
+	filter := &model.OrderFilter{
+		BuyerID:   req.BuyerID,
+		SellerID:  req.SellerID,
+		GoodsID:   req.GoodsID,
+		Statuses:  req.Statuses,
+		StartTime: req.StartTime,
+		EndTime:   req.EndTime,
+	}
+
+	// 多查一条判断是否还有下一页
+	page := &model.OrderPage{
+		SortBy:     req.SortBy,
+		Asc:        req.Asc,
+		LastID:     req.LastID,
+		LastPrice:  req.LastPrice,
+		NumPerPage: numPerPage + 1,
+	}
+
+	orders, err := model.ListOrders(s.db, filter, page)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.ListOrdersResponse{}
+	if int64(len(orders)) > numPerPage {
+		orders = orders[:numPerPage]
+		resp.HasMore = true
+	}
+
+	var orderIDs []string
+	for _, order := range orders {
+		orderIDs = append(orderIDs, order.OrderID)
+	}
+
+	itemsMap, err := model.GetOrderItemsByOrderIDs(s.db, orderIDs)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	for _, order := range orders {
+		resp.OrderInfos = append(resp.OrderInfos, toOrderInfo(order, itemsMap[order.OrderID]))
+	}
+
+	if len(orders) != 0 {
+		resp.LastID = orders[len(orders)-1].ID
+		resp.LastPrice = orders[len(orders)-1].Price
+	}
+
+	return resp, nil
+}
+
+// 只能删除已经结束的订单,未结束的订单先取消或退款,删除是软删除
 func (s *Service) DelOrder(ctx context.Context, req *proto.DelOrderRequest) (*proto.DelOrderResponse, error) {
 	if req.OrderID == "" {
 		return nil, errors.New("orderID is null")
 	}
 
-	err := s.db.Where("order_id = ?", req.OrderID).Delete(model.OrderModel{}).Error
+	order, err := s.getOrder(req.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if order.Status != OrderStatusCancelled && order.Status != OrderStatusRefunded && order.Status != OrderStatusCompleted {
+		return &proto.DelOrderResponse{
+			Code:    common.ErrOrderStatusIllegal,
+			CodeMsg: fmt.Sprintf("order is %s, can not delete", OrderStatusName(order.Status)),
+		}, nil
+	}
+
+	err = s.db.Where("order_id = ?", req.OrderID).Delete(model.OrderModel{}).Error
 	if err != nil {
 		logger.Error(err)
 		return nil, err
@@ -113,15 +410,78 @@ func (s *Service) DelOrder(ctx context.Context, req *proto.DelOrderRequest) (*pr
 	}, nil
 }
 
-/*
-后期优化
-0.生成了订单但是没库存,直接撤销
-1.支持实际支付
+func (s *Service) RestoreOrder(ctx context.Context, req *proto.RestoreOrderRequest) (*proto.RestoreOrderResponse, error) {
+	if req.OrderID == "" {
+		return nil, errors.New("orderID is null")
+	}
+
+	restored, err := softdelete.Restore(s.db, model.OrderModel{}, "order_id = ?", req.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !restored {
+		return nil, fmt.Errorf("deleted order %s not found", req.OrderID)
+	}
+
+	return &proto.RestoreOrderResponse{
+		CodeMsg: "restore success",
+	}, nil
+}
+
+// 清理软删除的订单,同时删除订单商品和状态历史
+func (s *Service) purgeOrders(retentionDays int64) {
+	deadline := softdelete.Deadline(retentionDays)
+
+	for {
+		var orders []*model.OrderModel
+		if err := s.db.Unscoped().Select("id, order_id").Where("is_delete = ? AND update_time < ?", 1, deadline).Limit(softdelete.DefaultPurgeBatchSize).Find(&orders).Error; err != nil {
+			logger.Error(err)
+			return
+		}
+
+		if len(orders) == 0 {
+			return
+		}
+
+		var orderIDs []string
+		for _, order := range orders {
+			orderIDs = append(orderIDs, order.OrderID)
+		}
+
+		tx := s.db.Begin()
+		if err := tx.Error; err != nil {
+			logger.Error(err)
+			return
+		}
+
+		for _, value := range []interface{}{model.OrderItemModel{}, model.OrderStatusHistoryModel{}, model.OrderModel{}} {
+			if err := tx.Unscoped().Where("order_id IN (?)", orderIDs).Delete(value).Error; err != nil {
+				logger.Error(err)
+				tx.Rollback()
+				return
+			}
+		}
 
-2.支付成功，扣库存失败(库存足)，抛消息队列
-3.支付成功，扣库存失败(没库存)，直接撤销，退款
+		if err := tx.Commit().Error; err != nil {
+			logger.Error(err)
+			return
+		}
 
-4.扣库存成功，修改支付状态失败，抛消息队列
+		logger.Info("purge", len(orderIDs), "orders")
+
+		if len(orders) < softdelete.DefaultPurgeBatchSize {
+			return
+		}
+	}
+}
+
+/*
+支付订单以saga方式执行: 检查订单 -> 支付 -> 扣库存 -> 修改订单状态
+1.任一步骤出现不可重试的失败(如没库存),逆序执行补偿: 归还库存 -> 退款 -> 撤销订单
+2.可重试的失败(如网络错误)记录在saga_tb,由后台任务继续推进,宕机重启后同样可以恢复
+3.支付步骤创建支付单后saga暂停,立即返回pending,收到支付回调后继续执行
 */
 func (s *Service) PayOrder(ctx context.Context, req *proto.PayOrderRequest) (*proto.PayOrderResponse, error) {
 	if req.OrderID == "" {
@@ -141,85 +501,44 @@ func (s *Service) PayOrder(ctx context.Context, req *proto.PayOrderRequest) (*pr
 		logger.Error(err)
 		return nil, err
 	}
-	if order.Status != OrderStatusUnpaid {
+	if !isOrderUnpaid(&order) {
 		return nil, errors.New("current status is not unpaid")
 	}
 
-	// 测试，以后删除
-	conn, err := s.RedisPool.Get()
-	if err != nil {
-		logger.Error(err)
-		return nil, err
-	}
-	defer conn.Close()
-
-	conn.SetAdd(PayOrderPersonTime, order.BuyerID)
-	conn.ZsetAdd(PayOrderPersonTimeByTimestamp, order.BuyerID, time.Now().Unix())
-	conn.HyperLogLogAdd(PayOrderPersonTimeEstimate, order.BuyerID)
-
-	/*getGoodsReq := &goodspb.GetGoodsRequest{
-		GoodsID: order.GoodsID,
-	}
-	getGoodsResp, err := s.GoodsServiceClient.GetGoods(ctx, getGoodsReq)
+	saga, err := s.newSaga(SagaTypePayOrder, req.OrderID)
 	if err != nil {
 		logger.Error(err)
 		return nil, err
 	}
-	if getGoodsResp.GoodsInfo.Stock < int32(order.Count) {
-		return nil, errors.New("stock number is not enough")
-	}*/
-
-	// pay(order.Price)
-	// 支付成功
 
-	// 扣库存
-	deductStockReq := &goodspb.DeductStockRequest{
-		GoodsID: order.GoodsID,
-		OrderID: req.OrderID,
-		PayID:   util.GetUUID(), // 后期修改
-		Number:  order.Count,
-	}
-	deductStockResp, err := s.GoodsServiceClient.DeductStock(ctx, deductStockReq)
-	if err != nil {
+	if err := s.runSagaWithLock(ctx, saga); err != nil {
 		logger.Error(err)
-		// 2.支付成功，扣库存失败(库存足)，抛消息队列
-		// s.pushDeductStockEvent(ctx, req)
-		// s.publishDeductStockChannel(ctx, req)
-		// s.produceDeductStockMsg(ctx, req)
-		s.produceDeductStockNsqMsg(ctx, req)
 		return nil, err
 	}
 
-	if deductStockResp.Code != 0 && deductStockResp.Code != common.ErrDeductStockRepeat {
-		logger.Error("Code:", deductStockResp.Code, "CodeMsg:", deductStockResp.CodeMsg)
-
-		if deductStockResp.Code == common.ErrStockIsNotEnough {
-			// 3.支付成功，扣库存失败(没库存)，直接撤销，退款
-		}
-
-		return nil, errors.New(deductStockResp.CodeMsg)
-	}
-
-	// 修改订单状态
-	param := map[string]interface{}{
-		"status": OrderStatusPaid,
-		"pay":    order.Price,
-	}
-	if err := s.db.Model(model.OrderModel{}).Where("order_id = ?", req.OrderID).Updates(param).Error; err != nil {
-		logger.Error(err)
-		return nil, err
+	switch saga.Status {
+	case SagaStatusDone:
+	case SagaStatusCompensated:
+		return nil, fmt.Errorf("pay order fail: %s", saga.LastError)
+	case SagaStatusRunning:
+		// 等待支付渠道回调
+		return &proto.PayOrderResponse{
+			CodeMsg: "waiting for payment",
+			PayID:   saga.PayID,
+			Pending: true,
+		}, nil
+	default:
+		return nil, fmt.Errorf("pay order is processing, saga status %d", saga.Status)
 	}
 
 	return &proto.PayOrderResponse{
 		CodeMsg: "pay success",
+		PayID:   saga.PayID,
 	}, nil
 }
 
 const (
-	ActivityPrefix                = "ActivityPrefix"
-	PayOrderPersonTime            = "PayOrderPersonTime"            // 历史支付人次,一个人算一次
-	PayOrderPersonTimeByTimestamp = "PayOrderPersonTimeByTimestamp" // 历史支付人次,一个人算一次,按照时间戳排序
-	PayOrderPersonTimeEstimate    = "PayOrderPersonTimeEstimate"    // 历史支付人次,一个人算一次,有误差,占资源小
+	ActivityPrefix = "ActivityPrefix"
 )
 
 func (s *Service) SetActivity(ctx context.Context, req *proto.SetActivityRequest) (*proto.SetActivityResponse, error) {
@@ -227,6 +546,29 @@ func (s *Service) SetActivity(ctx context.Context, req *proto.SetActivityRequest
 		return nil, errors.New("param can not be null")
 	}
 
+	startTime, err := time.ParseInLocation(ActivityTimeLayout, req.StartTime, time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	endTime, err := time.ParseInLocation(ActivityTimeLayout, req.EndTime, time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !startTime.Before(endTime) {
+		return nil, errors.New("startTime must be before endTime")
+	}
+
+	if req.Promotion != nil {
+		if err := validatePromotion(req.Promotion); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
 	lock := redis.NewDistLock(s.RedisPool, "SetActivity"+req.ActivityID, s.Config.Redis.RedisLockTimeout)
 	if err := lock.Lock(); err != nil {
 		logger.Error(err)
@@ -252,11 +594,29 @@ func (s *Service) SetActivity(ctx context.Context, req *proto.SetActivityRequest
 		"endTime":      req.EndTime,
 	}
 
+	if req.Promotion != nil {
+		data, err := json.Marshal(req.Promotion)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+		m["promotion"] = string(data)
+	}
+
 	if err := conn.Hmset(ActivityPrefix+req.ActivityID, m); err != nil {
 		logger.Error(err)
 		return nil, err
 	}
 
+	if req.Promotion != nil {
+		for _, key := range promotionIndexKeys(req.Promotion) {
+			if err := conn.SetAdd(key, req.ActivityID); err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+		}
+	}
+
 	return &proto.SetActivityResponse{
 		CodeMsg: "add activity success",
 	}, nil
@@ -284,57 +644,20 @@ func (s *Service) GetActivity(ctx context.Context, req *proto.GetActivityRequest
 		return nil, err
 	}
 
-	return &proto.GetActivityResponse{
+	resp := &proto.GetActivityResponse{
 		ActivityID:   activityInfo["activityID"],
 		ActivityName: activityInfo["activityName"],
 		StartTime:    activityInfo["startTime"],
 		EndTime:      activityInfo["endTime"],
-	}, nil
-}
-
-func (s *Service) GetPayOrderPersonTime(ctx context.Context, req *proto.GetPayOrderPersonTimeRequest) (*proto.GetPayOrderPersonTimeResponse, error) {
-	conn, err := s.RedisPool.Get()
-	if err != nil {
-		logger.Error(err)
-		return nil, err
 	}
-	defer conn.Close()
 
-	personTime, err := conn.SetLen(PayOrderPersonTime)
-	if err != nil {
-		logger.Error(err)
-		return nil, err
-	}
-
-	personList, err := conn.SetMembers(PayOrderPersonTime)
-	if err != nil {
-		logger.Error(err)
-		return nil, err
-	}
-
-	personTimeByTimestamp, err := conn.ZsetLen(PayOrderPersonTimeByTimestamp)
-	if err != nil {
-		logger.Error(err)
-		return nil, err
-	}
-
-	personListMap, err := conn.ZsetMembers(PayOrderPersonTimeByTimestamp)
-	if err != nil {
-		logger.Error(err)
-		return nil, err
-	}
-
-	personTimeEstimate, err := conn.HyperLogLogLen(PayOrderPersonTimeEstimate)
-	if err != nil {
-		logger.Error(err)
-		return nil, err
+	if activityInfo["promotion"] != "" {
+		resp.Promotion = &proto.Promotion{}
+		if err := json.Unmarshal([]byte(activityInfo["promotion"]), resp.Promotion); err != nil {
+			logger.Error(err)
+			return nil, err
+		}
 	}
 
-	return &proto.GetPayOrderPersonTimeResponse{
-		PersonTime:            personTime,
-		PersonList:            personList,
-		PersonTimeByTimestamp: personTimeByTimestamp,
-		PersonListMap:         personListMap,
-		PersonTimeEstimate:    personTimeEstimate,
-	}, nil
+	return resp, nil
 }
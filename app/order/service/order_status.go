@@ -0,0 +1,323 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/seller"
+	"github.com/jinzhu/gorm"
+)
+
+// 保持和旧数据兼容,0/1/2的含义不变
+const (
+	OrderStatusCreated   = 0
+	OrderStatusPaid      = 1
+	OrderStatusCancelled = 2
+	OrderStatusReserved  = 3
+	OrderStatusShipped   = 4
+	OrderStatusCompleted = 5
+	OrderStatusRefunded  = 6
+)
+
+const (
+	OrderActorSystem = "system"
+	OrderActorSaga   = "saga"
+)
+
+var (
+	ErrOrderStatusIllegal = errors.New("illegal order status transition")
+	ErrOrderStatusChanged = errors.New("order status changed")
+)
+
+var orderStatusNames = map[uint8]string{
+	OrderStatusCreated:   "created",
+	OrderStatusPaid:      "paid",
+	OrderStatusCancelled: "cancelled",
+	OrderStatusReserved:  "reserved",
+	OrderStatusShipped:   "shipped",
+	OrderStatusCompleted: "completed",
+	OrderStatusRefunded:  "refunded",
+}
+
+/*
+订单状态机
+created   -> reserved, paid, cancelled
+reserved  -> paid, cancelled
+paid      -> shipped, refunded
+shipped   -> completed, refunded
+completed -> refunded
+cancelled和refunded是终态
+created直接到paid是兼容没有预占库存的旧订单
+*/
+var orderStatusTransitions = map[uint8][]uint8{
+	OrderStatusCreated:   {OrderStatusReserved, OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusReserved:  {OrderStatusPaid, OrderStatusCancelled},
+	OrderStatusPaid:      {OrderStatusShipped, OrderStatusRefunded},
+	OrderStatusShipped:   {OrderStatusCompleted, OrderStatusRefunded},
+	OrderStatusCompleted: {OrderStatusRefunded},
+}
+
+func OrderStatusName(status uint8) string {
+	if name, ok := orderStatusNames[status]; ok {
+		return name
+	}
+	return fmt.Sprintf("unknown(%d)", status)
+}
+
+func CanChangeOrderStatus(from, to uint8) bool {
+	for _, status := range orderStatusTransitions[from] {
+		if status == to {
+			return true
+		}
+	}
+	return false
+}
+
+func isOrderUnpaid(order *model.OrderModel) bool {
+	return order.Status == OrderStatusCreated || order.Status == OrderStatusReserved
+}
+
+func newOrderStatusHistory(orderID string, from, to uint8, actor, reason string) *model.OrderStatusHistoryModel {
+	return &model.OrderStatusHistoryModel{
+		OrderID:    orderID,
+		FromStatus: from,
+		ToStatus:   to,
+		Actor:      actor,
+		Reason:     reason,
+		LogTime:    time.Now().Unix(),
+	}
+}
+
+// 新建订单和订单商品并记录历史,同时安排超时未支付自动取消
+func (s *Service) createOrder(order *model.OrderModel, items []*model.OrderItemModel, actor, reason string) error {
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	if err := tx.Create(order).Error; err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	for _, item := range items {
+		item.OrderID = order.OrderID
+		if err := tx.Create(item).Error; err != nil {
+			logger.Error(err)
+			tx.Rollback()
+			return err
+		}
+	}
+
+	if err := tx.Create(newOrderStatusHistory(order.OrderID, order.Status, order.Status, actor, reason)).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+
+	// 提交前加入超时取消任务,事务失败时任务执行会找不到订单
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+	defer conn.Close()
+
+	if err := s.addDelayJob(conn, DelayJobOrderExpire, order.OrderID, time.Now().Unix()+s.orderPayExpireSeconds()); err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+
+	return tx.Commit().Error
+}
+
+/*
+按状态机修改订单状态并记录历史
+1.非法的状态变更返回ErrOrderStatusIllegal
+2.以order.Status为条件更新,期间状态被其他人修改返回ErrOrderStatusChanged
+3.param是需要一起修改的其他字段
+*/
+func (s *Service) changeOrderStatus(order *model.OrderModel, to uint8, actor, reason string, param map[string]interface{}) error {
+	from := order.Status
+	if !CanChangeOrderStatus(from, to) {
+		logger.Error("order", order.OrderID, "can not change from", OrderStatusName(from), "to", OrderStatusName(to))
+		return ErrOrderStatusIllegal
+	}
+
+	if param == nil {
+		param = make(map[string]interface{})
+	}
+	param["status"] = to
+
+	tx := s.db.Begin()
+	if err := tx.Error; err != nil {
+		return err
+	}
+
+	result := tx.Model(model.OrderModel{}).Where("order_id = ? AND status = ?", order.OrderID, from).Updates(param)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		tx.Rollback()
+		return ErrOrderStatusChanged
+	}
+
+	if err := tx.Create(newOrderStatusHistory(order.OrderID, from, to, actor, reason)).Error; err != nil {
+		logger.Error(err)
+		tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit().Error; err != nil {
+		return err
+	}
+
+	order.Status = to
+
+	if to == OrderStatusPaid {
+		if err := s.recordPaidOrder(order); err != nil {
+			logger.Error(err)
+		}
+	}
+
+	// 取消和退款的订单不占用促销的限购数量,退回优惠券
+	if to == OrderStatusCancelled || to == OrderStatusRefunded {
+		if err := s.releaseOrderPromotion(order); err != nil {
+			logger.Error(err)
+		}
+		if err := s.returnOrderCoupon(order); err != nil {
+			logger.Error(err)
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) getOrder(orderID string) (*model.OrderModel, error) {
+	var order model.OrderModel
+	if err := s.db.Where("order_id = ?", orderID).First(&order).Error; err != nil {
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (s *Service) ShipOrder(ctx context.Context, req *proto.ShipOrderRequest) (*proto.ShipOrderResponse, error) {
+	// metadata里有商家ID时以metadata为准
+	sellerID := seller.FromContext(ctx)
+	if sellerID == "" {
+		sellerID = req.SellerID
+	}
+
+	if req.OrderID == "" || sellerID == "" {
+		return nil, errors.New("orderID or sellerID is null")
+	}
+
+	order, err := s.getOrder(req.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 旧订单没有记录商家,不检查
+	if order.SellerID != "" && order.SellerID != sellerID {
+		return &proto.ShipOrderResponse{
+			Code:    common.ErrOrderNotOwned,
+			CodeMsg: "order not owned by seller",
+		}, nil
+	}
+
+	err = s.changeOrderStatus(order, OrderStatusShipped, "seller:"+sellerID, "ship order", nil)
+	if err == ErrOrderStatusIllegal || err == ErrOrderStatusChanged {
+		return &proto.ShipOrderResponse{
+			Code:    common.ErrOrderStatusIllegal,
+			CodeMsg: fmt.Sprintf("order is %s, can not ship", OrderStatusName(order.Status)),
+		}, nil
+	}
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.ShipOrderResponse{
+		CodeMsg: "ship order success",
+	}, nil
+}
+
+func (s *Service) CompleteOrder(ctx context.Context, req *proto.CompleteOrderRequest) (*proto.CompleteOrderResponse, error) {
+	if req.OrderID == "" || req.BuyerID == "" {
+		return nil, errors.New("orderID or buyerID is null")
+	}
+
+	order, err := s.getOrder(req.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if order.BuyerID != req.BuyerID {
+		return nil, errors.New("order does not belong to buyer")
+	}
+
+	err = s.changeOrderStatus(order, OrderStatusCompleted, "buyer:"+req.BuyerID, "confirm receipt", nil)
+	if err == ErrOrderStatusIllegal || err == ErrOrderStatusChanged {
+		return &proto.CompleteOrderResponse{
+			Code:    common.ErrOrderStatusIllegal,
+			CodeMsg: fmt.Sprintf("order is %s, can not complete", OrderStatusName(order.Status)),
+		}, nil
+	}
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.CompleteOrderResponse{
+		CodeMsg: "complete order success",
+	}, nil
+}
+
+func (s *Service) GetOrderHistory(ctx context.Context, req *proto.GetOrderHistoryRequest) (*proto.GetOrderHistoryResponse, error) {
+	if req.OrderID == "" {
+		return nil, errors.New("orderID is null")
+	}
+
+	histories, err := model.GetOrderStatusHistories(s.db, req.OrderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.GetOrderHistoryResponse{}
+	for _, history := range histories {
+		resp.Histories = append(resp.Histories, &proto.OrderStatusHistory{
+			FromStatus: uint32(history.FromStatus),
+			ToStatus:   uint32(history.ToStatus),
+			Actor:      history.Actor,
+			Reason:     history.Reason,
+			LogTime:    history.LogTime,
+		})
+	}
+
+	return resp, nil
+}
+
+// 订单不存在返回nil
+func (s *Service) getOrderIfExisted(orderID string) (*model.OrderModel, error) {
+	order, err := s.getOrder(orderID)
+	if gorm.IsRecordNotFoundError(err) {
+		return nil, nil
+	}
+	return order, err
+}
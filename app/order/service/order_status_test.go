@@ -0,0 +1,46 @@
+package service
+
+import (
+	"testing"
+)
+
+func TestCanChangeOrderStatus(t *testing.T) {
+	tests := []struct {
+		from, to uint8
+		want     bool
+	}{
+		{OrderStatusCreated, OrderStatusReserved, true},
+		{OrderStatusCreated, OrderStatusPaid, true},
+		{OrderStatusCreated, OrderStatusCancelled, true},
+		{OrderStatusCreated, OrderStatusShipped, false},
+		{OrderStatusCreated, OrderStatusRefunded, false},
+		{OrderStatusReserved, OrderStatusPaid, true},
+		{OrderStatusReserved, OrderStatusCancelled, true},
+		{OrderStatusReserved, OrderStatusCreated, false},
+		{OrderStatusPaid, OrderStatusShipped, true},
+		{OrderStatusPaid, OrderStatusRefunded, true},
+		{OrderStatusPaid, OrderStatusCancelled, false},
+		{OrderStatusPaid, OrderStatusCompleted, false},
+		{OrderStatusShipped, OrderStatusCompleted, true},
+		{OrderStatusShipped, OrderStatusRefunded, true},
+		{OrderStatusShipped, OrderStatusPaid, false},
+		{OrderStatusCompleted, OrderStatusRefunded, true},
+		{OrderStatusCompleted, OrderStatusShipped, false},
+		// 终态不能再变
+		{OrderStatusCancelled, OrderStatusCreated, false},
+		{OrderStatusCancelled, OrderStatusPaid, false},
+		{OrderStatusRefunded, OrderStatusPaid, false},
+		{OrderStatusRefunded, OrderStatusRefunded, false},
+		// 不能原地变更
+		{OrderStatusCreated, OrderStatusCreated, false},
+		// 未知状态
+		{100, OrderStatusPaid, false},
+		{OrderStatusCreated, 100, false},
+	}
+
+	for _, tt := range tests {
+		if got := CanChangeOrderStatus(tt.from, tt.to); got != tt.want {
+			t.Errorf("CanChangeOrderStatus(%s, %s) = %v, want %v", OrderStatusName(tt.from), OrderStatusName(tt.to), got, tt.want)
+		}
+	}
+}
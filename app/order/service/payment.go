@@ -0,0 +1,271 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/logger"
+	"github.com/harveywangdao/ants/payment"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/jinzhu/gorm"
+)
+
+const (
+	PaymentStatusPending   = 0
+	PaymentStatusSucceeded = 1
+	PaymentStatusFailed    = 2
+	PaymentStatusRefunded  = 3 // 已全额退款
+
+	PaymentDefaultExpireSeconds = 900
+)
+
+func (s *Service) initPayment() error {
+	conf := s.Config.Payment
+	if conf == nil {
+		return errors.New("payment config is null")
+	}
+
+	switch conf.Provider {
+	case payment.ProviderMock:
+		s.Payment = payment.NewMockProvider(conf.Secret, time.Duration(conf.MockCallbackDelay)*time.Second, conf.MockFailRate)
+	default:
+		return fmt.Errorf("payment provider %s not supported", conf.Provider)
+	}
+
+	return nil
+}
+
+// 支付记录不存在返回nil
+func (s *Service) getPayment(payID string) (*model.PaymentModel, error) {
+	var p model.PaymentModel
+	if err := s.db.Where("pay_id = ?", payID).First(&p).Error; err != nil {
+		if gorm.IsRecordNotFoundError(err) {
+			return nil, nil
+		}
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &p, nil
+}
+
+// 一个支付号一条支付记录,重复调用返回已有的
+func (s *Service) getOrCreatePayment(order *model.OrderModel, payID string) (*model.PaymentModel, error) {
+	expireSeconds := s.Config.Payment.ExpireSeconds
+	if expireSeconds <= 0 {
+		expireSeconds = PaymentDefaultExpireSeconds
+	}
+
+	p := &model.PaymentModel{
+		PayID:      payID,
+		OrderID:    order.OrderID,
+		Provider:   s.Payment.Name(),
+		Amount:     order.Price,
+		Status:     PaymentStatusPending,
+		ExpireTime: time.Now().Unix() + expireSeconds,
+	}
+
+	if err := s.db.Create(p).Error; err != nil {
+		if !strings.Contains(err.Error(), "Duplicate entry") {
+			logger.Error(err)
+			return nil, err
+		}
+
+		return s.getPayment(payID)
+	}
+
+	return p, nil
+}
+
+// 记录渠道的支付结果,只修改待支付的记录,重复通知直接忽略
+func (s *Service) setPaymentResult(p *model.PaymentModel, chargeStatus int, tradeNo string, amount float64) error {
+	var status uint8
+	switch chargeStatus {
+	case payment.ChargeStatusSucceeded:
+		status = PaymentStatusSucceeded
+	case payment.ChargeStatusFailed:
+		status = PaymentStatusFailed
+	default:
+		return nil
+	}
+
+	if status == PaymentStatusSucceeded && math.Abs(amount-p.Amount) > 0.001 {
+		logger.Error("payment", p.PayID, "amount", p.Amount, "but paid", amount)
+		return fmt.Errorf("payment %s amount mismatch", p.PayID)
+	}
+
+	param := map[string]interface{}{
+		"status":      status,
+		"trade_no":    tradeNo,
+		"notify_time": time.Now().Unix(),
+	}
+	result := s.db.Model(model.PaymentModel{}).Where("id = ? AND status = ?", p.ID, PaymentStatusPending).Updates(param)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if result.RowsAffected == 0 {
+		return s.db.Where("id = ?", p.ID).First(p).Error
+	}
+
+	p.Status = status
+	p.TradeNo = tradeNo
+	return nil
+}
+
+// 回调丢失时主动查询渠道,渠道没有支付单时(如创建时宕机)重新创建
+func (s *Service) syncPayment(ctx context.Context, order *model.OrderModel, p *model.PaymentModel) error {
+	if p.Status != PaymentStatusPending {
+		return nil
+	}
+
+	charge, err := s.Payment.QueryCharge(ctx, p.PayID)
+	if err == payment.ErrChargeNotFound {
+		chargeReq := &payment.ChargeRequest{
+			PayID:     p.PayID,
+			OrderID:   p.OrderID,
+			Amount:    p.Amount,
+			Subject:   order.GoodsName,
+			NotifyURL: s.Config.Payment.NotifyURL,
+		}
+		charge, err = s.Payment.CreateCharge(ctx, chargeReq)
+	}
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return s.setPaymentResult(p, charge.Status, charge.TradeNo, charge.Amount)
+}
+
+// refundID相同的退款渠道只会退一次,全部退完后支付记录变为已退款
+func (s *Service) refundPayment(ctx context.Context, p *model.PaymentModel, refundID string, amount float64, reason string) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	refundReq := &payment.RefundRequest{
+		RefundID: refundID,
+		PayID:    p.PayID,
+		Amount:   amount,
+		Reason:   reason,
+	}
+	if _, err := s.Payment.Refund(ctx, refundReq); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	status := p.Status
+	refundedAmount := p.RefundedAmount + amount
+	if refundedAmount >= p.Amount-0.001 {
+		status = PaymentStatusRefunded
+	}
+
+	param := map[string]interface{}{
+		"refunded_amount": refundedAmount,
+		"status":          status,
+	}
+	if err := s.db.Model(model.PaymentModel{}).Where("id = ?", p.ID).Updates(param).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	p.RefundedAmount = refundedAmount
+	p.Status = status
+
+	logger.Info("refund payment", p.PayID, "refundID", refundID, "amount", amount, "reason", reason)
+	return nil
+}
+
+func (s *Service) handlePaymentNotification(ctx context.Context, n *payment.Notification) error {
+	p, err := s.getPayment(n.PayID)
+	if err != nil {
+		return err
+	}
+
+	if p == nil {
+		return fmt.Errorf("payment %s not found", n.PayID)
+	}
+
+	if err := s.setPaymentResult(p, n.Status, n.TradeNo, n.Amount); err != nil {
+		return err
+	}
+
+	saga := &model.SagaModel{}
+	if err := s.db.Where("saga_type = ? AND order_id = ? AND pay_id = ?", SagaTypePayOrder, p.OrderID, p.PayID).First(saga).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	// 异步推进saga,尽快响应回调
+	go func() {
+		if err := s.runSagaWithLock(context.Background(), saga); err != nil {
+			logger.Error(err)
+			return
+		}
+
+		// 订单已经关闭后才支付成功,直接退款
+		if saga.Status == SagaStatusCompensated && p.Status == PaymentStatusSucceeded {
+			if err := s.refundPayment(context.Background(), p, p.PayID, p.Amount-p.RefundedAmount, "order closed before payment"); err != nil {
+				logger.Error(err)
+			}
+		}
+	}()
+
+	return nil
+}
+
+// 支付渠道回调,返回非200时渠道会重试
+func (s *Service) paymentCallback(c *gin.Context) {
+	body, err := ioutil.ReadAll(c.Request.Body)
+	if err != nil {
+		logger.Error(err)
+		c.String(http.StatusBadRequest, "fail")
+		return
+	}
+
+	n, err := s.Payment.VerifyCallback(c.Request.Header, body)
+	if err != nil {
+		logger.Error(err)
+		c.String(http.StatusBadRequest, "fail")
+		return
+	}
+
+	if err := s.handlePaymentNotification(c.Request.Context(), n); err != nil {
+		logger.Error(err)
+		c.String(http.StatusInternalServerError, "fail")
+		return
+	}
+
+	c.String(http.StatusOK, "success")
+}
+
+func (s *Service) GetPayment(ctx context.Context, req *proto.GetPaymentRequest) (*proto.GetPaymentResponse, error) {
+	if req.OrderID == "" {
+		return nil, errors.New("orderID is null")
+	}
+
+	var p model.PaymentModel
+	if err := s.db.Where("order_id = ?", req.OrderID).Order("id desc").First(&p).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.GetPaymentResponse{
+		PayID:          p.PayID,
+		Provider:       p.Provider,
+		TradeNo:        p.TradeNo,
+		Amount:         p.Amount,
+		RefundedAmount: p.RefundedAmount,
+		Status:         uint32(p.Status),
+	}, nil
+}
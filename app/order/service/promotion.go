@@ -0,0 +1,361 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/logger"
+	goodspb "github.com/harveywangdao/ants/rpc/goods"
+	proto "github.com/harveywangdao/ants/rpc/order"
+)
+
+/*
+促销
+1.促销挂在活动上,活动的promotion字段保存促销规则,没有促销的活动只是普通活动
+2.促销可以指定商品或者分类,都不指定时对所有商品生效,指定的分类对所有子分类的商品也生效
+3.下单时每个商品在活动时间内的促销里选优惠最多的一个,记录在订单商品上
+4.有每人限购的促销,超过限购数量不再享受优惠,订单取消后归还限购数量
+*/
+
+const (
+	PromotionTypeDiscount  = "discount"  // 打折
+	PromotionTypeReduction = "reduction" // 满减,threshold为0时直接减
+	PromotionTypeBuyNGetM  = "buyNGetM"  // 买N件送M件
+
+	ActivityGoodsPrefix     = "ActivityGoods"     // set,商品参加的活动
+	ActivityCategoryPrefix  = "ActivityCategory"  // set,分类参加的活动
+	ActivityAllGoods        = "ActivityAllGoods"  // set,对所有商品生效的活动
+	ActivityUserCountPrefix = "ActivityUserCount" // string,用户在活动中已购买的数量
+)
+
+type promotion struct {
+	ActivityID string
+	StartTime  time.Time
+	EndTime    time.Time
+	*proto.Promotion
+}
+
+func (p *promotion) isActive(now time.Time) bool {
+	return !now.Before(p.StartTime) && now.Before(p.EndTime)
+}
+
+// categories是商品的分类和所有上级分类
+func (p *promotion) matchGoods(goodsID string, categories []uint32) bool {
+	if len(p.GoodsIDs) == 0 && len(p.Categories) == 0 {
+		return true
+	}
+
+	for _, id := range p.GoodsIDs {
+		if id == goodsID {
+			return true
+		}
+	}
+
+	for _, c := range p.Categories {
+		for _, category := range categories {
+			if c == category {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+func roundPrice(x float64) float64 {
+	return math.Round(x*100) / 100
+}
+
+// 按促销规则计算count件商品的金额
+func (p *promotion) apply(price float64, count uint32) float64 {
+	amount := price * float64(count)
+
+	switch p.PromotionType {
+	case PromotionTypeDiscount:
+		amount = amount * p.DiscountRate
+	case PromotionTypeReduction:
+		if amount >= p.Threshold {
+			amount = math.Max(amount-p.Reduction, 0)
+		}
+	case PromotionTypeBuyNGetM:
+		free := count / (p.BuyCount + p.FreeCount) * p.FreeCount
+		amount = price * float64(count-free)
+	}
+
+	return roundPrice(amount)
+}
+
+func validatePromotion(p *proto.Promotion) error {
+	switch p.PromotionType {
+	case PromotionTypeDiscount:
+		if p.DiscountRate <= 0 || p.DiscountRate >= 1 {
+			return errors.New("discountRate must be between 0 and 1")
+		}
+	case PromotionTypeReduction:
+		if p.Reduction <= 0 || p.Threshold < 0 {
+			return errors.New("reduction must be greater than 0")
+		}
+	case PromotionTypeBuyNGetM:
+		if p.BuyCount == 0 || p.FreeCount == 0 {
+			return errors.New("buyCount or freeCount can not be 0")
+		}
+	default:
+		return fmt.Errorf("promotionType %s not supported", p.PromotionType)
+	}
+
+	return nil
+}
+
+// 活动的商品和分类索引,活动结束后在查询时清理
+func promotionIndexKeys(p *proto.Promotion) []string {
+	if len(p.GoodsIDs) == 0 && len(p.Categories) == 0 {
+		return []string{ActivityAllGoods}
+	}
+
+	var keys []string
+	for _, goodsID := range p.GoodsIDs {
+		keys = append(keys, ActivityGoodsPrefix+goodsID)
+	}
+	for _, category := range p.Categories {
+		keys = append(keys, ActivityCategoryPrefix+strconv.FormatUint(uint64(category), 10))
+	}
+
+	return keys
+}
+
+// 活动不存在或者没有促销时返回nil
+func (s *Service) getPromotion(conn *redis.Redis, activityID string) (*promotion, error) {
+	if !conn.IsKeyExist(ActivityPrefix + activityID) {
+		return nil, nil
+	}
+
+	activityInfo, err := conn.Hgetall(ActivityPrefix + activityID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if activityInfo["promotion"] == "" {
+		return nil, nil
+	}
+
+	p := &promotion{
+		ActivityID: activityID,
+		Promotion:  &proto.Promotion{},
+	}
+	if err := json.Unmarshal([]byte(activityInfo["promotion"]), p.Promotion); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	p.StartTime, err = time.ParseInLocation(ActivityTimeLayout, activityInfo["startTime"], time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	p.EndTime, err = time.ParseInLocation(ActivityTimeLayout, activityInfo["endTime"], time.Local)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return p, nil
+}
+
+// 商品的分类和所有上级分类,分类不存在时只有自己
+func (s *Service) goodsCategoryPath(ctx context.Context, category uint32) ([]uint32, error) {
+	if category == 0 {
+		return nil, nil
+	}
+
+	resp, err := s.GoodsServiceClient.GetCategory(ctx, &goodspb.GetCategoryRequest{CategoryID: category})
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	categories := []uint32{category}
+	for _, ancestor := range resp.Ancestors {
+		categories = append(categories, ancestor.CategoryID)
+	}
+	return categories, nil
+}
+
+// 商品当前生效的促销
+func (s *Service) getGoodsPromotions(conn *redis.Redis, goodsID string, categories []uint32) ([]*promotion, error) {
+	keys := []string{ActivityAllGoods, ActivityGoodsPrefix + goodsID}
+	for _, category := range categories {
+		keys = append(keys, ActivityCategoryPrefix+strconv.FormatUint(uint64(category), 10))
+	}
+
+	now := time.Now()
+	checked := make(map[string]bool)
+	var promotions []*promotion
+	for _, key := range keys {
+		activityIDs, err := conn.SetMembers(key)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		for _, activityID := range activityIDs {
+			if checked[activityID] {
+				continue
+			}
+			checked[activityID] = true
+
+			p, err := s.getPromotion(conn, activityID)
+			if err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+
+			if p == nil || now.After(p.EndTime) {
+				conn.SetRemove(key, activityID)
+				continue
+			}
+
+			if p.isActive(now) && p.matchGoods(goodsID, categories) {
+				promotions = append(promotions, p)
+			}
+		}
+	}
+
+	return promotions, nil
+}
+
+func activityUserCountKey(activityID, buyerID string) string {
+	return ActivityUserCountPrefix + activityID + ":" + buyerID
+}
+
+// 占用限购数量,超过限购时归还并返回false
+func (s *Service) takePromotionQuota(conn *redis.Redis, p *promotion, buyerID string, count uint32) (bool, error) {
+	if p.LimitPerUser == 0 {
+		return true, nil
+	}
+
+	key := activityUserCountKey(p.ActivityID, buyerID)
+	n, err := conn.IncrBy(key, int64(count))
+	if err != nil {
+		logger.Error(err)
+		return false, err
+	}
+
+	// 活动结束一天后不再需要限购数量
+	conn.Expire(key, int64(time.Until(p.EndTime)/time.Second)+24*3600)
+
+	if n > int64(p.LimitPerUser) {
+		if _, err := conn.IncrBy(key, -int64(count)); err != nil {
+			logger.Error(err)
+			return false, err
+		}
+		return false, nil
+	}
+
+	return true, nil
+}
+
+// 选择金额最低的促销,返回nil表示没有可用的促销
+func (s *Service) applyBestPromotion(conn *redis.Redis, buyerID, goodsID string, categories []uint32, price float64, count uint32) (*promotion, float64, error) {
+	promotions, err := s.getGoodsPromotions(conn, goodsID, categories)
+	if err != nil {
+		logger.Error(err)
+		return nil, 0, err
+	}
+
+	// 优惠多的先尝试,限购用完的跳过
+	for _, p := range rankPromotions(promotions, price, count) {
+		ok, err := s.takePromotionQuota(conn, p, buyerID, count)
+		if err != nil {
+			logger.Error(err)
+			return nil, 0, err
+		}
+
+		if ok {
+			return p, p.apply(price, count), nil
+		}
+	}
+
+	return nil, roundPrice(price * float64(count)), nil
+}
+
+// 比原价便宜的促销按金额从低到高排序,金额相同时保持原来的顺序
+func rankPromotions(promotions []*promotion, price float64, count uint32) []*promotion {
+	amount := roundPrice(price * float64(count))
+	var candidates []*promotion
+	for _, p := range promotions {
+		if p.apply(price, count) < amount {
+			candidates = append(candidates, p)
+		}
+	}
+
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].apply(price, count) < candidates[j].apply(price, count)
+	})
+	return candidates
+}
+
+// 订单取消后归还限购数量
+func (s *Service) releaseOrderPromotion(order *model.OrderModel) error {
+	items, err := model.GetOrderItems(s.db, order.OrderID)
+	if err != nil {
+		return err
+	}
+
+	// 没有订单商品的老订单促销只记录在订单上,秒杀订单的activity_id是秒杀活动,只在订单商品上找促销
+	if len(items) == 0 {
+		if items, err = s.getOrderItems(order); err != nil {
+			return err
+		}
+		items[0].ActivityID = order.ActivityID
+	}
+
+	return s.releaseItemsPromotion(order.BuyerID, items)
+}
+
+// 每个商品分别归还占用的限购数量
+func (s *Service) releaseItemsPromotion(buyerID string, items []*model.OrderItemModel) error {
+	conn, err := s.RedisPool.Get()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer conn.Close()
+
+	for _, item := range items {
+		if item.ActivityID == "" {
+			continue
+		}
+
+		p, err := s.getPromotion(conn, item.ActivityID)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		if p == nil || p.LimitPerUser == 0 {
+			continue
+		}
+
+		key := activityUserCountKey(item.ActivityID, buyerID)
+		if !conn.IsKeyExist(key) {
+			continue
+		}
+
+		if _, err := conn.IncrBy(key, -int64(item.Count)); err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
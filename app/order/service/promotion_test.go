@@ -0,0 +1,75 @@
+package service
+
+import (
+	"strings"
+	"testing"
+
+	proto "github.com/harveywangdao/ants/rpc/order"
+)
+
+func newTestPromotion(activityID string, p *proto.Promotion) *promotion {
+	return &promotion{ActivityID: activityID, Promotion: p}
+}
+
+func TestPromotionApply(t *testing.T) {
+	tests := []struct {
+		name  string
+		p     *proto.Promotion
+		price float64
+		count uint32
+		want  float64
+	}{
+		{"discount", &proto.Promotion{PromotionType: PromotionTypeDiscount, DiscountRate: 0.8}, 10, 3, 24},
+		{"discount round", &proto.Promotion{PromotionType: PromotionTypeDiscount, DiscountRate: 0.85}, 9.99, 3, 25.47},
+		{"reduction below threshold", &proto.Promotion{PromotionType: PromotionTypeReduction, Threshold: 100, Reduction: 20}, 30, 3, 90},
+		{"reduction reach threshold", &proto.Promotion{PromotionType: PromotionTypeReduction, Threshold: 100, Reduction: 20}, 25, 4, 80},
+		{"reduction no threshold", &proto.Promotion{PromotionType: PromotionTypeReduction, Reduction: 5}, 10, 1, 5},
+		{"reduction more than amount", &proto.Promotion{PromotionType: PromotionTypeReduction, Reduction: 50}, 30, 1, 0},
+		{"buy 2 get 1 not enough", &proto.Promotion{PromotionType: PromotionTypeBuyNGetM, BuyCount: 2, FreeCount: 1}, 10, 2, 20},
+		{"buy 2 get 1", &proto.Promotion{PromotionType: PromotionTypeBuyNGetM, BuyCount: 2, FreeCount: 1}, 10, 3, 20},
+		{"buy 2 get 1 partial group", &proto.Promotion{PromotionType: PromotionTypeBuyNGetM, BuyCount: 2, FreeCount: 1}, 10, 5, 40},
+		{"buy 2 get 1 two groups", &proto.Promotion{PromotionType: PromotionTypeBuyNGetM, BuyCount: 2, FreeCount: 1}, 10, 6, 40},
+	}
+
+	for _, tt := range tests {
+		if got := newTestPromotion("a", tt.p).apply(tt.price, tt.count); got != tt.want {
+			t.Errorf("%s: apply(%v, %d) = %v, want %v", tt.name, tt.price, tt.count, got, tt.want)
+		}
+	}
+}
+
+func TestRankPromotions(t *testing.T) {
+	discount := newTestPromotion("discount", &proto.Promotion{PromotionType: PromotionTypeDiscount, DiscountRate: 0.8})
+	reduction5 := newTestPromotion("reduction5", &proto.Promotion{PromotionType: PromotionTypeReduction, Reduction: 5})
+	reduction6 := newTestPromotion("reduction6", &proto.Promotion{PromotionType: PromotionTypeReduction, Reduction: 6})
+	buy2Get1 := newTestPromotion("buy2Get1", &proto.Promotion{PromotionType: PromotionTypeBuyNGetM, BuyCount: 2, FreeCount: 1})
+	highThreshold := newTestPromotion("highThreshold", &proto.Promotion{PromotionType: PromotionTypeReduction, Threshold: 1000, Reduction: 100})
+
+	tests := []struct {
+		name       string
+		promotions []*promotion
+		price      float64
+		count      uint32
+		want       string
+	}{
+		{"none", nil, 10, 3, ""},
+		// 达不到门槛的促销不比原价便宜
+		{"no discount", []*promotion{highThreshold}, 10, 3, ""},
+		// 原价30,买二送一20,打八折24,减6元24,减5元25
+		{"lowest first", []*promotion{discount, reduction5, highThreshold, reduction6, buy2Get1}, 10, 3, "buy2Get1,discount,reduction6,reduction5"},
+		// 金额相同时按原来的顺序
+		{"tie keeps order", []*promotion{reduction6, discount}, 10, 3, "reduction6,discount"},
+		// 只买一件时买二送一没有优惠
+		{"single item", []*promotion{buy2Get1, discount, reduction5}, 10, 1, "reduction5,discount"},
+	}
+
+	for _, tt := range tests {
+		var ids []string
+		for _, p := range rankPromotions(tt.promotions, tt.price, tt.count) {
+			ids = append(ids, p.ActivityID)
+		}
+		if got := strings.Join(ids, ","); got != tt.want {
+			t.Errorf("%s: rankPromotions = %q, want %q", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,228 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	goodspb "github.com/harveywangdao/ants/rpc/goods"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/util"
+)
+
+const (
+	RefundStatusProcessing = 0
+	RefundStatusSucceeded  = 1
+)
+
+// 取消和退款使用支付的锁,避免和PayOrder同时修改订单
+func (s *Service) lockOrderPayment(orderID string) (*redis.DistLock, error) {
+	lock := redis.NewDistLock(s.RedisPool, "PayOrder"+orderID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return lock, nil
+}
+
+func (s *Service) getBuyerOrder(orderID, buyerID string) (*model.OrderModel, error) {
+	order, err := s.getOrder(orderID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if order.BuyerID != buyerID {
+		return nil, errors.New("order does not belong to buyer")
+	}
+
+	return order, nil
+}
+
+/*
+订单全额退款并归还库存
+1.一个支付号只有一条退款记录,失败后重试继续未完成的退款
+2.渠道按退款号保证只退一次,商品服务按支付号保证库存只归还一次
+3.退款记录成功后再修改订单状态为refunded
+*/
+func (s *Service) refundOrder(ctx context.Context, order *model.OrderModel, actor, reason string) (*model.RefundModel, error) {
+	p, err := s.getPayment(order.PayID)
+	if err != nil {
+		return nil, err
+	}
+
+	if p == nil {
+		return nil, fmt.Errorf("payment %s not found", order.PayID)
+	}
+
+	refund := &model.RefundModel{
+		RefundID: util.GetUUID(),
+		OrderID:  order.OrderID,
+		PayID:    order.PayID,
+		Amount:   p.Amount - p.RefundedAmount,
+		Reason:   reason,
+		Actor:    actor,
+		Status:   RefundStatusProcessing,
+	}
+	if err := s.db.Create(refund).Error; err != nil {
+		if !strings.Contains(err.Error(), "Duplicate entry") {
+			logger.Error(err)
+			return nil, err
+		}
+
+		if err := s.db.Where("pay_id = ?", order.PayID).First(refund).Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
+	if refund.Status != RefundStatusSucceeded {
+		if err := s.refundPayment(ctx, p, refund.RefundID, p.Amount-p.RefundedAmount, refund.Reason); err != nil {
+			return nil, err
+		}
+
+		restoreStockResp, err := s.GoodsServiceClient.RestoreStock(ctx, &goodspb.RestoreStockRequest{
+			OrderID: order.OrderID,
+			PayID:   order.PayID,
+		})
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		if restoreStockResp.Code != 0 && restoreStockResp.Code != common.ErrRestoreStockRepeat {
+			logger.Error("Code:", restoreStockResp.Code, "CodeMsg:", restoreStockResp.CodeMsg)
+			return nil, errors.New(restoreStockResp.CodeMsg)
+		}
+
+		param := map[string]interface{}{
+			"status":      RefundStatusSucceeded,
+			"finish_time": time.Now().Unix(),
+		}
+		if err := s.db.Model(model.RefundModel{}).Where("id = ?", refund.ID).Updates(param).Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+		refund.Status = RefundStatusSucceeded
+	}
+
+	if err := s.changeOrderStatus(order, OrderStatusRefunded, actor, refund.Reason, nil); err != nil {
+		return nil, err
+	}
+
+	return refund, nil
+}
+
+/*
+取消订单
+1.未支付的订单直接取消并释放预占的库存,之后才支付成功的会自动退款
+2.已支付未发货的订单全额退款并归还库存
+*/
+func (s *Service) CancelOrder(ctx context.Context, req *proto.CancelOrderRequest) (*proto.CancelOrderResponse, error) {
+	if req.OrderID == "" || req.BuyerID == "" {
+		return nil, errors.New("orderID or buyerID is null")
+	}
+
+	lock, err := s.lockOrderPayment(req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	order, err := s.getBuyerOrder(req.OrderID, req.BuyerID)
+	if err != nil {
+		return nil, err
+	}
+
+	actor := "buyer:" + req.BuyerID
+	reason := req.Reason
+	if reason == "" {
+		reason = "cancel order"
+	}
+
+	resp := &proto.CancelOrderResponse{
+		CodeMsg: "cancel order success",
+	}
+	switch {
+	case isOrderUnpaid(order):
+		err = s.changeOrderStatus(order, OrderStatusCancelled, actor, reason, nil)
+		if err == nil {
+			err = s.releaseOrderReservation(ctx, order)
+		}
+	case order.Status == OrderStatusPaid:
+		var refund *model.RefundModel
+		refund, err = s.refundOrder(ctx, order, actor, reason)
+		if err == nil {
+			resp.RefundID = refund.RefundID
+		}
+	default:
+		err = ErrOrderStatusIllegal
+	}
+
+	if err == ErrOrderStatusIllegal || err == ErrOrderStatusChanged {
+		return &proto.CancelOrderResponse{
+			Code:    common.ErrOrderStatusIllegal,
+			CodeMsg: fmt.Sprintf("order is %s, can not cancel", OrderStatusName(order.Status)),
+		}, nil
+	}
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return resp, nil
+}
+
+// 已支付、已发货和已完成的订单都可以全额退款
+func (s *Service) RefundOrder(ctx context.Context, req *proto.RefundOrderRequest) (*proto.RefundOrderResponse, error) {
+	if req.OrderID == "" || req.BuyerID == "" {
+		return nil, errors.New("orderID or buyerID is null")
+	}
+
+	lock, err := s.lockOrderPayment(req.OrderID)
+	if err != nil {
+		return nil, err
+	}
+	defer lock.Unlock()
+
+	order, err := s.getBuyerOrder(req.OrderID, req.BuyerID)
+	if err != nil {
+		return nil, err
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "refund order"
+	}
+
+	var refund *model.RefundModel
+	if CanChangeOrderStatus(order.Status, OrderStatusRefunded) {
+		refund, err = s.refundOrder(ctx, order, "buyer:"+req.BuyerID, reason)
+	} else {
+		err = ErrOrderStatusIllegal
+	}
+
+	if err == ErrOrderStatusIllegal || err == ErrOrderStatusChanged {
+		return &proto.RefundOrderResponse{
+			Code:    common.ErrOrderStatusIllegal,
+			CodeMsg: fmt.Sprintf("order is %s, can not refund", OrderStatusName(order.Status)),
+		}, nil
+	}
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.RefundOrderResponse{
+		CodeMsg:  "refund order success",
+		RefundID: refund.RefundID,
+		Amount:   refund.Amount,
+	}, nil
+}
@@ -0,0 +1,576 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/common"
+	"github.com/harveywangdao/ants/logger"
+	goodspb "github.com/harveywangdao/ants/rpc/goods"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/util"
+)
+
+const (
+	SagaStatusRunning      = 0
+	SagaStatusDone         = 1
+	SagaStatusCompensating = 2
+	SagaStatusCompensated  = 3
+	SagaStatusFailed       = 4
+
+	SagaActionForward    = 0
+	SagaActionCompensate = 1
+
+	SagaResultSuccess = 0
+	SagaResultFail    = 1
+
+	SagaTypePayOrder = "PayOrder"
+
+	SagaMaxRetryTimes        = 20
+	SagaRecoverTimerDuration = 10 * time.Second
+	SagaRecoverDelay         = 30 * time.Second // 超过这个时间没有推进的saga才会被后台恢复
+	SagaRecoverBatchSize     = 100
+)
+
+type sagaStep struct {
+	Name       string
+	Action     func(ctx context.Context, saga *model.SagaModel) error
+	Compensate func(ctx context.Context, saga *model.SagaModel) error
+	// 失败时可能已经部分生效,补偿从自己开始,Compensate需要幂等
+	Partial bool
+}
+
+// 不可重试的错误,saga收到后转入补偿
+type sagaAbortError struct {
+	msg string
+}
+
+func (e *sagaAbortError) Error() string {
+	return e.msg
+}
+
+func abortSaga(msg string) error {
+	return &sagaAbortError{msg: msg}
+}
+
+// 需要等待外部事件(如支付回调)的步骤返回,saga停在当前步骤,不算失败
+type sagaSuspendError struct {
+	msg string
+}
+
+func (e *sagaSuspendError) Error() string {
+	return e.msg
+}
+
+func suspendSaga(msg string) error {
+	return &sagaSuspendError{msg: msg}
+}
+
+func (s *Service) sagaSteps(sagaType string) []*sagaStep {
+	switch sagaType {
+	case SagaTypePayOrder:
+		return s.payOrderSagaSteps()
+	}
+
+	return nil
+}
+
+// 同一个订单同一种saga只会有一个,重复创建时返回已有的saga
+func (s *Service) newSaga(sagaType, orderID string) (*model.SagaModel, error) {
+	saga := &model.SagaModel{
+		SagaID:   util.GetUUID(),
+		SagaType: sagaType,
+		OrderID:  orderID,
+		PayID:    util.GetUUID(),
+		Status:   SagaStatusRunning,
+	}
+
+	if err := s.db.Create(saga).Error; err != nil {
+		if !strings.Contains(err.Error(), "Duplicate entry") {
+			logger.Error(err)
+			return nil, err
+		}
+
+		saga = &model.SagaModel{}
+		if err := s.db.Where("saga_type = ? AND order_id = ?", sagaType, orderID).First(saga).Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+	}
+
+	return saga, nil
+}
+
+func (s *Service) setSagaState(saga *model.SagaModel, step int, status uint8, lastError string) error {
+	if len(lastError) > 500 {
+		lastError = lastError[:500]
+	}
+
+	param := map[string]interface{}{
+		"step":       step,
+		"status":     status,
+		"last_error": lastError,
+	}
+	if err := s.db.Model(model.SagaModel{}).Where("saga_id = ?", saga.SagaID).Updates(param).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	saga.Step = step
+	saga.Status = status
+	saga.LastError = lastError
+	return nil
+}
+
+// 可重试的错误只记录下来,由后台任务继续推进,超过最大重试次数后需要人工处理
+func (s *Service) sagaRetryLater(saga *model.SagaModel, stepErr error) {
+	saga.RetryTimes++
+
+	param := map[string]interface{}{
+		"retry_times": saga.RetryTimes,
+		"last_error":  stepErr.Error(),
+	}
+	if saga.RetryTimes >= SagaMaxRetryTimes {
+		logger.Error("saga", saga.SagaID, "retry too many times, need manual handling")
+		saga.Status = SagaStatusFailed
+		param["status"] = saga.Status
+	}
+
+	if err := s.db.Model(model.SagaModel{}).Where("saga_id = ?", saga.SagaID).Updates(param).Error; err != nil {
+		logger.Error(err)
+	}
+}
+
+func (s *Service) addSagaLog(saga *model.SagaModel, stepName string, action uint8, stepErr error) {
+	sagaLog := &model.SagaLogModel{
+		SagaID:   saga.SagaID,
+		Step:     saga.Step,
+		StepName: stepName,
+		Action:   action,
+		Result:   SagaResultSuccess,
+		LogTime:  time.Now().Unix(),
+	}
+	if stepErr != nil {
+		sagaLog.Result = SagaResultFail
+		sagaLog.ErrMsg = stepErr.Error()
+	}
+
+	if err := s.db.Create(sagaLog).Error; err != nil {
+		logger.Error(err)
+	}
+}
+
+// 从saga当前步骤继续执行,调用方需要持有saga锁
+func (s *Service) runSaga(ctx context.Context, saga *model.SagaModel) error {
+	steps := s.sagaSteps(saga.SagaType)
+	if steps == nil {
+		return fmt.Errorf("unknown saga type %s", saga.SagaType)
+	}
+
+	return s.runSagaSteps(ctx, saga, steps)
+}
+
+func (s *Service) runSagaSteps(ctx context.Context, saga *model.SagaModel, steps []*sagaStep) error {
+	for saga.Status == SagaStatusRunning {
+		if saga.Step >= len(steps) {
+			return s.setSagaState(saga, saga.Step, SagaStatusDone, "")
+		}
+
+		step := steps[saga.Step]
+		err := step.Action(ctx, saga)
+		if _, ok := err.(*sagaSuspendError); ok {
+			logger.Info("saga", saga.SagaID, "step", step.Name, "suspend:", err)
+			return nil
+		}
+
+		s.addSagaLog(saga, step.Name, SagaActionForward, err)
+		if err == nil {
+			if err := s.setSagaState(saga, saga.Step+1, SagaStatusRunning, ""); err != nil {
+				return err
+			}
+			continue
+		}
+
+		logger.Error("saga", saga.SagaID, "step", step.Name, "fail:", err)
+
+		if _, ok := err.(*sagaAbortError); !ok {
+			s.sagaRetryLater(saga, err)
+			return err
+		}
+
+		// 当前步骤没有生效,从上一步开始补偿;部分生效的步骤先补偿自己
+		next := saga.Step - 1
+		if step.Partial {
+			next = saga.Step
+		}
+		if err := s.setSagaState(saga, next, SagaStatusCompensating, err.Error()); err != nil {
+			return err
+		}
+	}
+
+	for saga.Status == SagaStatusCompensating {
+		if saga.Step < 0 {
+			return s.setSagaState(saga, saga.Step, SagaStatusCompensated, saga.LastError)
+		}
+
+		step := steps[saga.Step]
+		if step.Compensate != nil {
+			err := step.Compensate(ctx, saga)
+			s.addSagaLog(saga, step.Name, SagaActionCompensate, err)
+			if err != nil {
+				logger.Error("saga", saga.SagaID, "compensate", step.Name, "fail:", err)
+				s.sagaRetryLater(saga, err)
+				return err
+			}
+		}
+
+		if err := s.setSagaState(saga, saga.Step-1, SagaStatusCompensating, saga.LastError); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) runSagaWithLock(ctx context.Context, saga *model.SagaModel) error {
+	lock := redis.NewDistLock(s.RedisPool, "Saga"+saga.SagaID, s.Config.Redis.RedisLockTimeout)
+	if err := lock.Lock(); err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer lock.Unlock()
+
+	// 拿到锁之后重新加载,saga可能已经被别的实例推进
+	if err := s.db.Where("saga_id = ?", saga.SagaID).First(saga).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return s.runSaga(ctx, saga)
+}
+
+// 后台恢复因宕机或可重试错误而中断的saga
+func SagaStartRecover(s *Service) {
+	go func() {
+		ticker := time.NewTicker(SagaRecoverTimerDuration)
+
+		for {
+			select {
+			case <-ticker.C:
+				s.recoverSagas()
+			}
+		}
+	}()
+}
+
+func (s *Service) recoverSagas() {
+	var sagas []*model.SagaModel
+	err := s.db.Where("status IN (?) AND update_time < ?", []int{SagaStatusRunning, SagaStatusCompensating}, time.Now().Add(-SagaRecoverDelay)).
+		Order("id asc").Limit(SagaRecoverBatchSize).Find(&sagas).Error
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	for _, saga := range sagas {
+		logger.Info("recover saga", saga.SagaID, saga.SagaType, saga.OrderID)
+		if err := s.runSagaWithLock(context.Background(), saga); err != nil {
+			logger.Error(err)
+		}
+	}
+}
+
+func (s *Service) payOrderSagaSteps() []*sagaStep {
+	return []*sagaStep{
+		{Name: "checkOrder", Action: s.sagaCheckOrder, Compensate: s.sagaRevokeOrder},
+		{Name: "pay", Action: s.sagaPay, Compensate: s.sagaRefund},
+		{Name: "deductStock", Action: s.sagaDeductStock, Compensate: s.sagaRestoreStock, Partial: true},
+		{Name: "updateOrderStatus", Action: s.sagaUpdateOrderStatus},
+	}
+}
+
+func (s *Service) getSagaOrder(saga *model.SagaModel) (*model.OrderModel, error) {
+	var order model.OrderModel
+	if err := s.db.Where("order_id = ?", saga.OrderID).First(&order).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &order, nil
+}
+
+func (s *Service) sagaCheckOrder(ctx context.Context, saga *model.SagaModel) error {
+	order, err := s.getSagaOrder(saga)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == OrderStatusPaid && order.PayID == saga.PayID {
+		return nil
+	}
+
+	if !isOrderUnpaid(order) {
+		return abortSaga("current status is not unpaid")
+	}
+
+	return nil
+}
+
+// 0.生成了订单但是没库存,直接撤销,同时释放预占的库存
+func (s *Service) sagaRevokeOrder(ctx context.Context, saga *model.SagaModel) error {
+	order, err := s.getSagaOrder(saga)
+	if err != nil {
+		return err
+	}
+
+	if isOrderUnpaid(order) {
+		err := s.changeOrderStatus(order, OrderStatusCancelled, OrderActorSaga, "pay order fail: "+saga.LastError, nil)
+		if err != nil && err != ErrOrderStatusChanged {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	return s.releaseOrderReservation(ctx, order)
+}
+
+// 1.创建支付单后等待渠道回调,回调或后台任务查询到结果后继续推进
+func (s *Service) sagaPay(ctx context.Context, saga *model.SagaModel) error {
+	order, err := s.getSagaOrder(saga)
+	if err != nil {
+		return err
+	}
+
+	p, err := s.getOrCreatePayment(order, saga.PayID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.syncPayment(ctx, order, p); err != nil {
+		return err
+	}
+
+	switch p.Status {
+	case PaymentStatusSucceeded:
+		return nil
+	case PaymentStatusFailed:
+		return abortSaga("payment failed")
+	}
+
+	if time.Now().Unix() > p.ExpireTime {
+		return abortSaga("payment timeout")
+	}
+
+	return suspendSaga("waiting for payment")
+}
+
+// 3.支付成功，扣库存失败(没库存)，退款
+func (s *Service) sagaRefund(ctx context.Context, saga *model.SagaModel) error {
+	p, err := s.getPayment(saga.PayID)
+	if err != nil {
+		return err
+	}
+
+	// 还没支付成功的,之后收到支付成功回调时再退款
+	if p == nil || p.Status != PaymentStatusSucceeded {
+		return nil
+	}
+
+	return s.refundPayment(ctx, p, saga.PayID, p.Amount-p.RefundedAmount, "pay order fail: "+saga.LastError)
+}
+
+// 订单的每个商品分别扣库存,任一商品失败整个saga补偿,已扣的按支付号归还
+func (s *Service) sagaDeductStock(ctx context.Context, saga *model.SagaModel) error {
+	order, err := s.getSagaOrder(saga)
+	if err != nil {
+		return err
+	}
+
+	items, err := s.getOrderItems(order)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		if err := s.sagaDeductItemStock(ctx, saga, item); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (s *Service) sagaDeductItemStock(ctx context.Context, saga *model.SagaModel, item *model.OrderItemModel) error {
+	// 下单时预占过库存的订单只需要确认预占
+	confirmReq := &goodspb.ConfirmReservationRequest{
+		GoodsID: item.GoodsID,
+		SkuID:   item.SkuID,
+		OrderID: saga.OrderID,
+		PayID:   saga.PayID,
+	}
+	confirmResp, err := s.GoodsServiceClient.ConfirmReservation(ctx, confirmReq)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	switch confirmResp.Code {
+	case 0, common.ErrDeductStockRepeat:
+		return nil
+	case common.ErrReservationExpired:
+		return abortSaga(confirmResp.CodeMsg)
+	case common.ErrReservationNotFound:
+	default:
+		logger.Error("Code:", confirmResp.Code, "CodeMsg:", confirmResp.CodeMsg)
+		return errors.New(confirmResp.CodeMsg)
+	}
+
+	deductStockReq := &goodspb.DeductStockRequest{
+		GoodsID: item.GoodsID,
+		SkuID:   item.SkuID,
+		OrderID: saga.OrderID,
+		PayID:   saga.PayID,
+		Number:  item.Count,
+	}
+	deductStockResp, err := s.GoodsServiceClient.DeductStock(ctx, deductStockReq)
+	if err != nil {
+		// 2.支付成功，扣库存失败(库存足)，稍后重试
+		logger.Error(err)
+		return err
+	}
+
+	if deductStockResp.Code == common.ErrStockIsNotEnough {
+		return abortSaga(deductStockResp.CodeMsg)
+	}
+
+	if deductStockResp.Code != 0 && deductStockResp.Code != common.ErrDeductStockRepeat {
+		logger.Error("Code:", deductStockResp.Code, "CodeMsg:", deductStockResp.CodeMsg)
+		return errors.New(deductStockResp.CodeMsg)
+	}
+
+	return nil
+}
+
+func (s *Service) sagaRestoreStock(ctx context.Context, saga *model.SagaModel) error {
+	order, err := s.getSagaOrder(saga)
+	if err != nil {
+		return err
+	}
+
+	items, err := s.getOrderItems(order)
+	if err != nil {
+		return err
+	}
+
+	for _, item := range items {
+		restoreStockReq := &goodspb.RestoreStockRequest{
+			GoodsID: item.GoodsID,
+			SkuID:   item.SkuID,
+			OrderID: order.OrderID,
+			PayID:   saga.PayID,
+		}
+		restoreStockResp, err := s.GoodsServiceClient.RestoreStock(ctx, restoreStockReq)
+		if err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		if restoreStockResp.Code != 0 && restoreStockResp.Code != common.ErrRestoreStockRepeat {
+			logger.Error("Code:", restoreStockResp.Code, "CodeMsg:", restoreStockResp.CodeMsg)
+			return errors.New(restoreStockResp.CodeMsg)
+		}
+	}
+
+	return nil
+}
+
+// 4.扣库存成功，修改支付状态失败，稍后重试
+func (s *Service) sagaUpdateOrderStatus(ctx context.Context, saga *model.SagaModel) error {
+	order, err := s.getSagaOrder(saga)
+	if err != nil {
+		return err
+	}
+
+	if order.Status == OrderStatusPaid && order.PayID == saga.PayID {
+		return nil
+	}
+
+	param := map[string]interface{}{
+		"pay":    order.Price,
+		"pay_id": saga.PayID,
+	}
+	err = s.changeOrderStatus(order, OrderStatusPaid, OrderActorSaga, "pay success", param)
+	switch err {
+	case nil:
+	case ErrOrderStatusIllegal:
+		return abortSaga("order status changed during payment")
+	case ErrOrderStatusChanged:
+		// 状态被其他人修改,重试时重新检查
+		return err
+	default:
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *Service) GetOrderSaga(ctx context.Context, req *proto.GetOrderSagaRequest) (*proto.GetOrderSagaResponse, error) {
+	if req.OrderID == "" {
+		return nil, errors.New("orderID is null")
+	}
+
+	var sagas []*model.SagaModel
+	if err := s.db.Where("order_id = ?", req.OrderID).Order("id asc").Find(&sagas).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.GetOrderSagaResponse{}
+	for _, saga := range sagas {
+		sagaInfo := &proto.SagaInfo{
+			SagaID:     saga.SagaID,
+			SagaType:   saga.SagaType,
+			OrderID:    saga.OrderID,
+			PayID:      saga.PayID,
+			Step:       uint32(saga.Step),
+			Status:     uint32(saga.Status),
+			RetryTimes: saga.RetryTimes,
+			LastError:  saga.LastError,
+		}
+
+		steps := s.sagaSteps(saga.SagaType)
+		if saga.Step >= 0 && saga.Step < len(steps) {
+			sagaInfo.StepName = steps[saga.Step].Name
+		}
+		if saga.Step < 0 {
+			sagaInfo.Step = 0
+		}
+
+		logs, err := model.GetSagaLogs(s.db, saga.SagaID)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		for _, v := range logs {
+			sagaInfo.Logs = append(sagaInfo.Logs, &proto.SagaStepLog{
+				Step:     uint32(v.Step),
+				StepName: v.StepName,
+				Action:   uint32(v.Action),
+				Result:   uint32(v.Result),
+				ErrMsg:   v.ErrMsg,
+				LogTime:  v.LogTime,
+			})
+		}
+
+		resp.SagaInfos = append(resp.SagaInfos, sagaInfo)
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,175 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/jinzhu/gorm"
+	_ "github.com/jinzhu/gorm/dialects/sqlite"
+)
+
+func newTestService(t *testing.T) *Service {
+	db, err := gorm.Open("sqlite3", ":memory:")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 内存库每个连接是独立的库
+	db.DB().SetMaxOpenConns(1)
+
+	if err := db.AutoMigrate(&model.SagaModel{}, &model.SagaLogModel{}).Error; err != nil {
+		db.Close()
+		t.Fatal(err)
+	}
+	return &Service{db: db, Config: &Config{}}
+}
+
+// 记录步骤的执行顺序,补偿记为"-"加步骤名
+type sagaRecorder struct {
+	trace         []string
+	actionErr     map[string]error
+	compensateErr map[string]error
+}
+
+func (r *sagaRecorder) step(name string, partial bool) *sagaStep {
+	return &sagaStep{
+		Name: name,
+		Action: func(ctx context.Context, saga *model.SagaModel) error {
+			r.trace = append(r.trace, name)
+			return r.actionErr[name]
+		},
+		Compensate: func(ctx context.Context, saga *model.SagaModel) error {
+			r.trace = append(r.trace, "-"+name)
+			return r.compensateErr[name]
+		},
+		Partial: partial,
+	}
+}
+
+func (r *sagaRecorder) steps() []*sagaStep {
+	return []*sagaStep{r.step("a", false), r.step("b", false), r.step("c", true)}
+}
+
+func newTestSaga(t *testing.T, s *Service) *model.SagaModel {
+	saga := &model.SagaModel{SagaID: "saga", SagaType: "test", OrderID: "order", Status: SagaStatusRunning}
+	if err := s.db.Create(saga).Error; err != nil {
+		t.Fatal(err)
+	}
+	return saga
+}
+
+func TestRunSagaSteps(t *testing.T) {
+	tests := []struct {
+		name          string
+		actionErr     map[string]error
+		compensateErr map[string]error
+		wantTrace     string
+		wantStatus    uint8
+		wantStep      int
+	}{
+		{
+			name:       "done",
+			wantTrace:  "a,b,c",
+			wantStatus: SagaStatusDone,
+			wantStep:   3,
+		},
+		{
+			// 第一步没有生效,不需要补偿
+			name:       "abort first step",
+			actionErr:  map[string]error{"a": abortSaga("a")},
+			wantTrace:  "a",
+			wantStatus: SagaStatusCompensated,
+			wantStep:   -1,
+		},
+		{
+			name:       "abort middle step",
+			actionErr:  map[string]error{"b": abortSaga("b")},
+			wantTrace:  "a,b,-a",
+			wantStatus: SagaStatusCompensated,
+			wantStep:   -1,
+		},
+		{
+			// 部分生效的步骤先补偿自己,再倒序补偿前面的步骤
+			name:       "abort partial step",
+			actionErr:  map[string]error{"c": abortSaga("c")},
+			wantTrace:  "a,b,c,-c,-b,-a",
+			wantStatus: SagaStatusCompensated,
+			wantStep:   -1,
+		},
+		{
+			// 可重试的错误停在当前步骤,不补偿
+			name:       "retryable error",
+			actionErr:  map[string]error{"b": errors.New("b")},
+			wantTrace:  "a,b",
+			wantStatus: SagaStatusRunning,
+			wantStep:   1,
+		},
+		{
+			name:       "suspend",
+			actionErr:  map[string]error{"b": suspendSaga("b")},
+			wantTrace:  "a,b",
+			wantStatus: SagaStatusRunning,
+			wantStep:   1,
+		},
+		{
+			// 补偿失败停在失败的步骤,下次从这里继续补偿
+			name:          "compensate error",
+			actionErr:     map[string]error{"c": abortSaga("c")},
+			compensateErr: map[string]error{"b": errors.New("b")},
+			wantTrace:     "a,b,c,-c,-b",
+			wantStatus:    SagaStatusCompensating,
+			wantStep:      1,
+		},
+	}
+
+	for _, tt := range tests {
+		s := newTestService(t)
+		saga := newTestSaga(t, s)
+
+		r := &sagaRecorder{actionErr: tt.actionErr, compensateErr: tt.compensateErr}
+		s.runSagaSteps(context.Background(), saga, r.steps())
+
+		if got := strings.Join(r.trace, ","); got != tt.wantTrace {
+			t.Errorf("%s: trace = %q, want %q", tt.name, got, tt.wantTrace)
+		}
+
+		// 状态要落库,恢复任务从库里读
+		var stored model.SagaModel
+		if err := s.db.Where("saga_id = ?", saga.SagaID).First(&stored).Error; err != nil {
+			t.Fatal(err)
+		}
+		if stored.Status != tt.wantStatus || stored.Step != tt.wantStep {
+			t.Errorf("%s: status = %d, step = %d, want status = %d, step = %d", tt.name, stored.Status, stored.Step, tt.wantStatus, tt.wantStep)
+		}
+		s.db.Close()
+	}
+}
+
+func TestRunSagaStepsResumeCompensation(t *testing.T) {
+	s := newTestService(t)
+	defer s.db.Close()
+	saga := newTestSaga(t, s)
+
+	r := &sagaRecorder{
+		actionErr:     map[string]error{"c": abortSaga("c")},
+		compensateErr: map[string]error{"b": errors.New("b")},
+	}
+	if err := s.runSagaSteps(context.Background(), saga, r.steps()); err == nil {
+		t.Fatal("compensate error is not returned")
+	}
+
+	// 恢复后只补偿还没补偿的步骤
+	r.trace = nil
+	r.compensateErr = nil
+	if err := s.runSagaSteps(context.Background(), saga, r.steps()); err != nil {
+		t.Fatal(err)
+	}
+	if got := strings.Join(r.trace, ","); got != "-b,-a" {
+		t.Fatalf("trace after resume = %q", got)
+	}
+	if saga.Status != SagaStatusCompensated || saga.RetryTimes != 1 {
+		t.Fatalf("status = %d, retryTimes = %d", saga.Status, saga.RetryTimes)
+	}
+}
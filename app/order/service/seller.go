@@ -0,0 +1,144 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/harveywangdao/ants/app/order/model"
+	"github.com/harveywangdao/ants/logger"
+	proto "github.com/harveywangdao/ants/rpc/order"
+	"github.com/harveywangdao/ants/seller"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+商家接口
+1.商家ID从gRPC metadata取,见seller包
+2.订单的商家在下单时从商品上取
+3.销售汇总按订单创建时间统计,gmv和GetSalesStats一样用订单金额
+*/
+
+type sellerStatusSummary struct {
+	Status uint8   `gorm:"column:status"`
+	Orders int64   `gorm:"column:orders"`
+	Amount float64 `gorm:"column:amount"`
+}
+
+type sellerGoodsSales struct {
+	GoodsID string `gorm:"column:goods_id"`
+	Sales   int64  `gorm:"column:sales"`
+}
+
+// 已支付过的订单状态,退款的单独统计;不用[]uint8,gorm会当成[]byte
+var sellerPaidStatuses = []int{OrderStatusPaid, OrderStatusShipped, OrderStatusCompleted}
+
+// 我的订单,参数和分页同ListOrders
+func (s *Service) ListSellerOrders(ctx context.Context, req *proto.ListSellerOrdersRequest) (*proto.ListSellerOrdersResponse, error) {
+	sellerID, err := seller.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	listResp, err := s.ListOrders(ctx, &proto.ListOrdersRequest{
+		SellerID:   sellerID,
+		GoodsID:    req.GoodsID,
+		Statuses:   req.Statuses,
+		StartTime:  req.StartTime,
+		EndTime:    req.EndTime,
+		SortBy:     req.SortBy,
+		Asc:        req.Asc,
+		NumPerPage: req.NumPerPage,
+		LastID:     req.LastID,
+		LastPrice:  req.LastPrice,
+	})
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &proto.ListSellerOrdersResponse{
+		Code:       listResp.Code,
+		CodeMsg:    listResp.CodeMsg,
+		OrderInfos: listResp.OrderInfos,
+		HasMore:    listResp.HasMore,
+		LastID:     listResp.LastID,
+		LastPrice:  listResp.LastPrice,
+	}, nil
+}
+
+func sellerOrderQuery(db *gorm.DB, sellerID string, startTime, endTime int64) *gorm.DB {
+	query := db.Where("order_tb.seller_id = ?", sellerID)
+	if startTime > 0 {
+		query = query.Where("order_tb.create_time >= ?", time.Unix(startTime, 0))
+	}
+	if endTime > 0 {
+		query = query.Where("order_tb.create_time < ?", time.Unix(endTime, 0))
+	}
+	return query
+}
+
+// 销售汇总,时间是unix时间戳,endTime不包含
+func (s *Service) GetSellerSalesSummary(ctx context.Context, req *proto.GetSellerSalesSummaryRequest) (*proto.GetSellerSalesSummaryResponse, error) {
+	sellerID, err := seller.Require(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.TopN > AnalyticsMaxTopN {
+		return nil, fmt.Errorf("topN can not be more than %d", AnalyticsMaxTopN)
+	}
+
+	topN := req.TopN
+	if topN == 0 {
+		topN = AnalyticsDefaultTopN
+	}
+
+	var summaries []*sellerStatusSummary
+	err = sellerOrderQuery(s.db.Model(model.OrderModel{}), sellerID, req.StartTime, req.EndTime).
+		Select("status, count(*) as orders, sum(price) as amount").Group("status").Scan(&summaries).Error
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	resp := &proto.GetSellerSalesSummaryResponse{}
+	for _, summary := range summaries {
+		resp.TotalOrders += summary.Orders
+
+		if summary.Status == OrderStatusRefunded {
+			resp.RefundedOrders += summary.Orders
+			resp.RefundAmount += summary.Amount
+			continue
+		}
+
+		for _, status := range sellerPaidStatuses {
+			if int(summary.Status) == status {
+				resp.PaidOrders += summary.Orders
+				resp.Gmv += summary.Amount
+			}
+		}
+	}
+	resp.Gmv = roundPrice(resp.Gmv)
+	resp.RefundAmount = roundPrice(resp.RefundAmount)
+
+	var goodsSales []*sellerGoodsSales
+	err = sellerOrderQuery(s.db.Table("order_item_tb"), sellerID, req.StartTime, req.EndTime).
+		Select("order_item_tb.goods_id as goods_id, sum(order_item_tb.count) as sales").
+		Joins("JOIN order_tb ON order_tb.order_id = order_item_tb.order_id").
+		Where("order_tb.status in (?) AND order_tb.is_delete = 0 AND order_item_tb.is_delete = 0", sellerPaidStatuses).
+		Group("order_item_tb.goods_id").Order("sales desc").Limit(topN).Scan(&goodsSales).Error
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	for _, g := range goodsSales {
+		resp.TopGoods = append(resp.TopGoods, &proto.GoodsSales{
+			GoodsID: g.GoodsID,
+			Sales:   g.Sales,
+		})
+	}
+
+	return resp, nil
+}
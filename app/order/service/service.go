@@ -8,9 +8,13 @@ import (
 	"time"
 
 	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/database/softdelete"
+	"github.com/harveywangdao/ants/idempotency"
 	"github.com/harveywangdao/ants/logger"
+	"github.com/harveywangdao/ants/payment"
 	"github.com/harveywangdao/ants/register"
 	"github.com/harveywangdao/ants/register/discovery"
+	couponpb "github.com/harveywangdao/ants/rpc/coupon"
 	goodspb "github.com/harveywangdao/ants/rpc/goods"
 	proto "github.com/harveywangdao/ants/rpc/order"
 	"github.com/harveywangdao/ants/util"
@@ -25,8 +29,12 @@ type Service struct {
 	discovery *discovery.Discovery
 	db        *gorm.DB
 	RedisPool *redis.RedisPool
+	Payment   payment.Provider
 
-	GoodsServiceClient goodspb.GoodsServiceClient
+	Idempotency idempotency.Store
+
+	GoodsServiceClient  goodspb.GoodsServiceClient
+	CouponServiceClient couponpb.CouponServiceClient
 }
 
 var (
@@ -76,6 +84,7 @@ func initService() error {
 	db.DB().SetMaxIdleConns(10)
 	db.DB().SetMaxOpenConns(100)
 	db.DB().SetConnMaxLifetime(time.Hour)
+	softdelete.Register(db)
 
 	App.db = db
 
@@ -84,6 +93,11 @@ func initService() error {
 		return err
 	}
 
+	if err := App.initCouponServiceClient(); err != nil {
+		logger.Error(err)
+		return err
+	}
+
 	// Redis
 	pool, err := redis.NewRedisPool(App.Config.Redis.Address, App.Config.Redis.Password)
 	if err != nil {
@@ -91,8 +105,19 @@ func initService() error {
 		return err
 	}
 	App.RedisPool = pool
+	App.Idempotency = idempotency.NewRedisStore(pool, idempotencyTTLSeconds(App.Config))
+
+	if err := App.initPayment(); err != nil {
+		logger.Error(err)
+		return err
+	}
 
 	DeductStockEventStartListen(App)
+	SagaStartRecover(App)
+	FlashSaleStartPersist(App)
+	FlashSaleStartReconcile(App)
+	DelayJobStartRun(App)
+	softdelete.StartPurge(softDeleteRetentionDays(App.Config), App.purgeOrders)
 
 	return nil
 }
@@ -137,7 +162,7 @@ func StartService() error {
 	}
 	logger.Info("rpc server:", lis.Addr())
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(idempotency.UnaryServerInterceptor(App.Idempotency)))
 	proto.RegisterOrderServiceServer(s, App)
 	reflection.Register(s)
 
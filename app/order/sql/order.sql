@@ -8,8 +8,13 @@ CREATE TABLE IF NOT EXISTS `order_tb`(
    `count` INT(11) NOT NULL COMMENT '购买数量',
    `price` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '价格,单位为分',
    `pay` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '已支付金额,单位为分',
-   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:未完成 1:完成',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:已创建 1:已支付 2:已取消 3:已预占库存 4:已发货 5:已完成 6:已退款',
+   `pay_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '支付号',
    `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `activity_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的促销活动',
+   `discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '促销优惠金额',
+   `coupon_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的优惠券',
+   `coupon_discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '优惠券优惠金额',
    `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
    `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
    `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
@@ -20,6 +25,104 @@ CREATE TABLE IF NOT EXISTS `order_tb`(
    UNIQUE INDEX `unique_order_id` (`order_id`)
 )ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '订单表';
 
+-- 老库升级用,新建的库上面的建表语句已经包含这些改动,不用执行
+ALTER TABLE order_tb ADD COLUMN `pay_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '支付号' AFTER `status`;
+ALTER TABLE order_tb ADD COLUMN `activity_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的促销活动' AFTER `remark`, ADD COLUMN `discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '促销优惠金额' AFTER `activity_id`;
+ALTER TABLE order_tb ADD COLUMN `coupon_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的优惠券' AFTER `discount`, ADD COLUMN `coupon_discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '优惠券优惠金额' AFTER `coupon_id`;
+
+CREATE TABLE IF NOT EXISTS `order_item_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
+   `goods_id` VARCHAR(50) NOT NULL COMMENT '商品唯一标识',
+   `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU唯一标识,没有SKU的商品为空',
+   `goods_name` VARCHAR(100) NOT NULL COMMENT '下单时的商品名称',
+   `price` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '下单时的单价,单位为分',
+   `count` INT(11) NOT NULL COMMENT '购买数量',
+   `amount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '小计,单位为分',
+   `activity_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的促销活动',
+   `discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '促销优惠金额',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_goods_id` (`goods_id`),
+   UNIQUE INDEX `unique_order_id_goods_id_sku_id` (`order_id`, `goods_id`, `sku_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '订单商品表';
+
+-- 老库升级用,新建的库上面的建表语句已经包含这些改动,不用执行
+ALTER TABLE order_item_tb ADD COLUMN `activity_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '使用的促销活动' AFTER `amount`, ADD COLUMN `discount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '促销优惠金额' AFTER `activity_id`;
+ALTER TABLE order_item_tb ADD COLUMN `sku_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT 'SKU唯一标识,没有SKU的商品为空' AFTER `goods_id`, DROP INDEX `unique_order_id_goods_id`, ADD UNIQUE INDEX `unique_order_id_goods_id_sku_id` (`order_id`, `goods_id`, `sku_id`);
+
+CREATE TABLE IF NOT EXISTS `payment_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `pay_id` VARCHAR(50) NOT NULL COMMENT '支付号,即渠道的商户订单号',
+   `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
+   `provider` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '支付渠道',
+   `trade_no` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '渠道交易号',
+   `amount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '支付金额,单位为分',
+   `refunded_amount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '已退款金额,单位为分',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:待支付 1:支付成功 2:支付失败 3:已全额退款',
+   `expire_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '支付超时时间,unix时间戳',
+   `notify_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '收到支付结果的时间,unix时间戳',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_order_id` (`order_id`),
+   UNIQUE INDEX `unique_pay_id` (`pay_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '支付表';
+
+CREATE TABLE IF NOT EXISTS `saga_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `saga_id` VARCHAR(50) NOT NULL COMMENT 'saga唯一标识',
+   `saga_type` VARCHAR(50) NOT NULL COMMENT 'saga类型',
+   `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
+   `pay_id` VARCHAR(50) NOT NULL DEFAULT '' COMMENT '支付号',
+   `step` INT(11) NOT NULL DEFAULT 0 COMMENT '当前步骤',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:执行中 1:完成 2:补偿中 3:已补偿 4:失败',
+   `retry_times` INT(11) NOT NULL DEFAULT 0 COMMENT '重试次数',
+   `last_error` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '最后一次错误',
+   `remark` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '备注',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_status` (`status`),
+   UNIQUE INDEX `unique_saga_id` (`saga_id`),
+   UNIQUE INDEX `unique_saga_type_order_id` (`saga_type`, `order_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT 'saga表';
+
+CREATE TABLE IF NOT EXISTS `saga_log_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `saga_id` VARCHAR(50) NOT NULL COMMENT 'saga唯一标识',
+   `step` INT(11) NOT NULL COMMENT '步骤',
+   `step_name` VARCHAR(50) NOT NULL COMMENT '步骤名称',
+   `action` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '动作 0:正向 1:补偿',
+   `result` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '结果 0:成功 1:失败',
+   `err_msg` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '错误信息',
+   `log_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '记录时间,unix时间戳',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_saga_id` (`saga_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT 'saga步骤日志表';
+
+CREATE TABLE IF NOT EXISTS `order_status_history_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
+   `from_status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '变更前状态',
+   `to_status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '变更后状态',
+   `actor` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '操作者',
+   `reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '变更原因',
+   `log_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '变更时间,unix时间戳',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_order_id` (`order_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '订单状态变更历史表';
+
 ALTER TABLE order_tb ADD COLUMN `count` INT(11) NOT NULL COMMENT '购买数量' AFTER `goods_name`;
 ALTER TABLE order_tb MODIFY COLUMN `count` INT(11) NOT NULL COMMENT '购买数量';
 ALTER TABLE order_tb DROP COLUMN `count`;
@@ -28,3 +131,22 @@ ALTER TABLE order_tb COMMENT '修改表注释';
 ALTER TABLE order_tb CHANGE `count` `count` INT(11) NOT NULL COMMENT '购买数量' AFTER `goods_name`;
 
 ALTER TABLE order_tb ADD INDEX `index_goods_name` (`goods_name`);
+
+CREATE TABLE IF NOT EXISTS `refund_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `refund_id` VARCHAR(50) NOT NULL COMMENT '退款号',
+   `order_id` VARCHAR(50) NOT NULL COMMENT '订单唯一标识',
+   `pay_id` VARCHAR(50) NOT NULL COMMENT '支付号',
+   `amount` DECIMAL(12,2) NOT NULL DEFAULT 0 COMMENT '退款金额,单位为分',
+   `reason` VARCHAR(500) NOT NULL DEFAULT '' COMMENT '退款原因',
+   `actor` VARCHAR(100) NOT NULL DEFAULT '' COMMENT '发起人',
+   `status` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '状态 0:退款中 1:退款成功',
+   `finish_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '退款完成时间,unix时间戳',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   INDEX `index_order_id` (`order_id`),
+   UNIQUE INDEX `unique_refund_id` (`refund_id`),
+   UNIQUE INDEX `unique_pay_id` (`pay_id`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '退款表';
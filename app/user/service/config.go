@@ -41,13 +41,23 @@ type ClientConfig struct {
 	AntServiceName string `yaml:"antServiceName" json:"antServiceName"`
 }
 
+type IdempotencyConfig struct {
+	TTLSeconds int64 `yaml:"ttlSeconds" json:"ttlSeconds"`
+}
+
+type SoftDeleteConfig struct {
+	RetentionDays int64 `yaml:"retentionDays" json:"retentionDays"`
+}
+
 type Config struct {
-	Log        *LogConfig        `yaml:"log" json:"log"`
-	Etcd       *EtcdConfig       `yaml:"etcd" json:"etcd"`
-	Server     *ServerConfig     `yaml:"server" json:"server"`
-	HttpServer *HttpServerConfig `yaml:"httpServer" json:"httpServer"`
-	Database   *DatabaseConfig   `yaml:"database" json:"database"`
-	Client     *ClientConfig     `yaml:"client" json:"client"`
+	Log         *LogConfig         `yaml:"log" json:"log"`
+	Etcd        *EtcdConfig        `yaml:"etcd" json:"etcd"`
+	Server      *ServerConfig      `yaml:"server" json:"server"`
+	HttpServer  *HttpServerConfig  `yaml:"httpServer" json:"httpServer"`
+	Database    *DatabaseConfig    `yaml:"database" json:"database"`
+	Client      *ClientConfig      `yaml:"client" json:"client"`
+	Idempotency *IdempotencyConfig `yaml:"idempotency" json:"idempotency"`
+	SoftDelete  *SoftDeleteConfig  `yaml:"softDelete" json:"softDelete"`
 }
 
 func getConfig() (*Config, error) {
@@ -68,3 +78,17 @@ func getConfig() (*Config, error) {
 	logger.Debug("config:", string(data))
 	return &config, nil
 }
+
+func idempotencyTTLSeconds(config *Config) int64 {
+	if config.Idempotency == nil {
+		return 0
+	}
+	return config.Idempotency.TTLSeconds
+}
+
+func softDeleteRetentionDays(config *Config) int64 {
+	if config.SoftDelete == nil {
+		return 0
+	}
+	return config.SoftDelete.RetentionDays
+}
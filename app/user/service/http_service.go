@@ -9,6 +9,7 @@ import (
 	"reflect"
 
 	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/idempotency"
 	"github.com/harveywangdao/ants/logger"
 	userpb "github.com/harveywangdao/ants/rpc/user"
 )
@@ -25,6 +26,7 @@ func (h *HttpServer) StartHttpServer(httpService *HttpService) {
 
 	router.Use(gin.Logger())
 	router.Use(gin.Recovery())
+	router.Use(idempotency.GinMiddleware(httpService.ServiceApp.Idempotency))
 
 	router.POST("/ants/v1/"+h.ServiceName+"/:funcName", func(c *gin.Context) {
 		funcName := c.Param("funcName")
@@ -161,3 +163,14 @@ func (h *HttpService) DelUser(reqData []byte) (interface{}, error) {
 
 	return h.ServiceApp.DelUser(context.Background(), req)
 }
+
+func (h *HttpService) RestoreUser(reqData []byte) (interface{}, error) {
+	req := &userpb.RestoreUserRequest{}
+
+	if err := json.Unmarshal(reqData, req); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return h.ServiceApp.RestoreUser(context.Background(), req)
+}
@@ -7,6 +7,9 @@ import (
 	"path/filepath"
 	"time"
 
+	"github.com/harveywangdao/ants/app/user/model"
+	"github.com/harveywangdao/ants/database/softdelete"
+	"github.com/harveywangdao/ants/idempotency"
 	"github.com/harveywangdao/ants/logger"
 	"github.com/harveywangdao/ants/register"
 	"github.com/harveywangdao/ants/register/discovery"
@@ -22,6 +25,8 @@ type Service struct {
 	Config    *Config
 	discovery *discovery.Discovery
 	db        *gorm.DB
+
+	Idempotency idempotency.Store
 }
 
 var (
@@ -71,9 +76,15 @@ func initService() error {
 	db.DB().SetMaxIdleConns(10)
 	db.DB().SetMaxOpenConns(100)
 	db.DB().SetConnMaxLifetime(time.Hour)
+	softdelete.Register(db)
 
 	App.db = db
 
+	// 用户服务没有redis,幂等键存在MySQL
+	App.Idempotency = idempotency.NewMysqlStore(db, idempotencyTTLSeconds(App.Config))
+
+	softdelete.StartPurge(softDeleteRetentionDays(App.Config), softdelete.PurgeTables(db, model.UserModel{}.TableName()))
+
 	return nil
 }
 
@@ -117,7 +128,7 @@ func StartService() error {
 	}
 	logger.Info("rpc server:", lis.Addr())
 
-	s := grpc.NewServer()
+	s := grpc.NewServer(grpc.UnaryInterceptor(idempotency.UnaryServerInterceptor(App.Idempotency)))
 	userpb.RegisterUserServiceServer(s, App)
 	reflection.Register(s)
 
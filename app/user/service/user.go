@@ -3,8 +3,10 @@ package service
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/harveywangdao/ants/app/user/model"
+	"github.com/harveywangdao/ants/database/softdelete"
 	"github.com/harveywangdao/ants/logger"
 	userpb "github.com/harveywangdao/ants/rpc/user"
 	"github.com/harveywangdao/ants/util"
@@ -79,7 +81,7 @@ func (s *Service) GetUserIdByPhoneNumber(ctx context.Context, req *userpb.GetUse
 		return nil, err
 	}*/
 
-	err := s.db.Raw("SELECT user_id, create_time, update_time, is_delete FROM user_tb WHERE phone_number = ?", req.PhoneNumber).Scan(&user).Error
+	err := s.db.Raw("SELECT user_id, create_time, update_time, is_delete FROM user_tb WHERE phone_number = ? AND is_delete = 0", req.PhoneNumber).Scan(&user).Error
 	if err != nil {
 		logger.Error(err)
 		return nil, err
@@ -104,7 +106,7 @@ func (s *Service) GetUsersByName(ctx context.Context, req *userpb.GetUsersByName
 		return nil, err
 	}*/
 
-	err := s.db.Raw("SELECT user_id, create_time, update_time, is_delete FROM user_tb WHERE name = ?", req.Name).Scan(&users).Error
+	err := s.db.Raw("SELECT user_id, create_time, update_time, is_delete FROM user_tb WHERE name = ? AND is_delete = 0", req.Name).Scan(&users).Error
 	if err != nil {
 		logger.Error(err)
 		return nil, err
@@ -151,6 +153,7 @@ func (s *Service) ModifyUserInfo(ctx context.Context, req *userpb.ModifyUserInfo
 	}, nil
 }
 
+// 软删除,可以用RestoreUser恢复
 func (s *Service) DelUser(ctx context.Context, req *userpb.DelUserRequest) (*userpb.DelUserResponse, error) {
 	if req.UserID == "" {
 		return nil, errors.New("userID is null")
@@ -167,6 +170,26 @@ func (s *Service) DelUser(ctx context.Context, req *userpb.DelUserRequest) (*use
 	}, nil
 }
 
+func (s *Service) RestoreUser(ctx context.Context, req *userpb.RestoreUserRequest) (*userpb.RestoreUserResponse, error) {
+	if req.UserID == "" {
+		return nil, errors.New("userID is null")
+	}
+
+	restored, err := softdelete.Restore(s.db, model.UserModel{}, "user_id = ?", req.UserID)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if !restored {
+		return nil, fmt.Errorf("deleted user %s not found", req.UserID)
+	}
+
+	return &userpb.RestoreUserResponse{
+		CodeMsg: "restore success",
+	}, nil
+}
+
 func (s *Service) Login(ctx context.Context, req *userpb.LoginRequest) (*userpb.LoginResponse, error) {
 	if req.WxUserInfo == nil {
 		return nil, errors.New("WxUserInfo is null")
@@ -22,6 +22,20 @@ CREATE TABLE IF NOT EXISTS `user_tb`(
    UNIQUE INDEX `unique_user_id` (`user_id`)
 )ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '用户表';
 
+CREATE TABLE IF NOT EXISTS `idempotency_tb`(
+   `id` BIGINT(20) NOT NULL AUTO_INCREMENT COMMENT '主键',
+   `idem_key` VARCHAR(200) NOT NULL COMMENT '接口和幂等键',
+   `request_hash` VARCHAR(64) NOT NULL DEFAULT '' COMMENT '请求内容的sha256',
+   `done` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否执行完成 0:执行中 1:已完成',
+   `response` MEDIUMBLOB COMMENT '第一次请求的响应',
+   `expire_time` BIGINT(20) NOT NULL DEFAULT 0 COMMENT '过期时间,unix时间戳',
+   `create_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '创建时间',
+   `update_time` TIMESTAMP NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '更新时间',
+   `is_delete` TINYINT(1) NOT NULL DEFAULT 0 COMMENT '是否删除 0:未删除 1:已删除',
+   PRIMARY KEY (`id`),
+   UNIQUE INDEX `unique_idem_key` (`idem_key`)
+)ENGINE=InnoDB DEFAULT CHARSET=utf8 COMMENT '幂等键表';
+
 mysql -u root -p
 use ant_test;
 DROP TABLE user_tb;
@@ -6,6 +6,7 @@ import (
 	"github.com/gomodule/redigo/redis"
 	"github.com/harveywangdao/ants/logger"
 	"github.com/harveywangdao/ants/util"
+	"strconv"
 	"time"
 )
 
@@ -358,7 +359,7 @@ func (red *Redis) Hmget(key string, fields []string) ([]string, error) {
 		args = append(args, v)
 	}
 
-	value, err := redis.Strings(red.conn.Do("HGET", args...))
+	value, err := redis.Strings(red.conn.Do("HMGET", args...))
 	if err != nil {
 		logger.Error(err)
 		return nil, err
@@ -402,6 +403,47 @@ func (red *Redis) ListPop(key string) (string, error) {
 	return value, nil
 }
 
+// 从src队尾取出放到dst队头,src为空时返回空字符串
+func (red *Redis) ListPopPush(src, dst string) (string, error) {
+	value, err := redis.String(red.conn.Do("RPOPLPUSH", src, dst))
+	if err == redis.ErrNil {
+		return "", nil
+	}
+
+	if err != nil {
+		logger.Error(err)
+		return "", err
+	}
+
+	return value, nil
+}
+
+// index为负数时从队尾数,不存在时返回空字符串
+func (red *Redis) ListIndex(key string, index int) (string, error) {
+	value, err := redis.String(red.conn.Do("LINDEX", key, index))
+	if err == redis.ErrNil {
+		return "", nil
+	}
+
+	if err != nil {
+		logger.Error(err)
+		return "", err
+	}
+
+	return value, nil
+}
+
+// 删除一个等于value的元素
+func (red *Redis) ListRemove(key, value string) error {
+	_, err := red.conn.Do("LREM", key, 1, value)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
 func (red *Redis) SetAdd(key, value string) error {
 	_, err := red.conn.Do("SADD", key, value)
 	if err != nil {
@@ -502,3 +544,224 @@ func (red *Redis) Publish(channel, msg string) error {
 
 	return nil
 }
+
+func (red *Redis) Set(key, value string) error {
+	_, err := red.conn.Do("SET", key, value)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (red *Redis) GetInt64(key string) (int64, error) {
+	value, err := redis.Int64(red.conn.Do("GET", key))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return value, nil
+}
+
+func (red *Redis) IncrBy(key string, n int64) (int64, error) {
+	value, err := redis.Int64(red.conn.Do("INCRBY", key, n))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return value, nil
+}
+
+func (red *Redis) SetRemove(key, value string) error {
+	_, err := red.conn.Do("SREM", key, value)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// 执行返回整数的lua脚本,前keyCount个参数是KEYS,其余是ARGV
+func (red *Redis) EvalInt64(keyCount int, script string, keysAndArgs ...interface{}) (int64, error) {
+	sc := redis.NewScript(keyCount, script)
+
+	value, err := redis.Int64(sc.Do(red.conn, keysAndArgs...))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return value, nil
+}
+
+func (red *Redis) Expire(key string, seconds int64) error {
+	_, err := red.conn.Do("EXPIRE", key, seconds)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (red *Redis) HincrBy(key, field string, n int64) (int64, error) {
+	value, err := redis.Int64(red.conn.Do("HINCRBY", key, field, n))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return value, nil
+}
+
+func (red *Redis) Hdel(key string, fields ...string) error {
+	args := []interface{}{key}
+	for _, field := range fields {
+		args = append(args, field)
+	}
+
+	_, err := red.conn.Do("HDEL", args...)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (red *Redis) Hlen(key string) (int64, error) {
+	value, err := redis.Int64(red.conn.Do("HLEN", key))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return value, nil
+}
+
+// 按分数从小到大返回[min,max]之间的前count个成员
+func (red *Redis) ZsetRangeByScore(key string, min, max int64, count int) ([]string, error) {
+	value, err := redis.Strings(red.conn.Do("ZRANGEBYSCORE", key, min, max, "LIMIT", 0, count))
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func (red *Redis) ZsetRemove(key, value string) error {
+	_, err := red.conn.Do("ZREM", key, value)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// key不存在时返回空字符串
+func (red *Redis) GetString(key string) (string, error) {
+	value, err := redis.String(red.conn.Do("GET", key))
+	if err == redis.ErrNil {
+		return "", nil
+	}
+
+	if err != nil {
+		logger.Error(err)
+		return "", err
+	}
+
+	return value, nil
+}
+
+func (red *Redis) SetEx(key, value string, seconds int64) error {
+	_, err := red.conn.Do("SET", key, value, "EX", seconds)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// key不存在时设置,返回是否设置成功
+func (red *Redis) SetNxEx(key, value string, seconds int64) (bool, error) {
+	reply, err := red.conn.Do("SET", key, value, "NX", "EX", seconds)
+	if err != nil {
+		logger.Error(err)
+		return false, err
+	}
+
+	return reply != nil, nil
+}
+
+func (red *Redis) ZsetIncrBy(key, value string, n int64) (int64, error) {
+	score, err := redis.Int64(red.conn.Do("ZINCRBY", key, n, value))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return score, nil
+}
+
+// 按分数从高到低取排名在[start, stop]之间的成员,返回的成员和分数一一对应
+func (red *Redis) ZsetRevRangeWithScores(key string, start, stop int) ([]string, []int64, error) {
+	values, err := redis.Strings(red.conn.Do("ZREVRANGE", key, start, stop, "WITHSCORES"))
+	if err != nil {
+		logger.Error(err)
+		return nil, nil, err
+	}
+
+	var members []string
+	var scores []int64
+	for i := 0; i+1 < len(values); i += 2 {
+		score, err := strconv.ParseInt(values[i+1], 10, 64)
+		if err != nil {
+			logger.Error(err)
+			return nil, nil, err
+		}
+
+		members = append(members, values[i])
+		scores = append(scores, score)
+	}
+
+	return members, scores, nil
+}
+
+// 多个有序集合合并到dest,相同成员的分数相加
+func (red *Redis) ZsetUnionStore(dest string, keys ...string) error {
+	args := []interface{}{dest, len(keys)}
+	for _, key := range keys {
+		args = append(args, key)
+	}
+
+	_, err := red.conn.Do("ZUNIONSTORE", args...)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// 多个HyperLogLog合并后的基数
+func (red *Redis) HyperLogLogCount(keys ...string) (int64, error) {
+	args := []interface{}{}
+	for _, key := range keys {
+		args = append(args, key)
+	}
+
+	value, err := redis.Int64(red.conn.Do("PFCOUNT", args...))
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+
+	return value, nil
+}
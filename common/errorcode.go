@@ -1,6 +1,36 @@
 package common
 
 const (
-	ErrStockIsNotEnough  = 10001
-	ErrDeductStockRepeat = 10002
+	ErrStockIsNotEnough            = 10001
+	ErrDeductStockRepeat           = 10002
+	ErrRestoreStockRepeat          = 10003
+	ErrReservationNotFound         = 10004
+	ErrReservationExpired          = 10005
+	ErrReserveStockRepeat          = 10006
+	ErrOrderStatusIllegal          = 10007
+	ErrCartIsFull                  = 10008
+	ErrCartItemNotFound            = 10009
+	ErrCouponNotFound              = 10010
+	ErrCouponUnavailable           = 10011
+	ErrCouponThreshold             = 10012
+	ErrCouponSoldOut               = 10013
+	ErrCouponClaimLimit            = 10014
+	ErrCouponNotRedeemed           = 10015
+	ErrCategoryNotFound            = 10016
+	ErrCategoryNotEmpty            = 10017
+	ErrCategoryNameRepeat          = 10018
+	ErrCategoryMoveIllegal         = 10019
+	ErrCategoryTooDeep             = 10020
+	ErrSkuNotFound                 = 10021
+	ErrSkuRequired                 = 10022
+	ErrSkuAttrIllegal              = 10023
+	ErrSkuRepeat                   = 10024
+	ErrGoodsNotOwned               = 10025
+	ErrOrderNotOwned               = 10026
+	ErrPriceScheduleNotFound       = 10027
+	ErrPriceScheduleStatus         = 10028
+	ErrPriceScheduleTime           = 10029
+	ErrPriceNotFound               = 10030
+	ErrGoodsInStock                = 10031
+	ErrRestockSubscriptionNotFound = 10032
 )
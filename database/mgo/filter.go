@@ -0,0 +1,185 @@
+package mgo
+
+import (
+	"regexp"
+
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// 查询条件,多个条件之间是AND,按添加顺序生成bson.D
+type Filter struct {
+	d bson.D
+}
+
+func NewFilter() *Filter {
+	return &Filter{
+		d: bson.D{},
+	}
+}
+
+func (f *Filter) add(key string, value interface{}) *Filter {
+	f.d = append(f.d, bson.E{Key: key, Value: value})
+	return f
+}
+
+func (f *Filter) Eq(key string, value interface{}) *Filter {
+	return f.add(key, value)
+}
+
+func (f *Filter) Ne(key string, value interface{}) *Filter {
+	return f.add(key, bson.M{"$ne": value})
+}
+
+func (f *Filter) Gt(key string, value interface{}) *Filter {
+	return f.add(key, bson.M{"$gt": value})
+}
+
+func (f *Filter) Gte(key string, value interface{}) *Filter {
+	return f.add(key, bson.M{"$gte": value})
+}
+
+func (f *Filter) Lt(key string, value interface{}) *Filter {
+	return f.add(key, bson.M{"$lt": value})
+}
+
+func (f *Filter) Lte(key string, value interface{}) *Filter {
+	return f.add(key, bson.M{"$lte": value})
+}
+
+// min或max为nil时不限制
+func (f *Filter) Between(key string, min, max interface{}) *Filter {
+	cond := bson.M{}
+	if min != nil {
+		cond["$gte"] = min
+	}
+	if max != nil {
+		cond["$lte"] = max
+	}
+	if len(cond) == 0 {
+		return f
+	}
+	return f.add(key, cond)
+}
+
+// values是切片
+func (f *Filter) In(key string, values interface{}) *Filter {
+	return f.add(key, bson.M{"$in": values})
+}
+
+func (f *Filter) Nin(key string, values interface{}) *Filter {
+	return f.add(key, bson.M{"$nin": values})
+}
+
+func (f *Filter) Exists(key string, exists bool) *Filter {
+	return f.add(key, bson.M{"$exists": exists})
+}
+
+// 包含关键字,不区分大小写,关键字中的正则字符会转义
+func (f *Filter) Contains(key, keyword string) *Filter {
+	return f.add(key, bson.M{"$regex": regexp.QuoteMeta(keyword), "$options": "i"})
+}
+
+// 满足任意一个子条件
+func (f *Filter) Or(filters ...*Filter) *Filter {
+	return f.add("$or", toArray(filters))
+}
+
+// 同一个字段需要多个条件时用And
+func (f *Filter) And(filters ...*Filter) *Filter {
+	return f.add("$and", toArray(filters))
+}
+
+func (f *Filter) Empty() bool {
+	return f == nil || len(f.d) == 0
+}
+
+func (f *Filter) Bson() bson.D {
+	if f == nil {
+		return bson.D{}
+	}
+	return f.d
+}
+
+func toArray(filters []*Filter) bson.A {
+	a := bson.A{}
+	for _, filter := range filters {
+		a = append(a, filter.Bson())
+	}
+	return a
+}
+
+// 更新操作
+type Update struct {
+	set   bson.D
+	inc   bson.D
+	unset bson.D
+}
+
+func NewUpdate() *Update {
+	return &Update{}
+}
+
+func (u *Update) Set(key string, value interface{}) *Update {
+	u.set = append(u.set, bson.E{Key: key, Value: value})
+	return u
+}
+
+func (u *Update) Inc(key string, value interface{}) *Update {
+	u.inc = append(u.inc, bson.E{Key: key, Value: value})
+	return u
+}
+
+func (u *Update) Unset(key string) *Update {
+	u.unset = append(u.unset, bson.E{Key: key, Value: ""})
+	return u
+}
+
+func (u *Update) Bson() bson.D {
+	d := bson.D{}
+	if len(u.set) != 0 {
+		d = append(d, bson.E{Key: "$set", Value: u.set})
+	}
+	if len(u.inc) != 0 {
+		d = append(d, bson.E{Key: "$inc", Value: u.inc})
+	}
+	if len(u.unset) != 0 {
+		d = append(d, bson.E{Key: "$unset", Value: u.unset})
+	}
+	return d
+}
+
+// 排序,按添加顺序
+type Sort struct {
+	d bson.D
+}
+
+func NewSort() *Sort {
+	return &Sort{
+		d: bson.D{},
+	}
+}
+
+func (s *Sort) Asc(key string) *Sort {
+	s.d = append(s.d, bson.E{Key: key, Value: 1})
+	return s
+}
+
+func (s *Sort) Desc(key string) *Sort {
+	s.d = append(s.d, bson.E{Key: key, Value: -1})
+	return s
+}
+
+// asc为false时倒序
+func (s *Sort) By(key string, asc bool) *Sort {
+	if asc {
+		return s.Asc(key)
+	}
+	return s.Desc(key)
+}
+
+func (s *Sort) Bson() bson.D {
+	if s == nil {
+		return nil
+	}
+	return s.d
+}
@@ -0,0 +1,288 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/harveywangdao/ants/logger"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+/*
+集合的通用读写
+1.一个Repository对应一个集合,文档用带bson tag的结构体,查询结果传指针,和gorm的用法一样
+2.条件、更新、排序用Filter、Update、Sort构造,不用到处写bson.M
+3.每次操作都加MongoTimeout超时,ctx是事务的sessCtx时操作在事务中执行
+4.查不到返回ErrNotFound,用IsNotFound判断
+*/
+
+var ErrNotFound = mongo.ErrNoDocuments
+
+func IsNotFound(err error) bool {
+	return err == mongo.ErrNoDocuments
+}
+
+// _id用ObjectID时,十六进制字符串转ObjectID
+func ObjectID(hex string) (primitive.ObjectID, error) {
+	return primitive.ObjectIDFromHex(hex)
+}
+
+type Index struct {
+	Name   string
+	Keys   *Sort
+	Unique bool
+	// 大于0时是TTL索引,单位秒
+	ExpireAfterSeconds int32
+}
+
+type Repository struct {
+	collection *mongo.Collection
+}
+
+func NewRepository(client *mongo.Client, dbName, collection string) *Repository {
+	return &Repository{
+		collection: client.Database(dbName).Collection(collection),
+	}
+}
+
+// 没有封装的操作直接用集合
+func (r *Repository) Collection() *mongo.Collection {
+	return r.collection
+}
+
+func withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	return context.WithTimeout(ctx, MongoTimeout)
+}
+
+// 返回_id,文档没有_id时自动生成ObjectID
+func (r *Repository) Insert(ctx context.Context, doc interface{}) (interface{}, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.collection.InsertOne(ctx, doc)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	return result.InsertedID, nil
+}
+
+func (r *Repository) InsertMany(ctx context.Context, docs []interface{}) ([]interface{}, error) {
+	if len(docs) == 0 {
+		return nil, nil
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.collection.InsertMany(ctx, docs)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	return result.InsertedIDs, nil
+}
+
+func (r *Repository) FindByID(ctx context.Context, id interface{}, result interface{}) error {
+	return r.FindOne(ctx, NewFilter().Eq("_id", id), result)
+}
+
+func (r *Repository) FindOne(ctx context.Context, filter *Filter, result interface{}) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if err := r.collection.FindOne(ctx, filter.Bson()).Decode(result); err != nil {
+		if !IsNotFound(err) {
+			logger.Error(err)
+		}
+		return err
+	}
+	return nil
+}
+
+// results是切片指针,limit为0时不限制
+func (r *Repository) Find(ctx context.Context, filter *Filter, sort *Sort, limit int64, results interface{}) error {
+	opts := options.Find()
+	if sort != nil {
+		opts.SetSort(sort.Bson())
+	}
+	if limit > 0 {
+		opts.SetLimit(limit)
+	}
+	return r.find(ctx, filter, opts, results)
+}
+
+// 按页码分页,pageNum从1开始,同时返回总数
+func (r *Repository) FindPage(ctx context.Context, filter *Filter, sort *Sort, pageNum, numPerPage int64, results interface{}) (int64, error) {
+	if pageNum < 1 {
+		pageNum = 1
+	}
+
+	total, err := r.Count(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	opts := options.Find().SetSkip((pageNum - 1) * numPerPage).SetLimit(numPerPage)
+	if sort != nil {
+		opts.SetSort(sort.Bson())
+	}
+	if err := r.find(ctx, filter, opts, results); err != nil {
+		return 0, err
+	}
+	return total, nil
+}
+
+func (r *Repository) find(ctx context.Context, filter *Filter, opts *options.FindOptions, results interface{}) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cur, err := r.collection.Find(ctx, filter.Bson(), opts)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := cur.All(ctx, results); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) Count(ctx context.Context, filter *Filter) (int64, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	count, err := r.collection.CountDocuments(ctx, filter.Bson())
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+	return count, nil
+}
+
+// pipeline是[]bson.D或bson.A,results是切片指针
+func (r *Repository) Aggregate(ctx context.Context, pipeline interface{}, results interface{}) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	cur, err := r.collection.Aggregate(ctx, pipeline)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if err := cur.All(ctx, results); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 文档不存在返回ErrNotFound
+func (r *Repository) UpdateByID(ctx context.Context, id interface{}, update *Update) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateOne(ctx, NewFilter().Eq("_id", id).Bson(), update.Bson())
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// 返回匹配的数量
+func (r *Repository) UpdateMany(ctx context.Context, filter *Filter, update *Update) (int64, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.collection.UpdateMany(ctx, filter.Bson(), update.Bson())
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+	return result.MatchedCount, nil
+}
+
+// 整个文档替换,不存在时插入
+func (r *Repository) Upsert(ctx context.Context, filter *Filter, doc interface{}) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.collection.ReplaceOne(ctx, filter.Bson(), doc, options.Replace().SetUpsert(true)); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+// 文档不存在不算失败
+func (r *Repository) DeleteByID(ctx context.Context, id interface{}) error {
+	_, err := r.DeleteMany(ctx, NewFilter().Eq("_id", id))
+	return err
+}
+
+// 返回删除的数量,filter为空时删除全部文档
+func (r *Repository) DeleteMany(ctx context.Context, filter *Filter) (int64, error) {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	result, err := r.collection.DeleteMany(ctx, filter.Bson())
+	if err != nil {
+		logger.Error(err)
+		return 0, err
+	}
+	return result.DeletedCount, nil
+}
+
+// 索引已存在时不报错,同名但定义不同时报错
+func (r *Repository) EnsureIndexes(ctx context.Context, indexes ...Index) error {
+	if len(indexes) == 0 {
+		return nil
+	}
+
+	var models []mongo.IndexModel
+	for _, index := range indexes {
+		opts := options.Index()
+		if index.Name != "" {
+			opts.SetName(index.Name)
+		}
+		if index.Unique {
+			opts.SetUnique(true)
+		}
+		if index.ExpireAfterSeconds > 0 {
+			opts.SetExpireAfterSeconds(index.ExpireAfterSeconds)
+		}
+
+		models = append(models, mongo.IndexModel{
+			Keys:    index.Keys.Bson(),
+			Options: opts,
+		})
+	}
+
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.collection.Indexes().CreateMany(ctx, models); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
+
+func (r *Repository) DropIndex(ctx context.Context, name string) error {
+	ctx, cancel := withTimeout(ctx)
+	defer cancel()
+
+	if _, err := r.collection.Indexes().DropOne(ctx, name); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
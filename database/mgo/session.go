@@ -0,0 +1,32 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/harveywangdao/ants/logger"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+/*
+事务
+1.MongoDB的事务需要副本集或分片集群,单机部署会报错
+2.fn里的操作都要传sessCtx才在事务中,fn返回错误时回滚
+3.遇到临时错误驱动会重试整个fn,fn里不要有事务外的副作用
+*/
+func WithTransaction(ctx context.Context, client *mongo.Client, fn func(sessCtx mongo.SessionContext) error) error {
+	session, err := client.StartSession()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer session.EndSession(ctx)
+
+	_, err = session.WithTransaction(ctx, func(sessCtx mongo.SessionContext) (interface{}, error) {
+		return nil, fn(sessCtx)
+	})
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,83 @@
+package mgo
+
+import (
+	"context"
+
+	"github.com/harveywangdao/ants/logger"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	OperationInsert  = "insert"
+	OperationUpdate  = "update"
+	OperationReplace = "replace"
+	OperationDelete  = "delete"
+)
+
+// 删除事件没有FullDocument
+type ChangeEvent struct {
+	ResumeToken   bson.Raw `bson:"_id"`
+	OperationType string   `bson:"operationType"`
+	DocumentKey   bson.Raw `bson:"documentKey"`
+	FullDocument  bson.Raw `bson:"fullDocument"`
+}
+
+// 解析修改后的整个文档
+func (e *ChangeEvent) Decode(v interface{}) error {
+	return bson.Unmarshal(e.FullDocument, v)
+}
+
+// 文档的_id,删除事件也有
+func (e *ChangeEvent) ID() bson.RawValue {
+	return e.DocumentKey.Lookup("_id")
+}
+
+type WatchHandler func(event *ChangeEvent) error
+
+/*
+监听集合的变化
+1.需要副本集,阻塞直到ctx取消或handler返回错误
+2.filter匹配的是变化事件,比如NewFilter().In("operationType", ...)
+3.handler处理完保存event.ResumeToken,重启后传入resumeToken从上次的位置继续
+*/
+func (r *Repository) Watch(ctx context.Context, filter *Filter, resumeToken bson.Raw, handler WatchHandler) error {
+	pipeline := mongo.Pipeline{}
+	if !filter.Empty() {
+		pipeline = append(pipeline, bson.D{{Key: "$match", Value: filter.Bson()}})
+	}
+
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != nil {
+		opts.SetResumeAfter(resumeToken)
+	}
+
+	stream, err := r.collection.Watch(ctx, pipeline, opts)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer stream.Close(context.Background())
+
+	for stream.Next(ctx) {
+		var event ChangeEvent
+		if err := stream.Decode(&event); err != nil {
+			logger.Error(err)
+			return err
+		}
+
+		if err := handler(&event); err != nil {
+			return err
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := stream.Err(); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
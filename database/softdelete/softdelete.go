@@ -0,0 +1,148 @@
+package softdelete
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+/*
+软删除
+1.有IsDelete字段的模型,查询和更新自动加上is_delete = 0,Delete改为把is_delete设为1
+2.Unscoped()时不过滤,Delete是真删除
+3.db.Raw的sql不做修改,需要自己带上is_delete条件
+4.软删除时update_time会自动更新,清理任务按update_time判断删除了多久
+*/
+
+const (
+	FieldName = "IsDelete"
+
+	DefaultRetentionDays  = 30
+	DefaultPurgeBatchSize = 500
+	PurgeTimerDuration    = time.Hour
+)
+
+func Register(db *gorm.DB) {
+	db.Callback().Query().Before("gorm:query").Register("softdelete:query", filterCallback)
+	db.Callback().RowQuery().Before("gorm:row_query").Register("softdelete:row_query", filterCallback)
+	db.Callback().Update().Before("gorm:update").Register("softdelete:update", filterCallback)
+	db.Callback().Delete().Replace("gorm:delete", deleteCallback)
+}
+
+// gorm没有导出raw标记,raw查询的条件就是整条sql,不能再加条件
+func isRaw(scope *gorm.Scope) bool {
+	return reflect.ValueOf(scope.Search).Elem().FieldByName("raw").Bool()
+}
+
+func notDeletedCondition(scope *gorm.Scope) (string, bool) {
+	field, ok := scope.FieldByName(FieldName)
+	if !ok {
+		return "", false
+	}
+
+	return fmt.Sprintf("%v.%v = 0", scope.QuotedTableName(), scope.Quote(field.DBName)), true
+}
+
+func filterCallback(scope *gorm.Scope) {
+	if scope.HasError() || scope.Search.Unscoped || isRaw(scope) {
+		return
+	}
+
+	if condition, ok := notDeletedCondition(scope); ok {
+		scope.Search.Where(condition)
+	}
+}
+
+func deleteCallback(scope *gorm.Scope) {
+	if scope.HasError() {
+		return
+	}
+
+	var extraOption string
+	if str, ok := scope.Get("gorm:delete_option"); ok {
+		extraOption = " " + fmt.Sprint(str)
+	}
+
+	condition, ok := notDeletedCondition(scope)
+	if scope.Search.Unscoped || !ok {
+		scope.Raw(fmt.Sprintf("DELETE FROM %v %v%v", scope.QuotedTableName(), scope.CombinedConditionSql(), extraOption)).Exec()
+		return
+	}
+
+	// 已经删除的不再修改,保留第一次删除的时间
+	scope.Search.Where(condition)
+	field, _ := scope.FieldByName(FieldName)
+	scope.Raw(fmt.Sprintf("UPDATE %v SET %v = 1 %v%v", scope.QuotedTableName(), scope.Quote(field.DBName), scope.CombinedConditionSql(), extraOption)).Exec()
+}
+
+// 恢复软删除的记录,返回是否有记录被恢复
+func Restore(db *gorm.DB, value interface{}, query string, args ...interface{}) (bool, error) {
+	result := db.Unscoped().Model(value).Where(query, args...).Where("is_delete = ?", 1).Update("is_delete", 0)
+	if err := result.Error; err != nil {
+		logger.Error(err)
+		return false, err
+	}
+
+	return result.RowsAffected != 0, nil
+}
+
+// 在这个时间之前软删除的记录需要清理
+func Deadline(retentionDays int64) time.Time {
+	if retentionDays <= 0 {
+		retentionDays = DefaultRetentionDays
+	}
+	return time.Now().Add(-time.Duration(retentionDays) * 24 * time.Hour)
+}
+
+// 真删除软删除超过retentionDays天的记录,分批删除避免长时间锁表
+func Purge(db *gorm.DB, table string, retentionDays int64) (int64, error) {
+	deadline := Deadline(retentionDays)
+
+	var total int64
+	for {
+		result := db.Exec(fmt.Sprintf("DELETE FROM %s WHERE is_delete = 1 AND update_time < ? LIMIT %d", table, DefaultPurgeBatchSize), deadline)
+		if err := result.Error; err != nil {
+			logger.Error(err)
+			return total, err
+		}
+
+		total += result.RowsAffected
+		if result.RowsAffected < DefaultPurgeBatchSize {
+			return total, nil
+		}
+	}
+}
+
+// 定时清理,多个实例同时执行也没有问题
+func StartPurge(retentionDays int64, purge func(retentionDays int64)) {
+	go func() {
+		ticker := time.NewTicker(PurgeTimerDuration)
+
+		for {
+			select {
+			case <-ticker.C:
+				purge(retentionDays)
+			}
+		}
+	}()
+}
+
+// 清理多个没有关联数据的表
+func PurgeTables(db *gorm.DB, tables ...string) func(retentionDays int64) {
+	return func(retentionDays int64) {
+		for _, table := range tables {
+			n, err := Purge(db, table, retentionDays)
+			if err != nil {
+				logger.Error(err)
+				continue
+			}
+
+			if n != 0 {
+				logger.Info("purge", table, n, "rows")
+			}
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package idempotency
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/harveywangdao/ants/logger"
+)
+
+type responseWriter struct {
+	gin.ResponseWriter
+	body *bytes.Buffer
+}
+
+func (w *responseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// 响应的error为空才算执行成功
+func isSucceeded(status int, body []byte) bool {
+	if status != http.StatusOK {
+		return false
+	}
+
+	var resp struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return false
+	}
+
+	return resp.Error == ""
+}
+
+// 只处理带了幂等键的请求,key按请求路径区分
+func GinMiddleware(store Store) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		key := c.GetHeader(HeaderKey)
+		if key == "" {
+			c.Next()
+			return
+		}
+
+		body, err := ioutil.ReadAll(c.Request.Body)
+		if err != nil {
+			logger.Error(err)
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{"error": err.Error()})
+			return
+		}
+		c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+		hash := hashRequest(body)
+		storeKey := c.Request.URL.Path + ":" + key
+
+		record, err := store.Begin(storeKey, hash)
+		if err != nil {
+			c.AbortWithStatusJSON(http.StatusOK, gin.H{"error": err.Error()})
+			return
+		}
+
+		if record != nil {
+			if err := checkRecord(record, hash); err != nil {
+				c.AbortWithStatusJSON(http.StatusOK, gin.H{"error": err.Error()})
+				return
+			}
+
+			logger.Info("replay", c.Request.URL.Path, "idempotency key", key)
+			c.Data(http.StatusOK, "application/json; charset=utf-8", record.Response)
+			c.Abort()
+			return
+		}
+
+		w := &responseWriter{
+			ResponseWriter: c.Writer,
+			body:           &bytes.Buffer{},
+		}
+		c.Writer = w
+
+		c.Next()
+
+		if !isSucceeded(w.Status(), w.body.Bytes()) {
+			store.Abort(storeKey)
+			return
+		}
+
+		if err := store.Finish(storeKey, &Record{Hash: hash, Done: true, Response: w.body.Bytes()}); err != nil {
+			logger.Error(err)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+package idempotency
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/harveywangdao/ants/logger"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func keyFromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// 按服务方法的返回类型新建响应,用来反序列化保存的响应
+func newResponse(info *grpc.UnaryServerInfo) (proto.Message, error) {
+	name := info.FullMethod[strings.LastIndex(info.FullMethod, "/")+1:]
+	method := reflect.ValueOf(info.Server).MethodByName(name)
+	if !method.IsValid() || method.Type().NumOut() != 2 {
+		return nil, fmt.Errorf("method %s not found", info.FullMethod)
+	}
+
+	resp, ok := reflect.New(method.Type().Out(0).Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, fmt.Errorf("method %s response is not proto message", info.FullMethod)
+	}
+
+	return resp, nil
+}
+
+// 只处理带了幂等键的请求,key按方法区分
+func UnaryServerInterceptor(store Store) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		key := keyFromContext(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		reqMsg, ok := req.(proto.Message)
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		reqData, err := proto.Marshal(reqMsg)
+		if err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		hash := hashRequest(reqData)
+		storeKey := info.FullMethod + ":" + key
+
+		record, err := store.Begin(storeKey, hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if record != nil {
+			if err := checkRecord(record, hash); err != nil {
+				return nil, err
+			}
+
+			resp, err := newResponse(info)
+			if err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+
+			if err := proto.Unmarshal(record.Response, resp); err != nil {
+				logger.Error(err)
+				return nil, err
+			}
+
+			logger.Info("replay", info.FullMethod, "idempotency key", key)
+			return resp, nil
+		}
+
+		resp, err := handler(ctx, req)
+		if err != nil {
+			store.Abort(storeKey)
+			return resp, err
+		}
+
+		respMsg, ok := resp.(proto.Message)
+		if !ok {
+			store.Abort(storeKey)
+			return resp, nil
+		}
+
+		respData, err := proto.Marshal(respMsg)
+		if err != nil {
+			logger.Error(err)
+			store.Abort(storeKey)
+			return resp, nil
+		}
+
+		if err := store.Finish(storeKey, &Record{Hash: hash, Done: true, Response: respData}); err != nil {
+			logger.Error(err)
+		}
+
+		return resp, nil
+	}
+}
@@ -0,0 +1,61 @@
+package idempotency
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+)
+
+/*
+幂等键
+1.客户端在HTTP头或gRPC metadata里带上Idempotency-Key,重试时使用同一个key
+2.第一次请求执行成功后保存响应,有效期内相同key的请求直接返回保存的响应
+3.请求执行失败时删除记录,允许客户端重试
+4.相同key但请求内容不同时报错,同一个key的请求还在执行时也报错
+*/
+
+const (
+	HeaderKey   = "Idempotency-Key"
+	MetadataKey = "idempotency-key" // gRPC metadata的key都是小写
+
+	DefaultTTLSeconds        = 24 * 3600
+	DefaultProcessingSeconds = 60 // 执行中的记录超时时间,防止宕机后key一直不能用
+)
+
+var (
+	ErrProcessing = errors.New("request with the same idempotency key is processing")
+	ErrKeyReused  = errors.New("idempotency key is reused with a different request")
+)
+
+type Record struct {
+	Hash     string `json:"hash"`
+	Done     bool   `json:"done"`
+	Response []byte `json:"response"`
+}
+
+type Store interface {
+	// key不存在时占住key并返回nil,存在时返回已有的记录
+	Begin(key, hash string) (*Record, error)
+	// 保存执行成功的响应
+	Finish(key string, record *Record) error
+	// 执行失败时删除记录
+	Abort(key string) error
+}
+
+func hashRequest(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// 检查已有的记录是否可以直接返回
+func checkRecord(record *Record, hash string) error {
+	if record.Hash != hash {
+		return ErrKeyReused
+	}
+
+	if !record.Done {
+		return ErrProcessing
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package idempotency
+
+import (
+	"strings"
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/jinzhu/gorm"
+)
+
+type IdempotencyModel struct {
+	ID          int64     `gorm:"column:id"`
+	IdemKey     string    `gorm:"column:idem_key"`
+	RequestHash string    `gorm:"column:request_hash"`
+	Done        uint8     `gorm:"column:done"`
+	Response    []byte    `gorm:"column:response"`
+	ExpireTime  int64     `gorm:"column:expire_time"`
+	CreateTime  time.Time `gorm:"column:create_time;-"`
+	UpdateTime  time.Time `gorm:"column:update_time;-"`
+	IsDelete    uint8     `gorm:"column:is_delete"`
+}
+
+func (m IdempotencyModel) TableName() string {
+	return "idempotency_tb"
+}
+
+// 没有redis的服务使用,表结构见各服务sql目录的idempotency_tb
+type MysqlStore struct {
+	db         *gorm.DB
+	ttlSeconds int64
+}
+
+func NewMysqlStore(db *gorm.DB, ttlSeconds int64) *MysqlStore {
+	if ttlSeconds <= 0 {
+		ttlSeconds = DefaultTTLSeconds
+	}
+
+	return &MysqlStore{
+		db:         db,
+		ttlSeconds: ttlSeconds,
+	}
+}
+
+func (s *MysqlStore) Begin(key, hash string) (*Record, error) {
+	now := time.Now().Unix()
+	m := &IdempotencyModel{
+		IdemKey:     key,
+		RequestHash: hash,
+		ExpireTime:  now + DefaultProcessingSeconds,
+	}
+
+	err := s.db.Create(m).Error
+	if err == nil {
+		return nil, nil
+	}
+
+	if !strings.Contains(err.Error(), "Duplicate entry") {
+		logger.Error(err)
+		return nil, err
+	}
+
+	var old IdempotencyModel
+	if err := s.db.Where("idem_key = ?", key).First(&old).Error; err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 过期的记录以过期时间为条件重新占用,同时只有一个请求能成功
+	if old.ExpireTime < now {
+		param := map[string]interface{}{
+			"request_hash": hash,
+			"done":         0,
+			"response":     []byte{},
+			"expire_time":  m.ExpireTime,
+		}
+		result := s.db.Model(IdempotencyModel{}).Where("id = ? AND expire_time = ?", old.ID, old.ExpireTime).Updates(param)
+		if err := result.Error; err != nil {
+			logger.Error(err)
+			return nil, err
+		}
+
+		if result.RowsAffected == 1 {
+			return nil, nil
+		}
+		return nil, ErrProcessing
+	}
+
+	return &Record{
+		Hash:     old.RequestHash,
+		Done:     old.Done == 1,
+		Response: old.Response,
+	}, nil
+}
+
+func (s *MysqlStore) Finish(key string, record *Record) error {
+	param := map[string]interface{}{
+		"done":        1,
+		"response":    record.Response,
+		"expire_time": time.Now().Unix() + s.ttlSeconds,
+	}
+	if err := s.db.Model(IdempotencyModel{}).Where("idem_key = ?", key).Updates(param).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+func (s *MysqlStore) Abort(key string) error {
+	if err := s.db.Unscoped().Where("idem_key = ?", key).Delete(IdempotencyModel{}).Error; err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
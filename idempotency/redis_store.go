@@ -0,0 +1,100 @@
+package idempotency
+
+import (
+	"encoding/json"
+
+	"github.com/harveywangdao/ants/cache/redis"
+	"github.com/harveywangdao/ants/logger"
+)
+
+const (
+	RedisKeyPrefix = "Idempotency"
+)
+
+type RedisStore struct {
+	pool       *redis.RedisPool
+	ttlSeconds int64
+}
+
+func NewRedisStore(pool *redis.RedisPool, ttlSeconds int64) *RedisStore {
+	if ttlSeconds <= 0 {
+		ttlSeconds = DefaultTTLSeconds
+	}
+
+	return &RedisStore{
+		pool:       pool,
+		ttlSeconds: ttlSeconds,
+	}
+}
+
+func (s *RedisStore) Begin(key, hash string) (*Record, error) {
+	conn, err := s.pool.Get()
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+	defer conn.Close()
+
+	data, err := json.Marshal(&Record{Hash: hash})
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	ok, err := conn.SetNxEx(RedisKeyPrefix+key, string(data), DefaultProcessingSeconds)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	if ok {
+		return nil, nil
+	}
+
+	value, err := conn.GetString(RedisKeyPrefix + key)
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	// 刚好过期了,让客户端重试
+	if value == "" {
+		return nil, ErrProcessing
+	}
+
+	record := &Record{}
+	if err := json.Unmarshal([]byte(value), record); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return record, nil
+}
+
+func (s *RedisStore) Finish(key string, record *Record) error {
+	conn, err := s.pool.Get()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer conn.Close()
+
+	data, err := json.Marshal(record)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return conn.SetEx(RedisKeyPrefix+key, string(data), s.ttlSeconds)
+}
+
+func (s *RedisStore) Abort(key string) error {
+	conn, err := s.pool.Get()
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer conn.Close()
+
+	return conn.DeleteKey(RedisKeyPrefix + key)
+}
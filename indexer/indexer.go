@@ -0,0 +1,227 @@
+package indexer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/olivere/elastic"
+)
+
+/*
+elasticsearch索引维护
+1.读写都通过别名,真正的索引名是别名加创建时间
+2.全量重建时先写新索引,完成后原子地把别名切到新索引,再删除旧索引,切换过程中查询不受影响
+3.关闭了sniff和healthcheck,可以直接连本地的HTTP桩
+*/
+
+const (
+	DocType = "_doc"
+
+	BulkSize = 500
+)
+
+// doc为nil表示删除
+type PutFunc func(id string, doc interface{}) error
+
+// since为零值时扫描全部数据,否则扫描since之后修改过的数据,包括已删除的
+type ScanFunc func(ctx context.Context, since time.Time, put PutFunc) error
+
+type Indexer struct {
+	client *elastic.Client
+}
+
+func NewIndexer(url string) (*Indexer, error) {
+	if url == "" {
+		return nil, errors.New("elasticsearch url is null")
+	}
+
+	client, err := elastic.NewClient(elastic.SetURL(url), elastic.SetSniff(false), elastic.SetHealthcheck(false))
+	if err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &Indexer{
+		client: client,
+	}, nil
+}
+
+func newIndexName(alias string) string {
+	return alias + "_" + time.Now().Format("20060102150405")
+}
+
+func (i *Indexer) createIndex(ctx context.Context, name, mapping string) error {
+	if _, err := i.client.CreateIndex(name).BodyString(mapping).Do(ctx); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// 别名不存在时按mapping创建索引
+func (i *Indexer) EnsureIndex(ctx context.Context, alias, mapping string) error {
+	exists, err := i.client.IndexExists(alias).Do(ctx)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	if exists {
+		return nil
+	}
+
+	name := newIndexName(alias)
+	if err := i.createIndex(ctx, name, mapping); err != nil {
+		return err
+	}
+
+	if _, err := i.client.Alias().Add(name, alias).Do(ctx); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	logger.Info("create index", name, "alias", alias)
+	return nil
+}
+
+func (i *Indexer) Put(ctx context.Context, alias, id string, doc interface{}) error {
+	if doc == nil {
+		return i.Delete(ctx, alias, id)
+	}
+
+	if _, err := i.client.Index().Index(alias).Type(DocType).Id(id).BodyJson(doc).Do(ctx); err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// 文档不存在不算失败
+func (i *Indexer) Delete(ctx context.Context, alias, id string) error {
+	if _, err := i.client.Delete().Index(alias).Type(DocType).Id(id).Do(ctx); err != nil && !elastic.IsNotFound(err) {
+		logger.Error(err)
+		return err
+	}
+
+	return nil
+}
+
+// 批量写入,攒够BulkSize条提交一次
+type bulkWriter struct {
+	ctx  context.Context
+	bulk *elastic.BulkService
+}
+
+func (w *bulkWriter) put(id string, doc interface{}) error {
+	if doc == nil {
+		w.bulk.Add(elastic.NewBulkDeleteRequest().Id(id))
+	} else {
+		w.bulk.Add(elastic.NewBulkIndexRequest().Id(id).Doc(doc))
+	}
+
+	if w.bulk.NumberOfActions() >= BulkSize {
+		return w.flush()
+	}
+	return nil
+}
+
+func (w *bulkWriter) flush() error {
+	if w.bulk.NumberOfActions() == 0 {
+		return nil
+	}
+
+	resp, err := w.bulk.Do(w.ctx)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	// 删除不存在的文档不算失败
+	for _, item := range resp.Failed() {
+		if item.Status == 404 {
+			continue
+		}
+		return fmt.Errorf("bulk %s %s failed: %v", item.Index, item.Id, item.Error)
+	}
+
+	return nil
+}
+
+func (i *Indexer) newBulkWriter(ctx context.Context, index string) *bulkWriter {
+	return &bulkWriter{
+		ctx:  ctx,
+		bulk: i.client.Bulk().Index(index).Type(DocType),
+	}
+}
+
+/*
+全量重建,不停服务
+1.新建索引,把scan扫出的全部数据写进去
+2.别名原子地从旧索引切到新索引
+3.重建期间的修改写在旧索引上,切换后把重建开始后修改过的数据再同步一遍
+4.删除旧索引
+*/
+func (i *Indexer) Reindex(ctx context.Context, alias, mapping string, scan ScanFunc) error {
+	start := time.Now()
+	name := newIndexName(alias)
+
+	if err := i.createIndex(ctx, name, mapping); err != nil {
+		return err
+	}
+
+	w := i.newBulkWriter(ctx, name)
+	err := scan(ctx, time.Time{}, w.put)
+	if err == nil {
+		err = w.flush()
+	}
+	if err == nil {
+		_, err = i.client.Refresh(name).Do(ctx)
+	}
+	if err != nil {
+		logger.Error(err)
+		if _, err := i.client.DeleteIndex(name).Do(ctx); err != nil {
+			logger.Error(err)
+		}
+		return err
+	}
+
+	aliases, err := i.client.Aliases().Do(ctx)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	oldIndices := aliases.IndicesByAlias(alias)
+
+	action := i.client.Alias().Add(name, alias)
+	for _, old := range oldIndices {
+		action = action.Remove(old, alias)
+	}
+	if _, err := action.Do(ctx); err != nil {
+		logger.Error(err)
+		return err
+	}
+	logger.Info("alias", alias, "switch to", name, "from", oldIndices)
+
+	w = i.newBulkWriter(ctx, name)
+	if err := scan(ctx, start, w.put); err != nil {
+		logger.Error(err)
+		return err
+	}
+	if err := w.flush(); err != nil {
+		return err
+	}
+
+	if len(oldIndices) != 0 {
+		if _, err := i.client.DeleteIndex(oldIndices...).Do(ctx); err != nil {
+			logger.Error(err)
+			return err
+		}
+	}
+
+	return nil
+}
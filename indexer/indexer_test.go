@@ -0,0 +1,375 @@
+package indexer
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sort"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// 内存里的elasticsearch桩,只实现Indexer用到的接口
+type fakeES struct {
+	mu      sync.Mutex
+	indices map[string]map[string]json.RawMessage
+	aliases map[string]string
+}
+
+func newFakeES() *fakeES {
+	return &fakeES{
+		indices: make(map[string]map[string]json.RawMessage),
+		aliases: make(map[string]string),
+	}
+}
+
+func (es *fakeES) resolve(name string) string {
+	if index, ok := es.aliases[name]; ok {
+		return index
+	}
+	return name
+}
+
+func writeJSON(w http.ResponseWriter, status int, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(v)
+}
+
+func writeError(w http.ResponseWriter, status int, reason string) {
+	writeJSON(w, status, map[string]interface{}{
+		"error":  map[string]interface{}{"type": reason, "reason": reason},
+		"status": status,
+	})
+}
+
+func (es *fakeES) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	switch {
+	case r.Method == "HEAD" && len(parts) == 1:
+		if _, ok := es.indices[es.resolve(parts[0])]; ok {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusNotFound)
+		}
+
+	case r.Method == "PUT" && len(parts) == 1:
+		if _, ok := es.indices[parts[0]]; ok {
+			writeError(w, http.StatusBadRequest, "resource_already_exists_exception")
+			return
+		}
+		es.indices[parts[0]] = make(map[string]json.RawMessage)
+		writeJSON(w, http.StatusOK, map[string]interface{}{"acknowledged": true, "index": parts[0]})
+
+	case r.Method == "DELETE" && len(parts) == 1:
+		for _, name := range strings.Split(parts[0], ",") {
+			delete(es.indices, name)
+			for alias, index := range es.aliases {
+				if index == name {
+					delete(es.aliases, alias)
+				}
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"acknowledged": true})
+
+	case r.Method == "POST" && parts[0] == "_aliases":
+		var body struct {
+			Actions []map[string]struct {
+				Index string `json:"index"`
+				Alias string `json:"alias"`
+			} `json:"actions"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		// 同一个请求里的操作一起生效,先删除再添加
+		for _, action := range body.Actions {
+			if remove, ok := action["remove"]; ok && es.aliases[remove.Alias] == remove.Index {
+				delete(es.aliases, remove.Alias)
+			}
+		}
+		for _, action := range body.Actions {
+			if add, ok := action["add"]; ok {
+				es.aliases[add.Alias] = add.Index
+			}
+		}
+		writeJSON(w, http.StatusOK, map[string]interface{}{"acknowledged": true})
+
+	case r.Method == "GET" && parts[0] == "_alias":
+		result := make(map[string]interface{})
+		for name := range es.indices {
+			aliases := make(map[string]interface{})
+			for alias, index := range es.aliases {
+				if index == name {
+					aliases[alias] = map[string]interface{}{}
+				}
+			}
+			result[name] = map[string]interface{}{"aliases": aliases}
+		}
+		writeJSON(w, http.StatusOK, result)
+
+	case len(parts) == 2 && parts[1] == "_refresh":
+		writeJSON(w, http.StatusOK, map[string]interface{}{"_shards": map[string]int{"total": 1, "successful": 1}})
+
+	case len(parts) == 3 && parts[2] == "_bulk":
+		es.bulk(w, r, es.resolve(parts[0]))
+
+	case len(parts) == 3 && parts[1] == DocType:
+		index := es.resolve(parts[0])
+		docs, ok := es.indices[index]
+		if !ok {
+			writeError(w, http.StatusNotFound, "index_not_found_exception")
+			return
+		}
+
+		resp := map[string]interface{}{"_index": index, "_type": DocType, "_id": parts[2], "_version": 1}
+		if r.Method == "DELETE" {
+			if _, ok := docs[parts[2]]; !ok {
+				resp["result"] = "not_found"
+				writeJSON(w, http.StatusNotFound, resp)
+				return
+			}
+			delete(docs, parts[2])
+			resp["result"] = "deleted"
+			writeJSON(w, http.StatusOK, resp)
+			return
+		}
+
+		var doc json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&doc); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+		docs[parts[2]] = doc
+		resp["result"] = "created"
+		writeJSON(w, http.StatusCreated, resp)
+
+	default:
+		writeError(w, http.StatusBadRequest, "unsupported "+r.Method+" "+r.URL.Path)
+	}
+}
+
+// 每个操作一行元数据,index操作后面再跟一行文档
+func (es *fakeES) bulk(w http.ResponseWriter, r *http.Request, index string) {
+	docs, ok := es.indices[index]
+	if !ok {
+		writeError(w, http.StatusNotFound, "index_not_found_exception")
+		return
+	}
+
+	var items []map[string]interface{}
+	scanner := bufio.NewScanner(r.Body)
+	for scanner.Scan() {
+		var meta map[string]struct {
+			ID string `json:"_id"`
+		}
+		if err := json.Unmarshal(scanner.Bytes(), &meta); err != nil {
+			writeError(w, http.StatusBadRequest, err.Error())
+			return
+		}
+
+		for op, m := range meta {
+			status := http.StatusOK
+			switch op {
+			case "index":
+				if !scanner.Scan() {
+					writeError(w, http.StatusBadRequest, "missing document")
+					return
+				}
+				docs[m.ID] = json.RawMessage(append([]byte(nil), scanner.Bytes()...))
+				status = http.StatusCreated
+			case "delete":
+				if _, ok := docs[m.ID]; !ok {
+					status = http.StatusNotFound
+				}
+				delete(docs, m.ID)
+			}
+			items = append(items, map[string]interface{}{
+				op: map[string]interface{}{"_index": index, "_type": DocType, "_id": m.ID, "status": status},
+			})
+		}
+	}
+
+	writeJSON(w, http.StatusOK, map[string]interface{}{"took": 1, "errors": false, "items": items})
+}
+
+func (es *fakeES) docIDs(index string) []string {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	var ids []string
+	for id := range es.indices[index] {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (es *fakeES) aliasIndex(alias string) string {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	return es.aliases[alias]
+}
+
+func (es *fakeES) indexExists(index string) bool {
+	es.mu.Lock()
+	defer es.mu.Unlock()
+
+	_, ok := es.indices[index]
+	return ok
+}
+
+func newTestIndexer(t *testing.T) (*Indexer, *fakeES, *httptest.Server) {
+	es := newFakeES()
+	server := httptest.NewServer(es)
+
+	i, err := NewIndexer(server.URL)
+	if err != nil {
+		server.Close()
+		t.Fatal(err)
+	}
+	return i, es, server
+}
+
+type testDoc struct {
+	Name string `json:"name"`
+}
+
+func TestPutAndDelete(t *testing.T) {
+	i, es, server := newTestIndexer(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	if err := i.EnsureIndex(ctx, "goods", "{}"); err != nil {
+		t.Fatal(err)
+	}
+	index := es.aliasIndex("goods")
+	if !strings.HasPrefix(index, "goods_") {
+		t.Fatalf("alias goods points to %q", index)
+	}
+
+	// 别名已存在时不再创建
+	if err := i.EnsureIndex(ctx, "goods", "{}"); err != nil {
+		t.Fatal(err)
+	}
+	if got := es.aliasIndex("goods"); got != index {
+		t.Fatalf("alias goods moved from %s to %s", index, got)
+	}
+
+	if err := i.Put(ctx, "goods", "1", &testDoc{Name: "apple"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.Put(ctx, "goods", "2", &testDoc{Name: "banana"}); err != nil {
+		t.Fatal(err)
+	}
+	if ids := es.docIDs(index); strings.Join(ids, ",") != "1,2" {
+		t.Fatalf("docs after put: %v", ids)
+	}
+
+	// doc为nil表示删除
+	if err := i.Put(ctx, "goods", "1", nil); err != nil {
+		t.Fatal(err)
+	}
+	if err := i.Delete(ctx, "goods", "2"); err != nil {
+		t.Fatal(err)
+	}
+	if ids := es.docIDs(index); len(ids) != 0 {
+		t.Fatalf("docs after delete: %v", ids)
+	}
+
+	// 文档不存在不算失败
+	if err := i.Delete(ctx, "goods", "3"); err != nil {
+		t.Fatalf("delete missing doc: %v", err)
+	}
+}
+
+func TestReindexSwitchesAlias(t *testing.T) {
+	i, es, server := newTestIndexer(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	es.indices["goods_old"] = map[string]json.RawMessage{
+		"1": json.RawMessage(`{"name":"old"}`),
+		"9": json.RawMessage(`{"name":"removed"}`),
+	}
+	es.aliases["goods"] = "goods_old"
+
+	var passes []time.Time
+	scan := func(ctx context.Context, since time.Time, put PutFunc) error {
+		passes = append(passes, since)
+		if since.IsZero() {
+			// 全量扫描时旧索引还在别名上
+			if got := es.aliasIndex("goods"); got != "goods_old" {
+				t.Errorf("alias switched before full scan finished: %s", got)
+			}
+			for _, id := range []string{"1", "2", "3"} {
+				if err := put(id, &testDoc{Name: "new" + id}); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+
+		// 重建期间删除的数据在第二遍同步
+		return put("3", nil)
+	}
+
+	if err := i.Reindex(ctx, "goods", "{}", scan); err != nil {
+		t.Fatal(err)
+	}
+
+	if len(passes) != 2 || !passes[0].IsZero() || passes[1].IsZero() {
+		t.Fatalf("scan passes: %v", passes)
+	}
+
+	index := es.aliasIndex("goods")
+	if index == "goods_old" || !strings.HasPrefix(index, "goods_") {
+		t.Fatalf("alias goods points to %q", index)
+	}
+	if es.indexExists("goods_old") {
+		t.Fatal("old index is not deleted")
+	}
+	if ids := es.docIDs(index); strings.Join(ids, ",") != "1,2" {
+		t.Fatalf("docs after reindex: %v", ids)
+	}
+}
+
+func TestReindexFailureKeepsAlias(t *testing.T) {
+	i, es, server := newTestIndexer(t)
+	defer server.Close()
+	ctx := context.Background()
+
+	es.indices["goods_old"] = map[string]json.RawMessage{"1": json.RawMessage(`{"name":"old"}`)}
+	es.aliases["goods"] = "goods_old"
+
+	scanErr := errors.New("scan failed")
+	scan := func(ctx context.Context, since time.Time, put PutFunc) error {
+		if err := put("1", &testDoc{Name: "new"}); err != nil {
+			return err
+		}
+		return scanErr
+	}
+
+	if err := i.Reindex(ctx, "goods", "{}", scan); err != scanErr {
+		t.Fatalf("reindex error: %v", err)
+	}
+
+	if got := es.aliasIndex("goods"); got != "goods_old" {
+		t.Fatalf("alias goods moved to %s", got)
+	}
+	es.mu.Lock()
+	defer es.mu.Unlock()
+	if len(es.indices) != 1 {
+		t.Fatalf("new index is not cleaned up: %d indices", len(es.indices))
+	}
+}
@@ -0,0 +1,88 @@
+package indexer
+
+import (
+	"context"
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+)
+
+const (
+	SyncQueueSize     = 10000
+	SyncRetryTimes    = 3
+	SyncRetryInterval = time.Second
+)
+
+// 按id读取最新数据,不存在或已删除时返回nil
+type LoadFunc func(id string) (interface{}, error)
+
+// 同步的目标,Indexer写elasticsearch,也可以是其他存储,doc为nil表示删除
+type Writer interface {
+	Put(ctx context.Context, alias, id string, doc interface{}) error
+}
+
+/*
+增量同步
+1.数据修改后只通知id,后台按id重新读取最新数据写入索引,不用关心通知的先后顺序
+2.队列满了或重试后仍然失败的只记录日志,由全量重建修复
+*/
+type Syncer struct {
+	writer Writer
+	alias  string
+	load   LoadFunc
+	queue  chan string
+}
+
+func NewSyncer(writer Writer, alias string, load LoadFunc) *Syncer {
+	return &Syncer{
+		writer: writer,
+		alias:  alias,
+		load:   load,
+		queue:  make(chan string, SyncQueueSize),
+	}
+}
+
+func (s *Syncer) Start() {
+	go func() {
+		for id := range s.queue {
+			s.sync(id)
+		}
+	}()
+}
+
+// 没有开启同步时syncer为nil,可以直接调用
+func (s *Syncer) Notify(ids ...string) {
+	if s == nil {
+		return
+	}
+
+	for _, id := range ids {
+		select {
+		case s.queue <- id:
+		default:
+			logger.Error("sync queue is full, drop", s.alias, id)
+		}
+	}
+}
+
+func (s *Syncer) sync(id string) {
+	var err error
+	for i := 0; i < SyncRetryTimes; i++ {
+		if i != 0 {
+			time.Sleep(SyncRetryInterval)
+		}
+
+		var doc interface{}
+		doc, err = s.load(id)
+		if err != nil {
+			logger.Error(err)
+			continue
+		}
+
+		if err = s.writer.Put(context.Background(), s.alias, id, doc); err == nil {
+			return
+		}
+	}
+
+	logger.Error("sync", s.alias, id, "failed:", err)
+}
@@ -0,0 +1,99 @@
+package notify
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+)
+
+/*
+用户通知发送渠道抽象
+1.业务方只负责生成消息,具体怎么送达由Sender决定,短信、邮件、推送等按需实现
+2.本地开发用LogSender打日志,或者用FileSender按行写json文件
+*/
+
+const (
+	SenderLog  = "log"
+	SenderFile = "file"
+)
+
+type Message struct {
+	UserID  string `json:"userID"`
+	Subject string `json:"subject"`
+	Content string `json:"content"`
+	Time    int64  `json:"time"`
+}
+
+type Sender interface {
+	Name() string
+	Send(ctx context.Context, msg *Message) error
+}
+
+type LogSender struct{}
+
+func NewLogSender() *LogSender {
+	return &LogSender{}
+}
+
+func (l *LogSender) Name() string {
+	return SenderLog
+}
+
+func (l *LogSender) Send(ctx context.Context, msg *Message) error {
+	logger.Info("notify", msg.UserID, msg.Subject, msg.Content)
+	return nil
+}
+
+// 每条消息一行json,追加写入
+type FileSender struct {
+	path string
+	lock sync.Mutex
+}
+
+func NewFileSender(path string) (*FileSender, error) {
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		logger.Error(err)
+		return nil, err
+	}
+
+	return &FileSender{
+		path: path,
+	}, nil
+}
+
+func (f *FileSender) Name() string {
+	return SenderFile
+}
+
+func (f *FileSender) Send(ctx context.Context, msg *Message) error {
+	if msg.Time == 0 {
+		msg.Time = time.Now().Unix()
+	}
+
+	data, err := json.Marshal(msg)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	file, err := os.OpenFile(f.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		logger.Error(err)
+		return err
+	}
+	defer file.Close()
+
+	if _, err := file.Write(append(data, '\n')); err != nil {
+		logger.Error(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package payment
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/harveywangdao/ants/logger"
+	"github.com/harveywangdao/ants/util"
+)
+
+const (
+	ProviderMock = "mock"
+
+	MockCallbackRetryTimes    = 5
+	MockCallbackRetryInterval = 2 * time.Second
+)
+
+/*
+本地模拟支付渠道,支付单保存在内存里
+1.创建支付单后延迟callbackDelay,按failRate随机决定支付结果
+2.结果用HMAC-SHA256签名后POST到notifyURL,失败会重试几次
+*/
+type MockProvider struct {
+	secret        []byte
+	callbackDelay time.Duration
+	failRate      float64
+	client        *http.Client
+
+	lock    sync.Mutex
+	charges map[string]*Charge
+	refunds map[string]*Refund
+	rand    *rand.Rand
+}
+
+func NewMockProvider(secret string, callbackDelay time.Duration, failRate float64) *MockProvider {
+	return &MockProvider{
+		secret:        []byte(secret),
+		callbackDelay: callbackDelay,
+		failRate:      failRate,
+		client:        &http.Client{Timeout: 5 * time.Second},
+		charges:       make(map[string]*Charge),
+		refunds:       make(map[string]*Refund),
+		rand:          rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+}
+
+func (m *MockProvider) Name() string {
+	return ProviderMock
+}
+
+func (m *MockProvider) CreateCharge(ctx context.Context, req *ChargeRequest) (*Charge, error) {
+	if req.PayID == "" || req.Amount < 0 {
+		return nil, fmt.Errorf("invalid charge request %+v", req)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if charge, ok := m.charges[req.PayID]; ok {
+		c := *charge
+		return &c, nil
+	}
+
+	charge := &Charge{
+		PayID:   req.PayID,
+		TradeNo: util.GetUUID(),
+		Amount:  req.Amount,
+		Status:  ChargeStatusPending,
+	}
+	m.charges[req.PayID] = charge
+
+	status := ChargeStatusSucceeded
+	if m.rand.Float64() < m.failRate {
+		status = ChargeStatusFailed
+	}
+
+	time.AfterFunc(m.callbackDelay, func() {
+		m.finishCharge(req.PayID, status, req.NotifyURL)
+	})
+
+	c := *charge
+	return &c, nil
+}
+
+func (m *MockProvider) finishCharge(payID string, status int, notifyURL string) {
+	m.lock.Lock()
+	charge := m.charges[payID]
+	charge.Status = status
+	n := &Notification{
+		PayID:   charge.PayID,
+		TradeNo: charge.TradeNo,
+		Amount:  charge.Amount,
+		Status:  charge.Status,
+	}
+	m.lock.Unlock()
+
+	if notifyURL == "" {
+		return
+	}
+
+	body, err := json.Marshal(n)
+	if err != nil {
+		logger.Error(err)
+		return
+	}
+
+	for i := 0; i < MockCallbackRetryTimes; i++ {
+		if err := m.notify(notifyURL, body); err != nil {
+			logger.Error("mock payment notify", payID, "fail:", err)
+			time.Sleep(MockCallbackRetryInterval)
+			continue
+		}
+		return
+	}
+}
+
+func (m *MockProvider) notify(notifyURL string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, notifyURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(SignatureHeader, m.sign(body))
+
+	resp, err := m.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("notify status code %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+func (m *MockProvider) sign(body []byte) string {
+	mac := hmac.New(sha256.New, m.secret)
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func (m *MockProvider) QueryCharge(ctx context.Context, payID string) (*Charge, error) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	charge, ok := m.charges[payID]
+	if !ok {
+		return nil, ErrChargeNotFound
+	}
+
+	c := *charge
+	return &c, nil
+}
+
+func (m *MockProvider) Refund(ctx context.Context, req *RefundRequest) (*Refund, error) {
+	if req.RefundID == "" || req.PayID == "" || req.Amount <= 0 {
+		return nil, fmt.Errorf("invalid refund request %+v", req)
+	}
+
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	if refund, ok := m.refunds[req.RefundID]; ok {
+		r := *refund
+		return &r, nil
+	}
+
+	charge, ok := m.charges[req.PayID]
+	if !ok {
+		return nil, ErrChargeNotFound
+	}
+
+	if charge.Status != ChargeStatusSucceeded {
+		return nil, ErrChargeNotPaid
+	}
+
+	if charge.RefundedAmount+req.Amount > charge.Amount+0.000001 {
+		return nil, ErrRefundExceeded
+	}
+
+	charge.RefundedAmount += req.Amount
+	refund := &Refund{
+		RefundID: req.RefundID,
+		PayID:    req.PayID,
+		Amount:   req.Amount,
+		Status:   RefundStatusSucceeded,
+	}
+	m.refunds[req.RefundID] = refund
+
+	r := *refund
+	return &r, nil
+}
+
+func (m *MockProvider) VerifyCallback(header http.Header, body []byte) (*Notification, error) {
+	signature, err := hex.DecodeString(header.Get(SignatureHeader))
+	if err != nil {
+		return nil, ErrSignatureMismatch
+	}
+
+	expected, _ := hex.DecodeString(m.sign(body))
+	if !hmac.Equal(signature, expected) {
+		return nil, ErrSignatureMismatch
+	}
+
+	n := &Notification{}
+	if err := json.Unmarshal(body, n); err != nil {
+		return nil, err
+	}
+
+	return n, nil
+}
@@ -0,0 +1,77 @@
+package payment
+
+import (
+	"context"
+	"errors"
+	"net/http"
+)
+
+/*
+支付渠道抽象
+1.以业务方的支付号payID作为渠道的商户订单号,创建支付单重复调用是幂等的
+2.支付结果以渠道的异步回调为准,回调丢失时业务方主动查询
+3.退款以refundID幂等,同一个支付单可以多次部分退款
+*/
+
+const (
+	ChargeStatusPending   = 0
+	ChargeStatusSucceeded = 1
+	ChargeStatusFailed    = 2
+
+	RefundStatusSucceeded = 1
+
+	SignatureHeader = "X-Payment-Signature"
+)
+
+var (
+	ErrChargeNotFound    = errors.New("charge not found")
+	ErrChargeNotPaid     = errors.New("charge not paid")
+	ErrRefundExceeded    = errors.New("refund amount exceeded")
+	ErrSignatureMismatch = errors.New("signature mismatch")
+)
+
+type ChargeRequest struct {
+	PayID     string
+	OrderID   string
+	Amount    float64
+	Subject   string
+	NotifyURL string
+}
+
+type Charge struct {
+	PayID          string  `json:"payID"`
+	TradeNo        string  `json:"tradeNo"`
+	Amount         float64 `json:"amount"`
+	Status         int     `json:"status"`
+	RefundedAmount float64 `json:"refundedAmount"`
+}
+
+type RefundRequest struct {
+	RefundID string
+	PayID    string
+	Amount   float64
+	Reason   string
+}
+
+type Refund struct {
+	RefundID string  `json:"refundID"`
+	PayID    string  `json:"payID"`
+	Amount   float64 `json:"amount"`
+	Status   int     `json:"status"`
+}
+
+// 渠道回调的内容
+type Notification struct {
+	PayID   string  `json:"payID"`
+	TradeNo string  `json:"tradeNo"`
+	Amount  float64 `json:"amount"`
+	Status  int     `json:"status"`
+}
+
+type Provider interface {
+	Name() string
+	CreateCharge(ctx context.Context, req *ChargeRequest) (*Charge, error)
+	QueryCharge(ctx context.Context, payID string) (*Charge, error)
+	Refund(ctx context.Context, req *RefundRequest) (*Refund, error)
+	VerifyCallback(header http.Header, body []byte) (*Notification, error)
+}
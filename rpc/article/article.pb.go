@@ -477,6 +477,92 @@ func (m *DelArticleResponse) GetCodeMsg() string {
 	return ""
 }
 
+type RestoreArticleRequest struct {
+	ArticleID            string   `protobuf:"bytes,1,opt,name=articleID,proto3" json:"articleID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreArticleRequest) Reset()         { *m = RestoreArticleRequest{} }
+func (m *RestoreArticleRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreArticleRequest) ProtoMessage()    {}
+func (*RestoreArticleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c593d380f9840a2, []int{9}
+}
+
+func (m *RestoreArticleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreArticleRequest.Unmarshal(m, b)
+}
+func (m *RestoreArticleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreArticleRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreArticleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreArticleRequest.Merge(m, src)
+}
+func (m *RestoreArticleRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreArticleRequest.Size(m)
+}
+func (m *RestoreArticleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreArticleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreArticleRequest proto.InternalMessageInfo
+
+func (m *RestoreArticleRequest) GetArticleID() string {
+	if m != nil {
+		return m.ArticleID
+	}
+	return ""
+}
+
+type RestoreArticleResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreArticleResponse) Reset()         { *m = RestoreArticleResponse{} }
+func (m *RestoreArticleResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreArticleResponse) ProtoMessage()    {}
+func (*RestoreArticleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5c593d380f9840a2, []int{10}
+}
+
+func (m *RestoreArticleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreArticleResponse.Unmarshal(m, b)
+}
+func (m *RestoreArticleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreArticleResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreArticleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreArticleResponse.Merge(m, src)
+}
+func (m *RestoreArticleResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreArticleResponse.Size(m)
+}
+func (m *RestoreArticleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreArticleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreArticleResponse proto.InternalMessageInfo
+
+func (m *RestoreArticleResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreArticleResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
 type ModifyArticleInfoRequest struct {
 	ArticleInfo          *ArticleInfo `protobuf:"bytes,1,opt,name=articleInfo,proto3" json:"articleInfo,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
@@ -488,7 +574,7 @@ func (m *ModifyArticleInfoRequest) Reset()         { *m = ModifyArticleInfoReque
 func (m *ModifyArticleInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyArticleInfoRequest) ProtoMessage()    {}
 func (*ModifyArticleInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c593d380f9840a2, []int{9}
+	return fileDescriptor_5c593d380f9840a2, []int{11}
 }
 
 func (m *ModifyArticleInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -528,7 +614,7 @@ func (m *ModifyArticleInfoResponse) Reset()         { *m = ModifyArticleInfoResp
 func (m *ModifyArticleInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyArticleInfoResponse) ProtoMessage()    {}
 func (*ModifyArticleInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5c593d380f9840a2, []int{10}
+	return fileDescriptor_5c593d380f9840a2, []int{12}
 }
 
 func (m *ModifyArticleInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -573,6 +659,8 @@ func init() {
 	proto.RegisterType((*GetArticleListResponse)(nil), "article.GetArticleListResponse")
 	proto.RegisterType((*DelArticleRequest)(nil), "article.DelArticleRequest")
 	proto.RegisterType((*DelArticleResponse)(nil), "article.DelArticleResponse")
+	proto.RegisterType((*RestoreArticleRequest)(nil), "article.RestoreArticleRequest")
+	proto.RegisterType((*RestoreArticleResponse)(nil), "article.RestoreArticleResponse")
 	proto.RegisterType((*ModifyArticleInfoRequest)(nil), "article.ModifyArticleInfoRequest")
 	proto.RegisterType((*ModifyArticleInfoResponse)(nil), "article.ModifyArticleInfoResponse")
 }
@@ -580,35 +668,37 @@ func init() {
 func init() { proto.RegisterFile("article.proto", fileDescriptor_5c593d380f9840a2) }
 
 var fileDescriptor_5c593d380f9840a2 = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0xdb, 0x9d, 0xba, 0x4b, 0x5f, 0xed, 0x42, 0x1f, 0xeb, 0x12, 0xa3, 0xac, 0x75, 0xf0,
-	0xd0, 0x53, 0xc1, 0x0a, 0xe2, 0xb5, 0x52, 0x28, 0x01, 0x0b, 0x65, 0x7a, 0xf5, 0x60, 0x4c, 0xa6,
-	0x25, 0x10, 0x33, 0x6d, 0x66, 0x2a, 0xe8, 0xc9, 0xbf, 0xc4, 0xbf, 0x55, 0x32, 0x99, 0x98, 0x49,
-	0xd2, 0x16, 0xcd, 0xde, 0xe6, 0xcd, 0xcb, 0x7c, 0xdf, 0xe7, 0xfd, 0x0a, 0x0c, 0xfd, 0x54, 0x45,
-	0x41, 0xcc, 0xa7, 0xfb, 0x54, 0x28, 0x81, 0x37, 0xc6, 0xa4, 0x02, 0x06, 0xf3, 0xfc, 0xe8, 0x25,
-	0x5b, 0x81, 0x2f, 0xa1, 0x6f, 0x3c, 0xde, 0xc2, 0xe9, 0x8e, 0xbb, 0x93, 0x3e, 0x2b, 0x2f, 0xf0,
-	0x0e, 0x9e, 0xa8, 0x48, 0xc5, 0xdc, 0xb9, 0xd2, 0x9e, 0xdc, 0x40, 0x07, 0x6e, 0x02, 0x91, 0x28,
-	0x9e, 0x28, 0x87, 0xe8, 0xfb, 0xc2, 0x44, 0x84, 0x9e, 0xf2, 0x77, 0xd2, 0xe9, 0x8d, 0xc9, 0xa4,
-	0xcf, 0xf4, 0x99, 0x06, 0x30, 0x9a, 0x87, 0xa1, 0x89, 0xc9, 0xf8, 0xe1, 0xc8, 0xa5, 0xc2, 0xf7,
-	0x30, 0xf0, 0x4b, 0x0a, 0x1d, 0x78, 0x30, 0xbb, 0x9b, 0x16, 0xcc, 0x16, 0x21, 0xb3, 0x3f, 0xc4,
-	0x7b, 0xb8, 0x3e, 0x4a, 0x9e, 0x7a, 0x0b, 0x43, 0x64, 0x2c, 0xfa, 0x05, 0xd0, 0x0e, 0x22, 0xf7,
-	0x22, 0x91, 0x3c, 0xc3, 0x09, 0x44, 0xc8, 0xb5, 0xfc, 0x90, 0xe9, 0x73, 0x0e, 0x1f, 0xf2, 0x95,
-	0xdc, 0x19, 0x89, 0xc2, 0xac, 0x96, 0x82, 0xd4, 0x4a, 0x41, 0xdf, 0xc2, 0x68, 0xc9, 0x55, 0x2d,
-	0x8d, 0x8b, 0xd5, 0xa3, 0x3f, 0x01, 0xed, 0x27, 0xad, 0xa0, 0x6a, 0x85, 0x22, 0xff, 0x58, 0x28,
-	0x7a, 0x80, 0x67, 0x65, 0xec, 0x4f, 0x91, 0x54, 0x05, 0x32, 0x42, 0x6f, 0xef, 0xef, 0xf2, 0xf0,
-	0x84, 0xe9, 0x33, 0x3e, 0x00, 0x24, 0xc7, 0x6f, 0x6b, 0x9e, 0xae, 0x33, 0xcf, 0x95, 0xf6, 0x58,
-	0x37, 0xf8, 0x06, 0x86, 0xb1, 0x2f, 0x0b, 0x35, 0x53, 0x1d, 0xc2, 0xaa, 0x97, 0xf4, 0x57, 0x17,
-	0xee, 0xeb, 0x31, 0x5b, 0xe5, 0xfc, 0x01, 0x9e, 0x5a, 0xa9, 0x48, 0x87, 0x8c, 0xc9, 0xd9, 0xa4,
-	0x2b, 0x5f, 0x66, 0x4d, 0x5a, 0xf0, 0xf8, 0xbf, 0x9a, 0xf4, 0x11, 0xd0, 0x7e, 0xd2, 0x06, 0x98,
-	0x32, 0x70, 0x56, 0x22, 0x8c, 0xb6, 0x3f, 0x6c, 0xb2, 0xc7, 0x4d, 0x3a, 0xf5, 0xe0, 0xf9, 0x09,
-	0xcd, 0x36, 0x78, 0xb3, 0xdf, 0x04, 0x6e, 0x8d, 0xca, 0x86, 0xa7, 0xdf, 0xa3, 0x80, 0xe3, 0x12,
-	0xa0, 0xdc, 0x17, 0x74, 0x4b, 0x9c, 0xfa, 0xa6, 0xba, 0x2f, 0x4e, 0xfa, 0x72, 0x0e, 0xda, 0xc9,
-	0x84, 0xca, 0x9e, 0x5b, 0x42, 0x8d, 0x5d, 0xb1, 0x84, 0x9a, 0x4b, 0x41, 0x3b, 0xb8, 0x81, 0xdb,
-	0xea, 0xf0, 0xe0, 0xc3, 0x89, 0x07, 0xd6, 0x24, 0xbb, 0xaf, 0xce, 0xfa, 0x6d, 0xba, 0xb2, 0xb9,
-	0x16, 0x5d, 0x63, 0x48, 0x2c, 0xba, 0xe6, 0x34, 0xd0, 0x0e, 0x7e, 0x86, 0x51, 0xa3, 0x1b, 0xf8,
-	0xfa, 0xef, 0x9b, 0x73, 0xdd, 0x77, 0xe9, 0xa5, 0x4f, 0x0a, 0xf5, 0xaf, 0xd7, 0xfa, 0x1f, 0xfd,
-	0xee, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x6c, 0xd3, 0x36, 0x35, 0xb4, 0x05, 0x00, 0x00,
+	// 473 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x51, 0x6f, 0xd3, 0x30,
+	0x10, 0xa6, 0x73, 0xd9, 0xd4, 0x2b, 0x9d, 0xd4, 0xd3, 0x56, 0x85, 0x14, 0x6d, 0xc5, 0xe2, 0x61,
+	0x4f, 0x93, 0x18, 0x02, 0xf1, 0x5a, 0x54, 0x31, 0x55, 0x62, 0xd2, 0xe4, 0xbd, 0xf2, 0x40, 0x48,
+	0x6e, 0x55, 0xa4, 0x10, 0x77, 0xb1, 0x8b, 0x04, 0x4f, 0xfc, 0x67, 0xfe, 0x00, 0x8a, 0x93, 0x34,
+	0x4e, 0x9a, 0x4c, 0x34, 0x7b, 0xf3, 0xf9, 0xf3, 0x7d, 0xdf, 0xe7, 0xf3, 0x5d, 0x02, 0x23, 0x2f,
+	0xd1, 0xa1, 0x1f, 0xd1, 0xe5, 0x3a, 0x91, 0x5a, 0xe2, 0x51, 0x1e, 0x72, 0x09, 0xc3, 0x79, 0xb6,
+	0x5c, 0xc6, 0xf7, 0x12, 0x5f, 0xc1, 0x20, 0x47, 0x96, 0x0b, 0xa7, 0x37, 0xeb, 0x5d, 0x0c, 0x44,
+	0xb9, 0x81, 0x27, 0xf0, 0x5c, 0x87, 0x3a, 0x22, 0xe7, 0xc0, 0x20, 0x59, 0x80, 0x0e, 0x1c, 0xf9,
+	0x32, 0xd6, 0x14, 0x6b, 0x87, 0x99, 0xfd, 0x22, 0x44, 0x84, 0xbe, 0xf6, 0x56, 0xca, 0xe9, 0xcf,
+	0xd8, 0xc5, 0x40, 0x98, 0x35, 0xf7, 0x61, 0x3c, 0x0f, 0x82, 0x5c, 0x53, 0xd0, 0xc3, 0x86, 0x94,
+	0xc6, 0x0f, 0x30, 0xf4, 0x4a, 0x17, 0x46, 0x78, 0x78, 0x75, 0x72, 0x59, 0x78, 0xb6, 0x1c, 0x0a,
+	0xfb, 0x20, 0x4e, 0xe0, 0x70, 0xa3, 0x28, 0x59, 0x2e, 0x72, 0x47, 0x79, 0xc4, 0xbf, 0x01, 0xda,
+	0x22, 0x6a, 0x2d, 0x63, 0x45, 0xa9, 0x1d, 0x5f, 0x06, 0x64, 0xe8, 0x47, 0xc2, 0xac, 0x33, 0xf3,
+	0x01, 0xdd, 0xa8, 0x55, 0x4e, 0x51, 0x84, 0xd5, 0x52, 0xb0, 0x5a, 0x29, 0xf8, 0x5b, 0x18, 0x5f,
+	0x93, 0xae, 0x5d, 0xe3, 0xd1, 0xea, 0xf1, 0xdf, 0x80, 0x76, 0x4a, 0x27, 0x53, 0xb5, 0x42, 0xb1,
+	0xff, 0x2c, 0x14, 0x7f, 0x80, 0xd3, 0x52, 0xfb, 0x4b, 0xa8, 0x74, 0x61, 0x19, 0xa1, 0xbf, 0xf6,
+	0x56, 0x99, 0x3c, 0x13, 0x66, 0x8d, 0x67, 0x00, 0xf1, 0xe6, 0xc7, 0x2d, 0x25, 0xb7, 0x29, 0x72,
+	0x60, 0x10, 0x6b, 0x07, 0xdf, 0xc0, 0x28, 0xf2, 0x94, 0x9e, 0x57, 0xaa, 0xc3, 0x44, 0x75, 0x93,
+	0xff, 0xe9, 0xc1, 0xa4, 0xae, 0xd9, 0xe9, 0xce, 0x1f, 0xe1, 0x85, 0x75, 0x15, 0xe5, 0xb0, 0x19,
+	0x6b, 0xbd, 0x74, 0xe5, 0x64, 0xfa, 0x48, 0x0b, 0x8a, 0xf6, 0x7a, 0xa4, 0x4f, 0x80, 0x76, 0x4a,
+	0x17, 0xc3, 0xfc, 0x3d, 0x9c, 0x0a, 0x52, 0x5a, 0x26, 0xb4, 0x97, 0xf4, 0x67, 0x98, 0xd4, 0xd3,
+	0x3a, 0xc9, 0x0b, 0x70, 0x6e, 0x64, 0x10, 0xde, 0xff, 0xb2, 0x0b, 0xf3, 0xb4, 0x41, 0xe3, 0x4b,
+	0x78, 0xd9, 0xc0, 0xd9, 0xc5, 0xde, 0xd5, 0x5f, 0x06, 0xc7, 0x39, 0xcb, 0x1d, 0x25, 0x3f, 0x43,
+	0x9f, 0xf0, 0x1a, 0xa0, 0x1c, 0x57, 0x74, 0x4b, 0x3b, 0xf5, 0x0f, 0x85, 0x3b, 0x6d, 0xc4, 0x32,
+	0x1f, 0xfc, 0x59, 0x4a, 0x54, 0xb6, 0x9c, 0x45, 0xb4, 0x33, 0xaa, 0xee, 0xb4, 0x11, 0xdb, 0x12,
+	0xdd, 0xc1, 0x71, 0xb5, 0x77, 0xf1, 0xac, 0x21, 0xc1, 0x1a, 0x24, 0xf7, 0xbc, 0x15, 0xb7, 0xdd,
+	0x95, 0xbd, 0x65, 0xb9, 0xdb, 0xe9, 0x51, 0x77, 0xda, 0x88, 0xd9, 0xee, 0xaa, 0x9d, 0x62, 0xb9,
+	0x6b, 0xec, 0x3c, 0xf7, 0xbc, 0x15, 0xdf, 0x92, 0x7e, 0x85, 0xf1, 0xce, 0x13, 0xe3, 0xeb, 0x6d,
+	0x5e, 0x5b, 0x4b, 0xb9, 0xfc, 0xb1, 0x23, 0x05, 0xfb, 0xf7, 0x43, 0xf3, 0xdf, 0x79, 0xf7, 0x6f,
+	0x00, 0x7c, 0x1e, 0xf7, 0x8b, 0x88, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -627,6 +717,7 @@ type ArticleServiceClient interface {
 	GetArticle(ctx context.Context, in *GetArticleRequest, opts ...grpc.CallOption) (*GetArticleResponse, error)
 	GetArticleList(ctx context.Context, in *GetArticleListRequest, opts ...grpc.CallOption) (*GetArticleListResponse, error)
 	DelArticle(ctx context.Context, in *DelArticleRequest, opts ...grpc.CallOption) (*DelArticleResponse, error)
+	RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleResponse, error)
 	ModifyArticleInfo(ctx context.Context, in *ModifyArticleInfoRequest, opts ...grpc.CallOption) (*ModifyArticleInfoResponse, error)
 }
 
@@ -674,6 +765,15 @@ func (c *articleServiceClient) DelArticle(ctx context.Context, in *DelArticleReq
 	return out, nil
 }
 
+func (c *articleServiceClient) RestoreArticle(ctx context.Context, in *RestoreArticleRequest, opts ...grpc.CallOption) (*RestoreArticleResponse, error) {
+	out := new(RestoreArticleResponse)
+	err := c.cc.Invoke(ctx, "/article.ArticleService/RestoreArticle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *articleServiceClient) ModifyArticleInfo(ctx context.Context, in *ModifyArticleInfoRequest, opts ...grpc.CallOption) (*ModifyArticleInfoResponse, error) {
 	out := new(ModifyArticleInfoResponse)
 	err := c.cc.Invoke(ctx, "/article.ArticleService/ModifyArticleInfo", in, out, opts...)
@@ -689,6 +789,7 @@ type ArticleServiceServer interface {
 	GetArticle(context.Context, *GetArticleRequest) (*GetArticleResponse, error)
 	GetArticleList(context.Context, *GetArticleListRequest) (*GetArticleListResponse, error)
 	DelArticle(context.Context, *DelArticleRequest) (*DelArticleResponse, error)
+	RestoreArticle(context.Context, *RestoreArticleRequest) (*RestoreArticleResponse, error)
 	ModifyArticleInfo(context.Context, *ModifyArticleInfoRequest) (*ModifyArticleInfoResponse, error)
 }
 
@@ -768,6 +869,24 @@ func _ArticleService_DelArticle_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ArticleService_RestoreArticle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreArticleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/article.ArticleService/RestoreArticle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ArticleServiceServer).RestoreArticle(ctx, req.(*RestoreArticleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _ArticleService_ModifyArticleInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ModifyArticleInfoRequest)
 	if err := dec(in); err != nil {
@@ -806,6 +925,10 @@ var _ArticleService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelArticle",
 			Handler:    _ArticleService_DelArticle_Handler,
 		},
+		{
+			MethodName: "RestoreArticle",
+			Handler:    _ArticleService_RestoreArticle_Handler,
+		},
 		{
 			MethodName: "ModifyArticleInfo",
 			Handler:    _ArticleService_ModifyArticleInfo_Handler,
@@ -0,0 +1,2 @@
+#!/bin/bash
+protoc --go_out=plugins=grpc:. *.proto
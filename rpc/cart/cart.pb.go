@@ -0,0 +1,1045 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: cart.proto
+
+package cart
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CartItem struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	GoodsName            string   `protobuf:"bytes,3,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock                int32    `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	SkuID                string   `protobuf:"bytes,6,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CartItem) Reset()         { *m = CartItem{} }
+func (m *CartItem) String() string { return proto.CompactTextString(m) }
+func (*CartItem) ProtoMessage()    {}
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{0}
+}
+
+func (m *CartItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CartItem.Unmarshal(m, b)
+}
+func (m *CartItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CartItem.Marshal(b, m, deterministic)
+}
+func (m *CartItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CartItem.Merge(m, src)
+}
+func (m *CartItem) XXX_Size() int {
+	return xxx_messageInfo_CartItem.Size(m)
+}
+func (m *CartItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CartItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CartItem proto.InternalMessageInfo
+
+func (m *CartItem) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *CartItem) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CartItem) GetGoodsName() string {
+	if m != nil {
+		return m.GoodsName
+	}
+	return ""
+}
+
+func (m *CartItem) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *CartItem) GetStock() int32 {
+	if m != nil {
+		return m.Stock
+	}
+	return 0
+}
+
+func (m *CartItem) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type AddCartItemRequest struct {
+	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	AnonymousID          string   `protobuf:"bytes,2,opt,name=anonymousID,proto3" json:"anonymousID,omitempty"`
+	GoodsID              string   `protobuf:"bytes,3,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Count                uint32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	SkuID                string   `protobuf:"bytes,5,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCartItemRequest) Reset()         { *m = AddCartItemRequest{} }
+func (m *AddCartItemRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCartItemRequest) ProtoMessage()    {}
+func (*AddCartItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{1}
+}
+
+func (m *AddCartItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCartItemRequest.Unmarshal(m, b)
+}
+func (m *AddCartItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCartItemRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCartItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCartItemRequest.Merge(m, src)
+}
+func (m *AddCartItemRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCartItemRequest.Size(m)
+}
+func (m *AddCartItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCartItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCartItemRequest proto.InternalMessageInfo
+
+func (m *AddCartItemRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *AddCartItemRequest) GetAnonymousID() string {
+	if m != nil {
+		return m.AnonymousID
+	}
+	return ""
+}
+
+func (m *AddCartItemRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *AddCartItemRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *AddCartItemRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type AddCartItemResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCartItemResponse) Reset()         { *m = AddCartItemResponse{} }
+func (m *AddCartItemResponse) String() string { return proto.CompactTextString(m) }
+func (*AddCartItemResponse) ProtoMessage()    {}
+func (*AddCartItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{2}
+}
+
+func (m *AddCartItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCartItemResponse.Unmarshal(m, b)
+}
+func (m *AddCartItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCartItemResponse.Marshal(b, m, deterministic)
+}
+func (m *AddCartItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCartItemResponse.Merge(m, src)
+}
+func (m *AddCartItemResponse) XXX_Size() int {
+	return xxx_messageInfo_AddCartItemResponse.Size(m)
+}
+func (m *AddCartItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCartItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCartItemResponse proto.InternalMessageInfo
+
+func (m *AddCartItemResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *AddCartItemResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *AddCartItemResponse) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type UpdateCartItemRequest struct {
+	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	AnonymousID          string   `protobuf:"bytes,2,opt,name=anonymousID,proto3" json:"anonymousID,omitempty"`
+	GoodsID              string   `protobuf:"bytes,3,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Count                uint32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	SkuID                string   `protobuf:"bytes,5,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCartItemRequest) Reset()         { *m = UpdateCartItemRequest{} }
+func (m *UpdateCartItemRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateCartItemRequest) ProtoMessage()    {}
+func (*UpdateCartItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{3}
+}
+
+func (m *UpdateCartItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCartItemRequest.Unmarshal(m, b)
+}
+func (m *UpdateCartItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCartItemRequest.Marshal(b, m, deterministic)
+}
+func (m *UpdateCartItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCartItemRequest.Merge(m, src)
+}
+func (m *UpdateCartItemRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateCartItemRequest.Size(m)
+}
+func (m *UpdateCartItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCartItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCartItemRequest proto.InternalMessageInfo
+
+func (m *UpdateCartItemRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *UpdateCartItemRequest) GetAnonymousID() string {
+	if m != nil {
+		return m.AnonymousID
+	}
+	return ""
+}
+
+func (m *UpdateCartItemRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *UpdateCartItemRequest) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *UpdateCartItemRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type UpdateCartItemResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateCartItemResponse) Reset()         { *m = UpdateCartItemResponse{} }
+func (m *UpdateCartItemResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateCartItemResponse) ProtoMessage()    {}
+func (*UpdateCartItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{4}
+}
+
+func (m *UpdateCartItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateCartItemResponse.Unmarshal(m, b)
+}
+func (m *UpdateCartItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateCartItemResponse.Marshal(b, m, deterministic)
+}
+func (m *UpdateCartItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateCartItemResponse.Merge(m, src)
+}
+func (m *UpdateCartItemResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateCartItemResponse.Size(m)
+}
+func (m *UpdateCartItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateCartItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateCartItemResponse proto.InternalMessageInfo
+
+func (m *UpdateCartItemResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *UpdateCartItemResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type RemoveCartItemRequest struct {
+	BuyerID              string      `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	AnonymousID          string      `protobuf:"bytes,2,opt,name=anonymousID,proto3" json:"anonymousID,omitempty"`
+	GoodsIDs             []string    `protobuf:"bytes,3,rep,name=goodsIDs,proto3" json:"goodsIDs,omitempty"`
+	Items                []*CartItem `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RemoveCartItemRequest) Reset()         { *m = RemoveCartItemRequest{} }
+func (m *RemoveCartItemRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveCartItemRequest) ProtoMessage()    {}
+func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{5}
+}
+
+func (m *RemoveCartItemRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveCartItemRequest.Unmarshal(m, b)
+}
+func (m *RemoveCartItemRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveCartItemRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveCartItemRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCartItemRequest.Merge(m, src)
+}
+func (m *RemoveCartItemRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveCartItemRequest.Size(m)
+}
+func (m *RemoveCartItemRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCartItemRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCartItemRequest proto.InternalMessageInfo
+
+func (m *RemoveCartItemRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *RemoveCartItemRequest) GetAnonymousID() string {
+	if m != nil {
+		return m.AnonymousID
+	}
+	return ""
+}
+
+func (m *RemoveCartItemRequest) GetGoodsIDs() []string {
+	if m != nil {
+		return m.GoodsIDs
+	}
+	return nil
+}
+
+func (m *RemoveCartItemRequest) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type RemoveCartItemResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveCartItemResponse) Reset()         { *m = RemoveCartItemResponse{} }
+func (m *RemoveCartItemResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoveCartItemResponse) ProtoMessage()    {}
+func (*RemoveCartItemResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{6}
+}
+
+func (m *RemoveCartItemResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveCartItemResponse.Unmarshal(m, b)
+}
+func (m *RemoveCartItemResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveCartItemResponse.Marshal(b, m, deterministic)
+}
+func (m *RemoveCartItemResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveCartItemResponse.Merge(m, src)
+}
+func (m *RemoveCartItemResponse) XXX_Size() int {
+	return xxx_messageInfo_RemoveCartItemResponse.Size(m)
+}
+func (m *RemoveCartItemResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveCartItemResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveCartItemResponse proto.InternalMessageInfo
+
+func (m *RemoveCartItemResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RemoveCartItemResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ListCartRequest struct {
+	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	AnonymousID          string   `protobuf:"bytes,2,opt,name=anonymousID,proto3" json:"anonymousID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCartRequest) Reset()         { *m = ListCartRequest{} }
+func (m *ListCartRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCartRequest) ProtoMessage()    {}
+func (*ListCartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{7}
+}
+
+func (m *ListCartRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCartRequest.Unmarshal(m, b)
+}
+func (m *ListCartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCartRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCartRequest.Merge(m, src)
+}
+func (m *ListCartRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCartRequest.Size(m)
+}
+func (m *ListCartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCartRequest proto.InternalMessageInfo
+
+func (m *ListCartRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *ListCartRequest) GetAnonymousID() string {
+	if m != nil {
+		return m.AnonymousID
+	}
+	return ""
+}
+
+type ListCartResponse struct {
+	Code                 uint32      `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string      `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Items                []*CartItem `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *ListCartResponse) Reset()         { *m = ListCartResponse{} }
+func (m *ListCartResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCartResponse) ProtoMessage()    {}
+func (*ListCartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{8}
+}
+
+func (m *ListCartResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCartResponse.Unmarshal(m, b)
+}
+func (m *ListCartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCartResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCartResponse.Merge(m, src)
+}
+func (m *ListCartResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCartResponse.Size(m)
+}
+func (m *ListCartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCartResponse proto.InternalMessageInfo
+
+func (m *ListCartResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListCartResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListCartResponse) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type ClearCartRequest struct {
+	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	AnonymousID          string   `protobuf:"bytes,2,opt,name=anonymousID,proto3" json:"anonymousID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearCartRequest) Reset()         { *m = ClearCartRequest{} }
+func (m *ClearCartRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearCartRequest) ProtoMessage()    {}
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{9}
+}
+
+func (m *ClearCartRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearCartRequest.Unmarshal(m, b)
+}
+func (m *ClearCartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearCartRequest.Marshal(b, m, deterministic)
+}
+func (m *ClearCartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearCartRequest.Merge(m, src)
+}
+func (m *ClearCartRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearCartRequest.Size(m)
+}
+func (m *ClearCartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearCartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearCartRequest proto.InternalMessageInfo
+
+func (m *ClearCartRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *ClearCartRequest) GetAnonymousID() string {
+	if m != nil {
+		return m.AnonymousID
+	}
+	return ""
+}
+
+type ClearCartResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearCartResponse) Reset()         { *m = ClearCartResponse{} }
+func (m *ClearCartResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearCartResponse) ProtoMessage()    {}
+func (*ClearCartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{10}
+}
+
+func (m *ClearCartResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearCartResponse.Unmarshal(m, b)
+}
+func (m *ClearCartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearCartResponse.Marshal(b, m, deterministic)
+}
+func (m *ClearCartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearCartResponse.Merge(m, src)
+}
+func (m *ClearCartResponse) XXX_Size() int {
+	return xxx_messageInfo_ClearCartResponse.Size(m)
+}
+func (m *ClearCartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearCartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearCartResponse proto.InternalMessageInfo
+
+func (m *ClearCartResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ClearCartResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type MergeCartRequest struct {
+	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	AnonymousID          string   `protobuf:"bytes,2,opt,name=anonymousID,proto3" json:"anonymousID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeCartRequest) Reset()         { *m = MergeCartRequest{} }
+func (m *MergeCartRequest) String() string { return proto.CompactTextString(m) }
+func (*MergeCartRequest) ProtoMessage()    {}
+func (*MergeCartRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{11}
+}
+
+func (m *MergeCartRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeCartRequest.Unmarshal(m, b)
+}
+func (m *MergeCartRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeCartRequest.Marshal(b, m, deterministic)
+}
+func (m *MergeCartRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeCartRequest.Merge(m, src)
+}
+func (m *MergeCartRequest) XXX_Size() int {
+	return xxx_messageInfo_MergeCartRequest.Size(m)
+}
+func (m *MergeCartRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeCartRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeCartRequest proto.InternalMessageInfo
+
+func (m *MergeCartRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *MergeCartRequest) GetAnonymousID() string {
+	if m != nil {
+		return m.AnonymousID
+	}
+	return ""
+}
+
+type MergeCartResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	MergedCount          uint32   `protobuf:"varint,3,opt,name=mergedCount,proto3" json:"mergedCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MergeCartResponse) Reset()         { *m = MergeCartResponse{} }
+func (m *MergeCartResponse) String() string { return proto.CompactTextString(m) }
+func (*MergeCartResponse) ProtoMessage()    {}
+func (*MergeCartResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bf731a5c8f9a516f, []int{12}
+}
+
+func (m *MergeCartResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MergeCartResponse.Unmarshal(m, b)
+}
+func (m *MergeCartResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MergeCartResponse.Marshal(b, m, deterministic)
+}
+func (m *MergeCartResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MergeCartResponse.Merge(m, src)
+}
+func (m *MergeCartResponse) XXX_Size() int {
+	return xxx_messageInfo_MergeCartResponse.Size(m)
+}
+func (m *MergeCartResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MergeCartResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MergeCartResponse proto.InternalMessageInfo
+
+func (m *MergeCartResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *MergeCartResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *MergeCartResponse) GetMergedCount() uint32 {
+	if m != nil {
+		return m.MergedCount
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*CartItem)(nil), "cart.CartItem")
+	proto.RegisterType((*AddCartItemRequest)(nil), "cart.AddCartItemRequest")
+	proto.RegisterType((*AddCartItemResponse)(nil), "cart.AddCartItemResponse")
+	proto.RegisterType((*UpdateCartItemRequest)(nil), "cart.UpdateCartItemRequest")
+	proto.RegisterType((*UpdateCartItemResponse)(nil), "cart.UpdateCartItemResponse")
+	proto.RegisterType((*RemoveCartItemRequest)(nil), "cart.RemoveCartItemRequest")
+	proto.RegisterType((*RemoveCartItemResponse)(nil), "cart.RemoveCartItemResponse")
+	proto.RegisterType((*ListCartRequest)(nil), "cart.ListCartRequest")
+	proto.RegisterType((*ListCartResponse)(nil), "cart.ListCartResponse")
+	proto.RegisterType((*ClearCartRequest)(nil), "cart.ClearCartRequest")
+	proto.RegisterType((*ClearCartResponse)(nil), "cart.ClearCartResponse")
+	proto.RegisterType((*MergeCartRequest)(nil), "cart.MergeCartRequest")
+	proto.RegisterType((*MergeCartResponse)(nil), "cart.MergeCartResponse")
+}
+
+func init() { proto.RegisterFile("cart.proto", fileDescriptor_bf731a5c8f9a516f) }
+
+var fileDescriptor_bf731a5c8f9a516f = []byte{
+	// 503 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x55, 0xc1, 0x8e, 0xd3, 0x30,
+	0x10, 0xc5, 0x9b, 0x64, 0x69, 0x27, 0xda, 0xa5, 0x6b, 0xe8, 0xae, 0x09, 0x3d, 0x44, 0x11, 0x87,
+	0x9c, 0xf6, 0xb0, 0x1c, 0x91, 0x90, 0x56, 0xad, 0x90, 0x2a, 0xd1, 0x1e, 0x82, 0x38, 0x70, 0xcc,
+	0x26, 0xa6, 0xaa, 0x96, 0xd4, 0x25, 0x76, 0x56, 0xda, 0x0f, 0x01, 0x09, 0x3e, 0x82, 0x6f, 0x44,
+	0xb6, 0x1b, 0xd7, 0x4d, 0x03, 0x87, 0x28, 0x07, 0x4e, 0xf5, 0xcc, 0x78, 0xde, 0xbc, 0xe7, 0xce,
+	0x4c, 0x00, 0xb2, 0xb4, 0x14, 0xd7, 0xdb, 0x92, 0x09, 0x86, 0x5d, 0x79, 0x8e, 0x7e, 0x21, 0x18,
+	0x4c, 0xd3, 0x52, 0xcc, 0x05, 0x2d, 0x30, 0x81, 0xa7, 0x2b, 0xc6, 0x72, 0x3e, 0x9f, 0x11, 0x14,
+	0xa2, 0x78, 0x98, 0xd4, 0x26, 0x7e, 0x01, 0x5e, 0xc6, 0xaa, 0x8d, 0x20, 0x27, 0x21, 0x8a, 0xcf,
+	0x12, 0x6d, 0xe0, 0x09, 0x0c, 0xd5, 0x85, 0x65, 0x5a, 0x50, 0xe2, 0xa8, 0x8c, 0xbd, 0x43, 0xe6,
+	0x6c, 0xcb, 0x75, 0x46, 0x89, 0x1b, 0xa2, 0x18, 0x25, 0xda, 0x90, 0x5e, 0x2e, 0x58, 0x76, 0x4f,
+	0xbc, 0x10, 0xc5, 0x5e, 0xa2, 0x0d, 0xe5, 0xbd, 0xaf, 0xe6, 0x33, 0x72, 0xaa, 0x50, 0xb4, 0x11,
+	0xfd, 0x40, 0x80, 0x6f, 0xf3, 0xbc, 0xe6, 0x97, 0xd0, 0x6f, 0x15, 0xe5, 0x42, 0xd2, 0xbc, 0xab,
+	0x1e, 0x69, 0xb9, 0xa7, 0xb9, 0x33, 0x71, 0x08, 0x7e, 0xba, 0x61, 0x9b, 0xc7, 0x82, 0x55, 0x52,
+	0xc4, 0x89, 0x8a, 0xda, 0x2e, 0x5b, 0xa2, 0xf3, 0x17, 0x89, 0xae, 0x2d, 0xd1, 0x10, 0xf3, 0x6c,
+	0x62, 0x9f, 0xe1, 0xf9, 0x01, 0x2f, 0xbe, 0x65, 0x1b, 0x4e, 0x31, 0x06, 0x37, 0x63, 0x39, 0x55,
+	0xac, 0xce, 0x12, 0x75, 0x96, 0x05, 0xe5, 0xef, 0x82, 0xaf, 0x76, 0x74, 0x6a, 0x73, 0x5f, 0xd0,
+	0xb1, 0x0a, 0x46, 0x3f, 0x11, 0x8c, 0x3f, 0x6d, 0xf3, 0x54, 0xd0, 0xff, 0x4f, 0xf6, 0x7b, 0xb8,
+	0x6c, 0x52, 0xeb, 0xa2, 0x3c, 0xfa, 0x8e, 0x60, 0x9c, 0xd0, 0x82, 0x3d, 0xf4, 0xaa, 0x31, 0x80,
+	0xc1, 0x4e, 0x14, 0x27, 0x4e, 0xe8, 0xc4, 0xc3, 0xc4, 0xd8, 0xf8, 0x35, 0x78, 0x6b, 0x41, 0x0b,
+	0x4e, 0xdc, 0xd0, 0x89, 0xfd, 0x9b, 0xf3, 0x6b, 0x35, 0x08, 0xa6, 0xba, 0x0e, 0x4a, 0x7d, 0x4d,
+	0x5a, 0x9d, 0xf4, 0x2d, 0xe0, 0xd9, 0x87, 0x35, 0x17, 0x12, 0xa5, 0x07, 0x61, 0xd1, 0x17, 0x18,
+	0xed, 0xe1, 0x3a, 0xb5, 0x9a, 0x91, 0xef, 0xfc, 0x4b, 0xfe, 0x12, 0x46, 0xd3, 0xaf, 0x34, 0x2d,
+	0xfb, 0xe2, 0x7d, 0x0b, 0x17, 0x16, 0x5e, 0xa7, 0x97, 0x5c, 0xc2, 0x68, 0x41, 0xcb, 0x15, 0xed,
+	0x8b, 0x52, 0x06, 0x17, 0x16, 0x5e, 0xa7, 0xb7, 0x0c, 0xc1, 0x2f, 0x24, 0x44, 0x3e, 0xb5, 0x86,
+	0xd7, 0x76, 0xdd, 0xfc, 0x76, 0xc0, 0x97, 0x05, 0x3e, 0xd2, 0xf2, 0x41, 0xae, 0xbc, 0x19, 0xf8,
+	0xd6, 0xb6, 0xc0, 0x44, 0xbf, 0xfe, 0xf1, 0x62, 0x0b, 0x5e, 0xb6, 0x44, 0x34, 0xc7, 0xe8, 0x09,
+	0x5e, 0xc0, 0xf9, 0xe1, 0xf0, 0xe1, 0x57, 0xfa, 0x7a, 0xeb, 0xb6, 0x08, 0x26, 0xed, 0x41, 0x1b,
+	0xee, 0xb0, 0xd7, 0x6b, 0xb8, 0xd6, 0xc1, 0x0c, 0x26, 0xed, 0x41, 0x03, 0xf7, 0x16, 0x06, 0x75,
+	0x8f, 0xe2, 0xb1, 0xbe, 0xdb, 0x18, 0x81, 0xe0, 0xb2, 0xe9, 0x36, 0xc9, 0xef, 0x60, 0x68, 0x1a,
+	0x05, 0xef, 0xae, 0x35, 0x3b, 0x31, 0xb8, 0x3a, 0xf2, 0xdb, 0xf9, 0xe6, 0x5f, 0xad, 0xf3, 0x9b,
+	0x6d, 0x13, 0x5c, 0x1d, 0xf9, 0xeb, 0xfc, 0xbb, 0x53, 0xf5, 0x45, 0x7c, 0xf3, 0x67, 0x00, 0x74,
+	0x8d, 0xea, 0x9e, 0x1f, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CartServiceClient is the client API for CartService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CartServiceClient interface {
+	AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...grpc.CallOption) (*AddCartItemResponse, error)
+	UpdateCartItem(ctx context.Context, in *UpdateCartItemRequest, opts ...grpc.CallOption) (*UpdateCartItemResponse, error)
+	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*RemoveCartItemResponse, error)
+	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error)
+	MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error)
+}
+
+type cartServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCartServiceClient(cc *grpc.ClientConn) CartServiceClient {
+	return &cartServiceClient{cc}
+}
+
+func (c *cartServiceClient) AddCartItem(ctx context.Context, in *AddCartItemRequest, opts ...grpc.CallOption) (*AddCartItemResponse, error) {
+	out := new(AddCartItemResponse)
+	err := c.cc.Invoke(ctx, "/cart.CartService/AddCartItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) UpdateCartItem(ctx context.Context, in *UpdateCartItemRequest, opts ...grpc.CallOption) (*UpdateCartItemResponse, error) {
+	out := new(UpdateCartItemResponse)
+	err := c.cc.Invoke(ctx, "/cart.CartService/UpdateCartItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*RemoveCartItemResponse, error) {
+	out := new(RemoveCartItemResponse)
+	err := c.cc.Invoke(ctx, "/cart.CartService/RemoveCartItem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error) {
+	out := new(ListCartResponse)
+	err := c.cc.Invoke(ctx, "/cart.CartService/ListCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*ClearCartResponse, error) {
+	out := new(ClearCartResponse)
+	err := c.cc.Invoke(ctx, "/cart.CartService/ClearCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartServiceClient) MergeCart(ctx context.Context, in *MergeCartRequest, opts ...grpc.CallOption) (*MergeCartResponse, error) {
+	out := new(MergeCartResponse)
+	err := c.cc.Invoke(ctx, "/cart.CartService/MergeCart", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CartServiceServer is the server API for CartService service.
+type CartServiceServer interface {
+	AddCartItem(context.Context, *AddCartItemRequest) (*AddCartItemResponse, error)
+	UpdateCartItem(context.Context, *UpdateCartItemRequest) (*UpdateCartItemResponse, error)
+	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*RemoveCartItemResponse, error)
+	ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error)
+	ClearCart(context.Context, *ClearCartRequest) (*ClearCartResponse, error)
+	MergeCart(context.Context, *MergeCartRequest) (*MergeCartResponse, error)
+}
+
+func RegisterCartServiceServer(s *grpc.Server, srv CartServiceServer) {
+	s.RegisterService(&_CartService_serviceDesc, srv)
+}
+
+func _CartService_AddCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).AddCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cart.CartService/AddCartItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).AddCartItem(ctx, req.(*AddCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_UpdateCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).UpdateCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cart.CartService/UpdateCartItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).UpdateCartItem(ctx, req.(*UpdateCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_RemoveCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).RemoveCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cart.CartService/RemoveCartItem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).RemoveCartItem(ctx, req.(*RemoveCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ListCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ListCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cart.CartService/ListCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ListCart(ctx, req.(*ListCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cart.CartService/ClearCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CartService_MergeCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServiceServer).MergeCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/cart.CartService/MergeCart",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServiceServer).MergeCart(ctx, req.(*MergeCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CartService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "cart.CartService",
+	HandlerType: (*CartServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AddCartItem",
+			Handler:    _CartService_AddCartItem_Handler,
+		},
+		{
+			MethodName: "UpdateCartItem",
+			Handler:    _CartService_UpdateCartItem_Handler,
+		},
+		{
+			MethodName: "RemoveCartItem",
+			Handler:    _CartService_RemoveCartItem_Handler,
+		},
+		{
+			MethodName: "ListCart",
+			Handler:    _CartService_ListCart_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _CartService_ClearCart_Handler,
+		},
+		{
+			MethodName: "MergeCart",
+			Handler:    _CartService_MergeCart_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "cart.proto",
+}
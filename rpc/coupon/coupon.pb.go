@@ -0,0 +1,1303 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: coupon.proto
+
+package coupon
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	grpc "google.golang.org/grpc"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type CouponTemplate struct {
+	TemplateID           string   `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CouponType           string   `protobuf:"bytes,3,opt,name=couponType,proto3" json:"couponType,omitempty"`
+	Threshold            float64  `protobuf:"fixed64,4,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Reduction            float64  `protobuf:"fixed64,5,opt,name=reduction,proto3" json:"reduction,omitempty"`
+	DiscountRate         float64  `protobuf:"fixed64,6,opt,name=discountRate,proto3" json:"discountRate,omitempty"`
+	Total                uint32   `protobuf:"varint,7,opt,name=total,proto3" json:"total,omitempty"`
+	Issued               uint32   `protobuf:"varint,8,opt,name=issued,proto3" json:"issued,omitempty"`
+	LimitPerUser         uint32   `protobuf:"varint,9,opt,name=limitPerUser,proto3" json:"limitPerUser,omitempty"`
+	StartTime            string   `protobuf:"bytes,10,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string   `protobuf:"bytes,11,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	ValidDays            uint32   `protobuf:"varint,12,opt,name=validDays,proto3" json:"validDays,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CouponTemplate) Reset()         { *m = CouponTemplate{} }
+func (m *CouponTemplate) String() string { return proto.CompactTextString(m) }
+func (*CouponTemplate) ProtoMessage()    {}
+func (*CouponTemplate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{0}
+}
+
+func (m *CouponTemplate) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CouponTemplate.Unmarshal(m, b)
+}
+func (m *CouponTemplate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CouponTemplate.Marshal(b, m, deterministic)
+}
+func (m *CouponTemplate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CouponTemplate.Merge(m, src)
+}
+func (m *CouponTemplate) XXX_Size() int {
+	return xxx_messageInfo_CouponTemplate.Size(m)
+}
+func (m *CouponTemplate) XXX_DiscardUnknown() {
+	xxx_messageInfo_CouponTemplate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CouponTemplate proto.InternalMessageInfo
+
+func (m *CouponTemplate) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
+func (m *CouponTemplate) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CouponTemplate) GetCouponType() string {
+	if m != nil {
+		return m.CouponType
+	}
+	return ""
+}
+
+func (m *CouponTemplate) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *CouponTemplate) GetReduction() float64 {
+	if m != nil {
+		return m.Reduction
+	}
+	return 0
+}
+
+func (m *CouponTemplate) GetDiscountRate() float64 {
+	if m != nil {
+		return m.DiscountRate
+	}
+	return 0
+}
+
+func (m *CouponTemplate) GetTotal() uint32 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *CouponTemplate) GetIssued() uint32 {
+	if m != nil {
+		return m.Issued
+	}
+	return 0
+}
+
+func (m *CouponTemplate) GetLimitPerUser() uint32 {
+	if m != nil {
+		return m.LimitPerUser
+	}
+	return 0
+}
+
+func (m *CouponTemplate) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *CouponTemplate) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *CouponTemplate) GetValidDays() uint32 {
+	if m != nil {
+		return m.ValidDays
+	}
+	return 0
+}
+
+type Coupon struct {
+	CouponID             string   `protobuf:"bytes,1,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	TemplateID           string   `protobuf:"bytes,2,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	UserID               string   `protobuf:"bytes,3,opt,name=userID,proto3" json:"userID,omitempty"`
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	CouponType           string   `protobuf:"bytes,5,opt,name=couponType,proto3" json:"couponType,omitempty"`
+	Threshold            float64  `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Reduction            float64  `protobuf:"fixed64,7,opt,name=reduction,proto3" json:"reduction,omitempty"`
+	DiscountRate         float64  `protobuf:"fixed64,8,opt,name=discountRate,proto3" json:"discountRate,omitempty"`
+	Status               uint32   `protobuf:"varint,9,opt,name=status,proto3" json:"status,omitempty"`
+	OrderID              string   `protobuf:"bytes,10,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Discount             float64  `protobuf:"fixed64,11,opt,name=discount,proto3" json:"discount,omitempty"`
+	ExpireTime           string   `protobuf:"bytes,12,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Coupon) Reset()         { *m = Coupon{} }
+func (m *Coupon) String() string { return proto.CompactTextString(m) }
+func (*Coupon) ProtoMessage()    {}
+func (*Coupon) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{1}
+}
+
+func (m *Coupon) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Coupon.Unmarshal(m, b)
+}
+func (m *Coupon) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Coupon.Marshal(b, m, deterministic)
+}
+func (m *Coupon) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Coupon.Merge(m, src)
+}
+func (m *Coupon) XXX_Size() int {
+	return xxx_messageInfo_Coupon.Size(m)
+}
+func (m *Coupon) XXX_DiscardUnknown() {
+	xxx_messageInfo_Coupon.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Coupon proto.InternalMessageInfo
+
+func (m *Coupon) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+func (m *Coupon) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
+func (m *Coupon) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *Coupon) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Coupon) GetCouponType() string {
+	if m != nil {
+		return m.CouponType
+	}
+	return ""
+}
+
+func (m *Coupon) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Coupon) GetReduction() float64 {
+	if m != nil {
+		return m.Reduction
+	}
+	return 0
+}
+
+func (m *Coupon) GetDiscountRate() float64 {
+	if m != nil {
+		return m.DiscountRate
+	}
+	return 0
+}
+
+func (m *Coupon) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *Coupon) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *Coupon) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *Coupon) GetExpireTime() string {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return ""
+}
+
+type CreateCouponTemplateRequest struct {
+	Template             *CouponTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CreateCouponTemplateRequest) Reset()         { *m = CreateCouponTemplateRequest{} }
+func (m *CreateCouponTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCouponTemplateRequest) ProtoMessage()    {}
+func (*CreateCouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{2}
+}
+
+func (m *CreateCouponTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCouponTemplateRequest.Unmarshal(m, b)
+}
+func (m *CreateCouponTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCouponTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *CreateCouponTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCouponTemplateRequest.Merge(m, src)
+}
+func (m *CreateCouponTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCouponTemplateRequest.Size(m)
+}
+func (m *CreateCouponTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCouponTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCouponTemplateRequest proto.InternalMessageInfo
+
+func (m *CreateCouponTemplateRequest) GetTemplate() *CouponTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+type CreateCouponTemplateResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	TemplateID           string   `protobuf:"bytes,3,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCouponTemplateResponse) Reset()         { *m = CreateCouponTemplateResponse{} }
+func (m *CreateCouponTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCouponTemplateResponse) ProtoMessage()    {}
+func (*CreateCouponTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{3}
+}
+
+func (m *CreateCouponTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCouponTemplateResponse.Unmarshal(m, b)
+}
+func (m *CreateCouponTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCouponTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *CreateCouponTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCouponTemplateResponse.Merge(m, src)
+}
+func (m *CreateCouponTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCouponTemplateResponse.Size(m)
+}
+func (m *CreateCouponTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCouponTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCouponTemplateResponse proto.InternalMessageInfo
+
+func (m *CreateCouponTemplateResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CreateCouponTemplateResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *CreateCouponTemplateResponse) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
+type GetCouponTemplateRequest struct {
+	TemplateID           string   `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCouponTemplateRequest) Reset()         { *m = GetCouponTemplateRequest{} }
+func (m *GetCouponTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCouponTemplateRequest) ProtoMessage()    {}
+func (*GetCouponTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{4}
+}
+
+func (m *GetCouponTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCouponTemplateRequest.Unmarshal(m, b)
+}
+func (m *GetCouponTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCouponTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCouponTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCouponTemplateRequest.Merge(m, src)
+}
+func (m *GetCouponTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCouponTemplateRequest.Size(m)
+}
+func (m *GetCouponTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCouponTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCouponTemplateRequest proto.InternalMessageInfo
+
+func (m *GetCouponTemplateRequest) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
+type GetCouponTemplateResponse struct {
+	Template             *CouponTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCouponTemplateResponse) Reset()         { *m = GetCouponTemplateResponse{} }
+func (m *GetCouponTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCouponTemplateResponse) ProtoMessage()    {}
+func (*GetCouponTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{5}
+}
+
+func (m *GetCouponTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCouponTemplateResponse.Unmarshal(m, b)
+}
+func (m *GetCouponTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCouponTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCouponTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCouponTemplateResponse.Merge(m, src)
+}
+func (m *GetCouponTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCouponTemplateResponse.Size(m)
+}
+func (m *GetCouponTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCouponTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCouponTemplateResponse proto.InternalMessageInfo
+
+func (m *GetCouponTemplateResponse) GetTemplate() *CouponTemplate {
+	if m != nil {
+		return m.Template
+	}
+	return nil
+}
+
+type IssueCouponsRequest struct {
+	TemplateID           string   `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	UserIDs              []string `protobuf:"bytes,2,rep,name=userIDs,proto3" json:"userIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssueCouponsRequest) Reset()         { *m = IssueCouponsRequest{} }
+func (m *IssueCouponsRequest) String() string { return proto.CompactTextString(m) }
+func (*IssueCouponsRequest) ProtoMessage()    {}
+func (*IssueCouponsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{6}
+}
+
+func (m *IssueCouponsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueCouponsRequest.Unmarshal(m, b)
+}
+func (m *IssueCouponsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueCouponsRequest.Marshal(b, m, deterministic)
+}
+func (m *IssueCouponsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueCouponsRequest.Merge(m, src)
+}
+func (m *IssueCouponsRequest) XXX_Size() int {
+	return xxx_messageInfo_IssueCouponsRequest.Size(m)
+}
+func (m *IssueCouponsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueCouponsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueCouponsRequest proto.InternalMessageInfo
+
+func (m *IssueCouponsRequest) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
+func (m *IssueCouponsRequest) GetUserIDs() []string {
+	if m != nil {
+		return m.UserIDs
+	}
+	return nil
+}
+
+type IssueCouponsResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	CouponIDs            []string `protobuf:"bytes,3,rep,name=couponIDs,proto3" json:"couponIDs,omitempty"`
+	FailedUserIDs        []string `protobuf:"bytes,4,rep,name=failedUserIDs,proto3" json:"failedUserIDs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *IssueCouponsResponse) Reset()         { *m = IssueCouponsResponse{} }
+func (m *IssueCouponsResponse) String() string { return proto.CompactTextString(m) }
+func (*IssueCouponsResponse) ProtoMessage()    {}
+func (*IssueCouponsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{7}
+}
+
+func (m *IssueCouponsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IssueCouponsResponse.Unmarshal(m, b)
+}
+func (m *IssueCouponsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IssueCouponsResponse.Marshal(b, m, deterministic)
+}
+func (m *IssueCouponsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IssueCouponsResponse.Merge(m, src)
+}
+func (m *IssueCouponsResponse) XXX_Size() int {
+	return xxx_messageInfo_IssueCouponsResponse.Size(m)
+}
+func (m *IssueCouponsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_IssueCouponsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IssueCouponsResponse proto.InternalMessageInfo
+
+func (m *IssueCouponsResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *IssueCouponsResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *IssueCouponsResponse) GetCouponIDs() []string {
+	if m != nil {
+		return m.CouponIDs
+	}
+	return nil
+}
+
+func (m *IssueCouponsResponse) GetFailedUserIDs() []string {
+	if m != nil {
+		return m.FailedUserIDs
+	}
+	return nil
+}
+
+type ClaimCouponRequest struct {
+	TemplateID           string   `protobuf:"bytes,1,opt,name=templateID,proto3" json:"templateID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimCouponRequest) Reset()         { *m = ClaimCouponRequest{} }
+func (m *ClaimCouponRequest) String() string { return proto.CompactTextString(m) }
+func (*ClaimCouponRequest) ProtoMessage()    {}
+func (*ClaimCouponRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{8}
+}
+
+func (m *ClaimCouponRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimCouponRequest.Unmarshal(m, b)
+}
+func (m *ClaimCouponRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimCouponRequest.Marshal(b, m, deterministic)
+}
+func (m *ClaimCouponRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimCouponRequest.Merge(m, src)
+}
+func (m *ClaimCouponRequest) XXX_Size() int {
+	return xxx_messageInfo_ClaimCouponRequest.Size(m)
+}
+func (m *ClaimCouponRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimCouponRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimCouponRequest proto.InternalMessageInfo
+
+func (m *ClaimCouponRequest) GetTemplateID() string {
+	if m != nil {
+		return m.TemplateID
+	}
+	return ""
+}
+
+func (m *ClaimCouponRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type ClaimCouponResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	CouponID             string   `protobuf:"bytes,3,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClaimCouponResponse) Reset()         { *m = ClaimCouponResponse{} }
+func (m *ClaimCouponResponse) String() string { return proto.CompactTextString(m) }
+func (*ClaimCouponResponse) ProtoMessage()    {}
+func (*ClaimCouponResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{9}
+}
+
+func (m *ClaimCouponResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClaimCouponResponse.Unmarshal(m, b)
+}
+func (m *ClaimCouponResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClaimCouponResponse.Marshal(b, m, deterministic)
+}
+func (m *ClaimCouponResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimCouponResponse.Merge(m, src)
+}
+func (m *ClaimCouponResponse) XXX_Size() int {
+	return xxx_messageInfo_ClaimCouponResponse.Size(m)
+}
+func (m *ClaimCouponResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimCouponResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimCouponResponse proto.InternalMessageInfo
+
+func (m *ClaimCouponResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ClaimCouponResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ClaimCouponResponse) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+type ListUserCouponsRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	OnlyAvailable        bool     `protobuf:"varint,2,opt,name=onlyAvailable,proto3" json:"onlyAvailable,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListUserCouponsRequest) Reset()         { *m = ListUserCouponsRequest{} }
+func (m *ListUserCouponsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListUserCouponsRequest) ProtoMessage()    {}
+func (*ListUserCouponsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{10}
+}
+
+func (m *ListUserCouponsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserCouponsRequest.Unmarshal(m, b)
+}
+func (m *ListUserCouponsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserCouponsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListUserCouponsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserCouponsRequest.Merge(m, src)
+}
+func (m *ListUserCouponsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListUserCouponsRequest.Size(m)
+}
+func (m *ListUserCouponsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserCouponsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserCouponsRequest proto.InternalMessageInfo
+
+func (m *ListUserCouponsRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *ListUserCouponsRequest) GetOnlyAvailable() bool {
+	if m != nil {
+		return m.OnlyAvailable
+	}
+	return false
+}
+
+type ListUserCouponsResponse struct {
+	Coupons              []*Coupon `protobuf:"bytes,1,rep,name=coupons,proto3" json:"coupons,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListUserCouponsResponse) Reset()         { *m = ListUserCouponsResponse{} }
+func (m *ListUserCouponsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListUserCouponsResponse) ProtoMessage()    {}
+func (*ListUserCouponsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{11}
+}
+
+func (m *ListUserCouponsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListUserCouponsResponse.Unmarshal(m, b)
+}
+func (m *ListUserCouponsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListUserCouponsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListUserCouponsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListUserCouponsResponse.Merge(m, src)
+}
+func (m *ListUserCouponsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListUserCouponsResponse.Size(m)
+}
+func (m *ListUserCouponsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListUserCouponsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListUserCouponsResponse proto.InternalMessageInfo
+
+func (m *ListUserCouponsResponse) GetCoupons() []*Coupon {
+	if m != nil {
+		return m.Coupons
+	}
+	return nil
+}
+
+type RedeemCouponRequest struct {
+	CouponID             string   `protobuf:"bytes,1,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	OrderID              string   `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Amount               float64  `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeemCouponRequest) Reset()         { *m = RedeemCouponRequest{} }
+func (m *RedeemCouponRequest) String() string { return proto.CompactTextString(m) }
+func (*RedeemCouponRequest) ProtoMessage()    {}
+func (*RedeemCouponRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{12}
+}
+
+func (m *RedeemCouponRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemCouponRequest.Unmarshal(m, b)
+}
+func (m *RedeemCouponRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemCouponRequest.Marshal(b, m, deterministic)
+}
+func (m *RedeemCouponRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemCouponRequest.Merge(m, src)
+}
+func (m *RedeemCouponRequest) XXX_Size() int {
+	return xxx_messageInfo_RedeemCouponRequest.Size(m)
+}
+func (m *RedeemCouponRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemCouponRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemCouponRequest proto.InternalMessageInfo
+
+func (m *RedeemCouponRequest) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+func (m *RedeemCouponRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+func (m *RedeemCouponRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *RedeemCouponRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type RedeemCouponResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Discount             float64  `protobuf:"fixed64,3,opt,name=discount,proto3" json:"discount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RedeemCouponResponse) Reset()         { *m = RedeemCouponResponse{} }
+func (m *RedeemCouponResponse) String() string { return proto.CompactTextString(m) }
+func (*RedeemCouponResponse) ProtoMessage()    {}
+func (*RedeemCouponResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{13}
+}
+
+func (m *RedeemCouponResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RedeemCouponResponse.Unmarshal(m, b)
+}
+func (m *RedeemCouponResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RedeemCouponResponse.Marshal(b, m, deterministic)
+}
+func (m *RedeemCouponResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RedeemCouponResponse.Merge(m, src)
+}
+func (m *RedeemCouponResponse) XXX_Size() int {
+	return xxx_messageInfo_RedeemCouponResponse.Size(m)
+}
+func (m *RedeemCouponResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RedeemCouponResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RedeemCouponResponse proto.InternalMessageInfo
+
+func (m *RedeemCouponResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RedeemCouponResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *RedeemCouponResponse) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+type ReturnCouponRequest struct {
+	CouponID             string   `protobuf:"bytes,1,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReturnCouponRequest) Reset()         { *m = ReturnCouponRequest{} }
+func (m *ReturnCouponRequest) String() string { return proto.CompactTextString(m) }
+func (*ReturnCouponRequest) ProtoMessage()    {}
+func (*ReturnCouponRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{14}
+}
+
+func (m *ReturnCouponRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReturnCouponRequest.Unmarshal(m, b)
+}
+func (m *ReturnCouponRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReturnCouponRequest.Marshal(b, m, deterministic)
+}
+func (m *ReturnCouponRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnCouponRequest.Merge(m, src)
+}
+func (m *ReturnCouponRequest) XXX_Size() int {
+	return xxx_messageInfo_ReturnCouponRequest.Size(m)
+}
+func (m *ReturnCouponRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnCouponRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnCouponRequest proto.InternalMessageInfo
+
+func (m *ReturnCouponRequest) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+func (m *ReturnCouponRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+type ReturnCouponResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReturnCouponResponse) Reset()         { *m = ReturnCouponResponse{} }
+func (m *ReturnCouponResponse) String() string { return proto.CompactTextString(m) }
+func (*ReturnCouponResponse) ProtoMessage()    {}
+func (*ReturnCouponResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a727a1a30518ca78, []int{15}
+}
+
+func (m *ReturnCouponResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReturnCouponResponse.Unmarshal(m, b)
+}
+func (m *ReturnCouponResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReturnCouponResponse.Marshal(b, m, deterministic)
+}
+func (m *ReturnCouponResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReturnCouponResponse.Merge(m, src)
+}
+func (m *ReturnCouponResponse) XXX_Size() int {
+	return xxx_messageInfo_ReturnCouponResponse.Size(m)
+}
+func (m *ReturnCouponResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReturnCouponResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReturnCouponResponse proto.InternalMessageInfo
+
+func (m *ReturnCouponResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReturnCouponResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*CouponTemplate)(nil), "coupon.CouponTemplate")
+	proto.RegisterType((*Coupon)(nil), "coupon.Coupon")
+	proto.RegisterType((*CreateCouponTemplateRequest)(nil), "coupon.CreateCouponTemplateRequest")
+	proto.RegisterType((*CreateCouponTemplateResponse)(nil), "coupon.CreateCouponTemplateResponse")
+	proto.RegisterType((*GetCouponTemplateRequest)(nil), "coupon.GetCouponTemplateRequest")
+	proto.RegisterType((*GetCouponTemplateResponse)(nil), "coupon.GetCouponTemplateResponse")
+	proto.RegisterType((*IssueCouponsRequest)(nil), "coupon.IssueCouponsRequest")
+	proto.RegisterType((*IssueCouponsResponse)(nil), "coupon.IssueCouponsResponse")
+	proto.RegisterType((*ClaimCouponRequest)(nil), "coupon.ClaimCouponRequest")
+	proto.RegisterType((*ClaimCouponResponse)(nil), "coupon.ClaimCouponResponse")
+	proto.RegisterType((*ListUserCouponsRequest)(nil), "coupon.ListUserCouponsRequest")
+	proto.RegisterType((*ListUserCouponsResponse)(nil), "coupon.ListUserCouponsResponse")
+	proto.RegisterType((*RedeemCouponRequest)(nil), "coupon.RedeemCouponRequest")
+	proto.RegisterType((*RedeemCouponResponse)(nil), "coupon.RedeemCouponResponse")
+	proto.RegisterType((*ReturnCouponRequest)(nil), "coupon.ReturnCouponRequest")
+	proto.RegisterType((*ReturnCouponResponse)(nil), "coupon.ReturnCouponResponse")
+}
+
+func init() { proto.RegisterFile("coupon.proto", fileDescriptor_a727a1a30518ca78) }
+
+var fileDescriptor_a727a1a30518ca78 = []byte{
+	// 784 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xcf, 0x6e, 0xd3, 0x4e,
+	0x10, 0xfe, 0x39, 0x4e, 0xdd, 0x64, 0x92, 0xf4, 0x27, 0xb6, 0x51, 0x58, 0xdc, 0xa8, 0x84, 0xa5,
+	0x87, 0x9c, 0x7a, 0x08, 0x37, 0x6e, 0x28, 0x91, 0xa0, 0x6a, 0x51, 0xc1, 0xa4, 0x1c, 0xb8, 0x80,
+	0x1b, 0x2f, 0xd4, 0x92, 0x63, 0x07, 0xef, 0xba, 0xa2, 0xe2, 0x05, 0x78, 0x43, 0x6e, 0x3c, 0x01,
+	0x0f, 0x81, 0x76, 0xd7, 0xff, 0xd6, 0x71, 0xd2, 0x2a, 0xa7, 0xee, 0xcc, 0xec, 0x7c, 0x9e, 0xef,
+	0x9b, 0x9d, 0x69, 0xa0, 0xbb, 0x88, 0x92, 0x55, 0x14, 0x9e, 0xae, 0xe2, 0x88, 0x47, 0xc8, 0x52,
+	0x16, 0xf9, 0xdb, 0x80, 0x83, 0xa9, 0x3c, 0xce, 0xe9, 0x72, 0x15, 0xb8, 0x9c, 0xa2, 0x63, 0x00,
+	0x9e, 0x9e, 0xcf, 0x66, 0xd8, 0x18, 0x19, 0xe3, 0xb6, 0x53, 0xf2, 0x20, 0x04, 0xcd, 0xd0, 0x5d,
+	0x52, 0xdc, 0x90, 0x11, 0x79, 0x16, 0x39, 0x0a, 0x70, 0x7e, 0xb7, 0xa2, 0xd8, 0x54, 0x39, 0x85,
+	0x07, 0x0d, 0xa1, 0xcd, 0x6f, 0x62, 0xca, 0x6e, 0xa2, 0xc0, 0xc3, 0xcd, 0x91, 0x31, 0x36, 0x9c,
+	0xc2, 0x21, 0xa2, 0x31, 0xf5, 0x92, 0x05, 0xf7, 0xa3, 0x10, 0xef, 0xa9, 0x68, 0xee, 0x40, 0x04,
+	0xba, 0x9e, 0xcf, 0x16, 0x51, 0x12, 0x72, 0xc7, 0xe5, 0x14, 0x5b, 0xf2, 0x82, 0xe6, 0x43, 0x7d,
+	0xd8, 0xe3, 0x11, 0x77, 0x03, 0xbc, 0x3f, 0x32, 0xc6, 0x3d, 0x47, 0x19, 0x68, 0x00, 0x96, 0xcf,
+	0x58, 0x42, 0x3d, 0xdc, 0x92, 0xee, 0xd4, 0x12, 0x88, 0x81, 0xbf, 0xf4, 0xf9, 0x3b, 0x1a, 0x5f,
+	0x31, 0x1a, 0xe3, 0xb6, 0x8c, 0x6a, 0x3e, 0x51, 0x13, 0xe3, 0x6e, 0xcc, 0xe7, 0xfe, 0x92, 0x62,
+	0x90, 0x84, 0x0a, 0x07, 0xc2, 0xb0, 0x4f, 0x43, 0x4f, 0xc6, 0x3a, 0x32, 0x96, 0x99, 0x22, 0xef,
+	0xd6, 0x0d, 0x7c, 0x6f, 0xe6, 0xde, 0x31, 0xdc, 0x95, 0xc0, 0x85, 0x83, 0xfc, 0x69, 0x80, 0xa5,
+	0xe4, 0x46, 0x36, 0xb4, 0x94, 0x40, 0xb9, 0xc8, 0xb9, 0x5d, 0x69, 0x41, 0x63, 0xad, 0x05, 0x03,
+	0xb0, 0x12, 0x46, 0xe3, 0xb3, 0x59, 0x2a, 0x75, 0x6a, 0xe5, 0xad, 0x69, 0x6e, 0x6c, 0xcd, 0xde,
+	0xf6, 0xd6, 0x58, 0x5b, 0x5b, 0xb3, 0x7f, 0x5f, 0x6b, 0x5a, 0x35, 0xad, 0x19, 0x80, 0xc5, 0xb8,
+	0xcb, 0x13, 0x96, 0xca, 0x9c, 0x5a, 0x42, 0xc2, 0x28, 0xf6, 0x24, 0x09, 0x25, 0x6f, 0x66, 0x0a,
+	0x65, 0x32, 0x04, 0xa9, 0xae, 0xe1, 0xe4, 0xb6, 0x60, 0x43, 0x7f, 0xac, 0xfc, 0x98, 0x4a, 0xed,
+	0xbb, 0x8a, 0x4d, 0xe1, 0x21, 0xef, 0xe1, 0x68, 0x1a, 0x53, 0x97, 0x53, 0xfd, 0x51, 0x3b, 0xf4,
+	0x7b, 0x42, 0x19, 0x47, 0x13, 0x68, 0x65, 0x32, 0x4a, 0xd1, 0x3b, 0x93, 0xc1, 0x69, 0x3a, 0x17,
+	0x95, 0x84, 0xfc, 0x1e, 0x09, 0x60, 0x58, 0x0f, 0xc9, 0x56, 0x51, 0xc8, 0xa8, 0x10, 0x7d, 0x11,
+	0x79, 0x0a, 0xaf, 0xe7, 0xc8, 0xb3, 0x20, 0x27, 0xfe, 0xbe, 0x65, 0xdf, 0xd2, 0xee, 0x65, 0x66,
+	0xa5, 0xb5, 0x66, 0xb5, 0xb5, 0xe4, 0x25, 0xe0, 0xd7, 0x94, 0xd7, 0x57, 0x7f, 0xcf, 0x64, 0x92,
+	0x4b, 0x78, 0x52, 0x93, 0x9b, 0x96, 0xb9, 0x0b, 0xf5, 0x4b, 0x38, 0x3c, 0x63, 0x2c, 0x49, 0x99,
+	0xb3, 0x07, 0xd6, 0x21, 0xd8, 0xab, 0x07, 0xc9, 0x70, 0x63, 0x64, 0x0a, 0xf6, 0xa9, 0x49, 0x7e,
+	0x19, 0xd0, 0xd7, 0x11, 0x77, 0x12, 0x71, 0x08, 0xed, 0x6c, 0x56, 0x18, 0x36, 0xe5, 0x27, 0x0a,
+	0x07, 0x3a, 0x81, 0xde, 0x57, 0xd7, 0x0f, 0xa8, 0x77, 0x95, 0x16, 0xd1, 0x94, 0x37, 0x74, 0x27,
+	0xb9, 0x00, 0x34, 0x0d, 0x5c, 0x7f, 0xa9, 0x2a, 0x79, 0x28, 0xb5, 0x62, 0xf2, 0x1a, 0xe5, 0xc9,
+	0x23, 0x9f, 0xe1, 0x50, 0x43, 0xdb, 0x89, 0x56, 0x79, 0x25, 0x98, 0xfa, 0x4a, 0x20, 0x1f, 0x61,
+	0x70, 0xe1, 0x33, 0x2e, 0xaa, 0xaf, 0x74, 0xa3, 0x28, 0xc9, 0xd0, 0x96, 0xc1, 0x09, 0xf4, 0xa2,
+	0x30, 0xb8, 0x7b, 0x75, 0xeb, 0xfa, 0x81, 0x7b, 0x1d, 0xa8, 0x85, 0xdd, 0x72, 0x74, 0x27, 0x99,
+	0xc2, 0xe3, 0x35, 0xdc, 0xb4, 0xf8, 0xb1, 0x28, 0x54, 0xba, 0xb0, 0x31, 0x32, 0xc7, 0x9d, 0xc9,
+	0x81, 0xfe, 0x60, 0x9c, 0x2c, 0x4c, 0x7e, 0xc2, 0xa1, 0x43, 0x3d, 0x4a, 0x2b, 0x62, 0x6e, 0x5b,
+	0x71, 0x1b, 0x84, 0x2c, 0xaf, 0x05, 0x53, 0x5f, 0x0b, 0x03, 0xb0, 0xdc, 0xa5, 0x5c, 0x0a, 0xea,
+	0x1f, 0x48, 0x6a, 0x91, 0x2f, 0xd0, 0xd7, 0x3f, 0xbe, 0xab, 0xf6, 0xf9, 0xd2, 0x31, 0xf5, 0xa5,
+	0x43, 0xce, 0x05, 0x3d, 0x9e, 0xc4, 0xe1, 0xc3, 0xe9, 0x95, 0x68, 0x34, 0x34, 0x1a, 0x64, 0x06,
+	0x7d, 0x1d, 0x6c, 0x97, 0x72, 0x27, 0xbf, 0x9b, 0xd0, 0x53, 0x00, 0x1f, 0x68, 0x7c, 0xeb, 0x2f,
+	0x28, 0x5a, 0x40, 0xbf, 0x6e, 0x4d, 0xa1, 0xe7, 0x79, 0xd3, 0x36, 0xef, 0x45, 0xfb, 0x64, 0xfb,
+	0x25, 0x55, 0x22, 0xf9, 0x0f, 0x7d, 0x82, 0x47, 0x6b, 0x1b, 0x06, 0x8d, 0xb2, 0xe4, 0x4d, 0x8b,
+	0xcb, 0x7e, 0xb6, 0xe5, 0x46, 0x8e, 0x7d, 0x0e, 0xdd, 0xf2, 0x6a, 0x40, 0x47, 0x59, 0x52, 0xcd,
+	0x0a, 0xb2, 0x87, 0xf5, 0xc1, 0x1c, 0xec, 0x0d, 0x74, 0x4a, 0xf3, 0x88, 0xec, 0x9c, 0xdf, 0xda,
+	0xc8, 0xdb, 0x47, 0xb5, 0xb1, 0x1c, 0x69, 0x0e, 0xff, 0x57, 0x06, 0x04, 0x1d, 0x67, 0x19, 0xf5,
+	0x13, 0x69, 0x3f, 0xdd, 0x18, 0x2f, 0x93, 0x2d, 0x3f, 0xda, 0x82, 0x6c, 0xcd, 0x1c, 0xd9, 0xc3,
+	0xfa, 0xa0, 0x0e, 0x56, 0x3c, 0xa9, 0x32, 0xd8, 0xda, 0xab, 0xb5, 0x87, 0xf5, 0xc1, 0x0c, 0xec,
+	0xda, 0x92, 0x3f, 0x10, 0x5f, 0xfc, 0x1b, 0x00, 0xa1, 0x18, 0xe0, 0xae, 0x30, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// CouponServiceClient is the client API for CouponService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type CouponServiceClient interface {
+	CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateRequest, opts ...grpc.CallOption) (*CreateCouponTemplateResponse, error)
+	GetCouponTemplate(ctx context.Context, in *GetCouponTemplateRequest, opts ...grpc.CallOption) (*GetCouponTemplateResponse, error)
+	IssueCoupons(ctx context.Context, in *IssueCouponsRequest, opts ...grpc.CallOption) (*IssueCouponsResponse, error)
+	ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*ClaimCouponResponse, error)
+	ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsResponse, error)
+	RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error)
+	ReturnCoupon(ctx context.Context, in *ReturnCouponRequest, opts ...grpc.CallOption) (*ReturnCouponResponse, error)
+}
+
+type couponServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewCouponServiceClient(cc *grpc.ClientConn) CouponServiceClient {
+	return &couponServiceClient{cc}
+}
+
+func (c *couponServiceClient) CreateCouponTemplate(ctx context.Context, in *CreateCouponTemplateRequest, opts ...grpc.CallOption) (*CreateCouponTemplateResponse, error) {
+	out := new(CreateCouponTemplateResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/CreateCouponTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) GetCouponTemplate(ctx context.Context, in *GetCouponTemplateRequest, opts ...grpc.CallOption) (*GetCouponTemplateResponse, error) {
+	out := new(GetCouponTemplateResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/GetCouponTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) IssueCoupons(ctx context.Context, in *IssueCouponsRequest, opts ...grpc.CallOption) (*IssueCouponsResponse, error) {
+	out := new(IssueCouponsResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/IssueCoupons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ClaimCoupon(ctx context.Context, in *ClaimCouponRequest, opts ...grpc.CallOption) (*ClaimCouponResponse, error) {
+	out := new(ClaimCouponResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/ClaimCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ListUserCoupons(ctx context.Context, in *ListUserCouponsRequest, opts ...grpc.CallOption) (*ListUserCouponsResponse, error) {
+	out := new(ListUserCouponsResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/ListUserCoupons", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) RedeemCoupon(ctx context.Context, in *RedeemCouponRequest, opts ...grpc.CallOption) (*RedeemCouponResponse, error) {
+	out := new(RedeemCouponResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/RedeemCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *couponServiceClient) ReturnCoupon(ctx context.Context, in *ReturnCouponRequest, opts ...grpc.CallOption) (*ReturnCouponResponse, error) {
+	out := new(ReturnCouponResponse)
+	err := c.cc.Invoke(ctx, "/coupon.CouponService/ReturnCoupon", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// CouponServiceServer is the server API for CouponService service.
+type CouponServiceServer interface {
+	CreateCouponTemplate(context.Context, *CreateCouponTemplateRequest) (*CreateCouponTemplateResponse, error)
+	GetCouponTemplate(context.Context, *GetCouponTemplateRequest) (*GetCouponTemplateResponse, error)
+	IssueCoupons(context.Context, *IssueCouponsRequest) (*IssueCouponsResponse, error)
+	ClaimCoupon(context.Context, *ClaimCouponRequest) (*ClaimCouponResponse, error)
+	ListUserCoupons(context.Context, *ListUserCouponsRequest) (*ListUserCouponsResponse, error)
+	RedeemCoupon(context.Context, *RedeemCouponRequest) (*RedeemCouponResponse, error)
+	ReturnCoupon(context.Context, *ReturnCouponRequest) (*ReturnCouponResponse, error)
+}
+
+func RegisterCouponServiceServer(s *grpc.Server, srv CouponServiceServer) {
+	s.RegisterService(&_CouponService_serviceDesc, srv)
+}
+
+func _CouponService_CreateCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).CreateCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/CreateCouponTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).CreateCouponTemplate(ctx, req.(*CreateCouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_GetCouponTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCouponTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).GetCouponTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/GetCouponTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).GetCouponTemplate(ctx, req.(*GetCouponTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_IssueCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(IssueCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).IssueCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/IssueCoupons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).IssueCoupons(ctx, req.(*IssueCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ClaimCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClaimCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ClaimCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/ClaimCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ClaimCoupon(ctx, req.(*ClaimCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ListUserCoupons_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListUserCouponsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ListUserCoupons(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/ListUserCoupons",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ListUserCoupons(ctx, req.(*ListUserCouponsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_RedeemCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeemCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).RedeemCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/RedeemCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).RedeemCoupon(ctx, req.(*RedeemCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _CouponService_ReturnCoupon_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReturnCouponRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CouponServiceServer).ReturnCoupon(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/coupon.CouponService/ReturnCoupon",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CouponServiceServer).ReturnCoupon(ctx, req.(*ReturnCouponRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _CouponService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "coupon.CouponService",
+	HandlerType: (*CouponServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateCouponTemplate",
+			Handler:    _CouponService_CreateCouponTemplate_Handler,
+		},
+		{
+			MethodName: "GetCouponTemplate",
+			Handler:    _CouponService_GetCouponTemplate_Handler,
+		},
+		{
+			MethodName: "IssueCoupons",
+			Handler:    _CouponService_IssueCoupons_Handler,
+		},
+		{
+			MethodName: "ClaimCoupon",
+			Handler:    _CouponService_ClaimCoupon_Handler,
+		},
+		{
+			MethodName: "ListUserCoupons",
+			Handler:    _CouponService_ListUserCoupons_Handler,
+		},
+		{
+			MethodName: "RedeemCoupon",
+			Handler:    _CouponService_RedeemCoupon_Handler,
+		},
+		{
+			MethodName: "ReturnCoupon",
+			Handler:    _CouponService_ReturnCoupon_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "coupon.proto",
+}
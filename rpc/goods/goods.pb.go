@@ -221,6 +221,7 @@ func (m *AddGoodsResponse) GetGoodsID() string {
 
 type GetGoodsRequest struct {
 	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	FromDB               bool     `protobuf:"varint,2,opt,name=fromDB,proto3" json:"fromDB,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -258,10 +259,20 @@ func (m *GetGoodsRequest) GetGoodsID() string {
 	return ""
 }
 
+func (m *GetGoodsRequest) GetFromDB() bool {
+	if m != nil {
+		return m.FromDB
+	}
+	return false
+}
+
 type GetGoodsResponse struct {
 	Code                 uint32     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	CodeMsg              string     `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
 	GoodsInfo            *GoodsInfo `protobuf:"bytes,3,opt,name=goodsInfo,proto3" json:"goodsInfo,omitempty"`
+	SkuAttrs             []*SkuAttr `protobuf:"bytes,4,rep,name=skuAttrs,proto3" json:"skuAttrs,omitempty"`
+	Skus                 []*SkuInfo `protobuf:"bytes,5,rep,name=skus,proto3" json:"skus,omitempty"`
+	SellerID             string     `protobuf:"bytes,6,opt,name=sellerID,proto3" json:"sellerID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
@@ -313,6 +324,27 @@ func (m *GetGoodsResponse) GetGoodsInfo() *GoodsInfo {
 	return nil
 }
 
+func (m *GetGoodsResponse) GetSkuAttrs() []*SkuAttr {
+	if m != nil {
+		return m.SkuAttrs
+	}
+	return nil
+}
+
+func (m *GetGoodsResponse) GetSkus() []*SkuInfo {
+	if m != nil {
+		return m.Skus
+	}
+	return nil
+}
+
+func (m *GetGoodsResponse) GetSellerID() string {
+	if m != nil {
+		return m.SellerID
+	}
+	return ""
+}
+
 type GetGoodsListByCategoryRequest struct {
 	Category             uint32   `protobuf:"varint,1,opt,name=category,proto3" json:"category,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -407,6 +439,345 @@ func (m *GetGoodsListByCategoryResponse) GetGoodsIDList() []string {
 	return nil
 }
 
+type SearchGoodsRequest struct {
+	Keyword              string   `protobuf:"bytes,1,opt,name=keyword,proto3" json:"keyword,omitempty"`
+	Categories           []uint32 `protobuf:"varint,2,rep,packed,name=categories,proto3" json:"categories,omitempty"`
+	Brands               []string `protobuf:"bytes,3,rep,name=brands,proto3" json:"brands,omitempty"`
+	MinPrice             float64  `protobuf:"fixed64,4,opt,name=minPrice,proto3" json:"minPrice,omitempty"`
+	MaxPrice             float64  `protobuf:"fixed64,5,opt,name=maxPrice,proto3" json:"maxPrice,omitempty"`
+	InStock              bool     `protobuf:"varint,6,opt,name=inStock,proto3" json:"inStock,omitempty"`
+	SortBy               string   `protobuf:"bytes,7,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Asc                  bool     `protobuf:"varint,8,opt,name=asc,proto3" json:"asc,omitempty"`
+	Cursor               string   `protobuf:"bytes,9,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	NumPerPage           int64    `protobuf:"varint,10,opt,name=numPerPage,proto3" json:"numPerPage,omitempty"`
+	WithFacets           bool     `protobuf:"varint,11,opt,name=withFacets,proto3" json:"withFacets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SearchGoodsRequest) Reset()         { *m = SearchGoodsRequest{} }
+func (m *SearchGoodsRequest) String() string { return proto.CompactTextString(m) }
+func (*SearchGoodsRequest) ProtoMessage()    {}
+func (*SearchGoodsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{7}
+}
+
+func (m *SearchGoodsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchGoodsRequest.Unmarshal(m, b)
+}
+func (m *SearchGoodsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchGoodsRequest.Marshal(b, m, deterministic)
+}
+func (m *SearchGoodsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchGoodsRequest.Merge(m, src)
+}
+func (m *SearchGoodsRequest) XXX_Size() int {
+	return xxx_messageInfo_SearchGoodsRequest.Size(m)
+}
+func (m *SearchGoodsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchGoodsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchGoodsRequest proto.InternalMessageInfo
+
+func (m *SearchGoodsRequest) GetKeyword() string {
+	if m != nil {
+		return m.Keyword
+	}
+	return ""
+}
+
+func (m *SearchGoodsRequest) GetCategories() []uint32 {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *SearchGoodsRequest) GetBrands() []string {
+	if m != nil {
+		return m.Brands
+	}
+	return nil
+}
+
+func (m *SearchGoodsRequest) GetMinPrice() float64 {
+	if m != nil {
+		return m.MinPrice
+	}
+	return 0
+}
+
+func (m *SearchGoodsRequest) GetMaxPrice() float64 {
+	if m != nil {
+		return m.MaxPrice
+	}
+	return 0
+}
+
+func (m *SearchGoodsRequest) GetInStock() bool {
+	if m != nil {
+		return m.InStock
+	}
+	return false
+}
+
+func (m *SearchGoodsRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *SearchGoodsRequest) GetAsc() bool {
+	if m != nil {
+		return m.Asc
+	}
+	return false
+}
+
+func (m *SearchGoodsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *SearchGoodsRequest) GetNumPerPage() int64 {
+	if m != nil {
+		return m.NumPerPage
+	}
+	return 0
+}
+
+func (m *SearchGoodsRequest) GetWithFacets() bool {
+	if m != nil {
+		return m.WithFacets
+	}
+	return false
+}
+
+type GoodsSummary struct {
+	GoodsID              string     `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	GoodsInfo            *GoodsInfo `protobuf:"bytes,2,opt,name=goodsInfo,proto3" json:"goodsInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GoodsSummary) Reset()         { *m = GoodsSummary{} }
+func (m *GoodsSummary) String() string { return proto.CompactTextString(m) }
+func (*GoodsSummary) ProtoMessage()    {}
+func (*GoodsSummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{8}
+}
+
+func (m *GoodsSummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GoodsSummary.Unmarshal(m, b)
+}
+func (m *GoodsSummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GoodsSummary.Marshal(b, m, deterministic)
+}
+func (m *GoodsSummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GoodsSummary.Merge(m, src)
+}
+func (m *GoodsSummary) XXX_Size() int {
+	return xxx_messageInfo_GoodsSummary.Size(m)
+}
+func (m *GoodsSummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_GoodsSummary.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GoodsSummary proto.InternalMessageInfo
+
+func (m *GoodsSummary) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *GoodsSummary) GetGoodsInfo() *GoodsInfo {
+	if m != nil {
+		return m.GoodsInfo
+	}
+	return nil
+}
+
+type FacetBucket struct {
+	Value                string   `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+	Count                int64    `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FacetBucket) Reset()         { *m = FacetBucket{} }
+func (m *FacetBucket) String() string { return proto.CompactTextString(m) }
+func (*FacetBucket) ProtoMessage()    {}
+func (*FacetBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{9}
+}
+
+func (m *FacetBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FacetBucket.Unmarshal(m, b)
+}
+func (m *FacetBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FacetBucket.Marshal(b, m, deterministic)
+}
+func (m *FacetBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FacetBucket.Merge(m, src)
+}
+func (m *FacetBucket) XXX_Size() int {
+	return xxx_messageInfo_FacetBucket.Size(m)
+}
+func (m *FacetBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_FacetBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FacetBucket proto.InternalMessageInfo
+
+func (m *FacetBucket) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+func (m *FacetBucket) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+type Facet struct {
+	Field                string         `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	Buckets              []*FacetBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *Facet) Reset()         { *m = Facet{} }
+func (m *Facet) String() string { return proto.CompactTextString(m) }
+func (*Facet) ProtoMessage()    {}
+func (*Facet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{10}
+}
+
+func (m *Facet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Facet.Unmarshal(m, b)
+}
+func (m *Facet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Facet.Marshal(b, m, deterministic)
+}
+func (m *Facet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Facet.Merge(m, src)
+}
+func (m *Facet) XXX_Size() int {
+	return xxx_messageInfo_Facet.Size(m)
+}
+func (m *Facet) XXX_DiscardUnknown() {
+	xxx_messageInfo_Facet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Facet proto.InternalMessageInfo
+
+func (m *Facet) GetField() string {
+	if m != nil {
+		return m.Field
+	}
+	return ""
+}
+
+func (m *Facet) GetBuckets() []*FacetBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type SearchGoodsResponse struct {
+	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string          `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Goods                []*GoodsSummary `protobuf:"bytes,3,rep,name=goods,proto3" json:"goods,omitempty"`
+	Total                int64           `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor           string          `protobuf:"bytes,5,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	Facets               []*Facet        `protobuf:"bytes,6,rep,name=facets,proto3" json:"facets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SearchGoodsResponse) Reset()         { *m = SearchGoodsResponse{} }
+func (m *SearchGoodsResponse) String() string { return proto.CompactTextString(m) }
+func (*SearchGoodsResponse) ProtoMessage()    {}
+func (*SearchGoodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{11}
+}
+
+func (m *SearchGoodsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SearchGoodsResponse.Unmarshal(m, b)
+}
+func (m *SearchGoodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SearchGoodsResponse.Marshal(b, m, deterministic)
+}
+func (m *SearchGoodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SearchGoodsResponse.Merge(m, src)
+}
+func (m *SearchGoodsResponse) XXX_Size() int {
+	return xxx_messageInfo_SearchGoodsResponse.Size(m)
+}
+func (m *SearchGoodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SearchGoodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SearchGoodsResponse proto.InternalMessageInfo
+
+func (m *SearchGoodsResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SearchGoodsResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *SearchGoodsResponse) GetGoods() []*GoodsSummary {
+	if m != nil {
+		return m.Goods
+	}
+	return nil
+}
+
+func (m *SearchGoodsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *SearchGoodsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+func (m *SearchGoodsResponse) GetFacets() []*Facet {
+	if m != nil {
+		return m.Facets
+	}
+	return nil
+}
+
 type ModifyGoodsInfoRequest struct {
 	GoodsID              string     `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
 	GoodsInfo            *GoodsInfo `protobuf:"bytes,2,opt,name=goodsInfo,proto3" json:"goodsInfo,omitempty"`
@@ -419,7 +790,7 @@ func (m *ModifyGoodsInfoRequest) Reset()         { *m = ModifyGoodsInfoRequest{}
 func (m *ModifyGoodsInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyGoodsInfoRequest) ProtoMessage()    {}
 func (*ModifyGoodsInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a30593c5487368b0, []int{7}
+	return fileDescriptor_a30593c5487368b0, []int{12}
 }
 
 func (m *ModifyGoodsInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -466,7 +837,7 @@ func (m *ModifyGoodsInfoResponse) Reset()         { *m = ModifyGoodsInfoResponse
 func (m *ModifyGoodsInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyGoodsInfoResponse) ProtoMessage()    {}
 func (*ModifyGoodsInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a30593c5487368b0, []int{8}
+	return fileDescriptor_a30593c5487368b0, []int{13}
 }
 
 func (m *ModifyGoodsInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -512,7 +883,7 @@ func (m *DelGoodsRequest) Reset()         { *m = DelGoodsRequest{} }
 func (m *DelGoodsRequest) String() string { return proto.CompactTextString(m) }
 func (*DelGoodsRequest) ProtoMessage()    {}
 func (*DelGoodsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a30593c5487368b0, []int{9}
+	return fileDescriptor_a30593c5487368b0, []int{14}
 }
 
 func (m *DelGoodsRequest) XXX_Unmarshal(b []byte) error {
@@ -552,7 +923,7 @@ func (m *DelGoodsResponse) Reset()         { *m = DelGoodsResponse{} }
 func (m *DelGoodsResponse) String() string { return proto.CompactTextString(m) }
 func (*DelGoodsResponse) ProtoMessage()    {}
 func (*DelGoodsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a30593c5487368b0, []int{10}
+	return fileDescriptor_a30593c5487368b0, []int{15}
 }
 
 func (m *DelGoodsResponse) XXX_Unmarshal(b []byte) error {
@@ -587,58 +958,145 @@ func (m *DelGoodsResponse) GetCodeMsg() string {
 	return ""
 }
 
-type DeductStockRequest struct {
+type RestoreGoodsRequest struct {
 	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
-	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
-	PayID                string   `protobuf:"bytes,3,opt,name=payID,proto3" json:"payID,omitempty"`
-	Number               uint32   `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DeductStockRequest) Reset()         { *m = DeductStockRequest{} }
-func (m *DeductStockRequest) String() string { return proto.CompactTextString(m) }
-func (*DeductStockRequest) ProtoMessage()    {}
-func (*DeductStockRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a30593c5487368b0, []int{11}
+func (m *RestoreGoodsRequest) Reset()         { *m = RestoreGoodsRequest{} }
+func (m *RestoreGoodsRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreGoodsRequest) ProtoMessage()    {}
+func (*RestoreGoodsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{16}
 }
 
-func (m *DeductStockRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DeductStockRequest.Unmarshal(m, b)
+func (m *RestoreGoodsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreGoodsRequest.Unmarshal(m, b)
 }
-func (m *DeductStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DeductStockRequest.Marshal(b, m, deterministic)
+func (m *RestoreGoodsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreGoodsRequest.Marshal(b, m, deterministic)
 }
-func (m *DeductStockRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DeductStockRequest.Merge(m, src)
+func (m *RestoreGoodsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreGoodsRequest.Merge(m, src)
 }
-func (m *DeductStockRequest) XXX_Size() int {
-	return xxx_messageInfo_DeductStockRequest.Size(m)
+func (m *RestoreGoodsRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreGoodsRequest.Size(m)
 }
-func (m *DeductStockRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DeductStockRequest.DiscardUnknown(m)
+func (m *RestoreGoodsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreGoodsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DeductStockRequest proto.InternalMessageInfo
+var xxx_messageInfo_RestoreGoodsRequest proto.InternalMessageInfo
 
-func (m *DeductStockRequest) GetGoodsID() string {
+func (m *RestoreGoodsRequest) GetGoodsID() string {
 	if m != nil {
 		return m.GoodsID
 	}
 	return ""
 }
 
-func (m *DeductStockRequest) GetOrderID() string {
+type RestoreGoodsResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreGoodsResponse) Reset()         { *m = RestoreGoodsResponse{} }
+func (m *RestoreGoodsResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreGoodsResponse) ProtoMessage()    {}
+func (*RestoreGoodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{17}
+}
+
+func (m *RestoreGoodsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreGoodsResponse.Unmarshal(m, b)
+}
+func (m *RestoreGoodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreGoodsResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreGoodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreGoodsResponse.Merge(m, src)
+}
+func (m *RestoreGoodsResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreGoodsResponse.Size(m)
+}
+func (m *RestoreGoodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreGoodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreGoodsResponse proto.InternalMessageInfo
+
+func (m *RestoreGoodsResponse) GetCode() uint32 {
 	if m != nil {
-		return m.OrderID
+		return m.Code
 	}
-	return ""
+	return 0
 }
 
-func (m *DeductStockRequest) GetPayID() string {
+func (m *RestoreGoodsResponse) GetCodeMsg() string {
 	if m != nil {
-		return m.PayID
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type DeductStockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	PayID                string   `protobuf:"bytes,3,opt,name=payID,proto3" json:"payID,omitempty"`
+	Number               uint32   `protobuf:"varint,4,opt,name=number,proto3" json:"number,omitempty"`
+	SkuID                string   `protobuf:"bytes,5,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeductStockRequest) Reset()         { *m = DeductStockRequest{} }
+func (m *DeductStockRequest) String() string { return proto.CompactTextString(m) }
+func (*DeductStockRequest) ProtoMessage()    {}
+func (*DeductStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{18}
+}
+
+func (m *DeductStockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeductStockRequest.Unmarshal(m, b)
+}
+func (m *DeductStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeductStockRequest.Marshal(b, m, deterministic)
+}
+func (m *DeductStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeductStockRequest.Merge(m, src)
+}
+func (m *DeductStockRequest) XXX_Size() int {
+	return xxx_messageInfo_DeductStockRequest.Size(m)
+}
+func (m *DeductStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeductStockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeductStockRequest proto.InternalMessageInfo
+
+func (m *DeductStockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *DeductStockRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *DeductStockRequest) GetPayID() string {
+	if m != nil {
+		return m.PayID
 	}
 	return ""
 }
@@ -650,6 +1108,13 @@ func (m *DeductStockRequest) GetNumber() uint32 {
 	return 0
 }
 
+func (m *DeductStockRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
 type DeductStockResponse struct {
 	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
@@ -662,7 +1127,7 @@ func (m *DeductStockResponse) Reset()         { *m = DeductStockResponse{} }
 func (m *DeductStockResponse) String() string { return proto.CompactTextString(m) }
 func (*DeductStockResponse) ProtoMessage()    {}
 func (*DeductStockResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_a30593c5487368b0, []int{12}
+	return fileDescriptor_a30593c5487368b0, []int{19}
 }
 
 func (m *DeductStockResponse) XXX_Unmarshal(b []byte) error {
@@ -697,260 +1162,5375 @@ func (m *DeductStockResponse) GetCodeMsg() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*GoodsInfo)(nil), "goods.GoodsInfo")
-	proto.RegisterType((*AddGoodsRequest)(nil), "goods.AddGoodsRequest")
-	proto.RegisterType((*AddGoodsResponse)(nil), "goods.AddGoodsResponse")
-	proto.RegisterType((*GetGoodsRequest)(nil), "goods.GetGoodsRequest")
-	proto.RegisterType((*GetGoodsResponse)(nil), "goods.GetGoodsResponse")
-	proto.RegisterType((*GetGoodsListByCategoryRequest)(nil), "goods.GetGoodsListByCategoryRequest")
-	proto.RegisterType((*GetGoodsListByCategoryResponse)(nil), "goods.GetGoodsListByCategoryResponse")
-	proto.RegisterType((*ModifyGoodsInfoRequest)(nil), "goods.ModifyGoodsInfoRequest")
-	proto.RegisterType((*ModifyGoodsInfoResponse)(nil), "goods.ModifyGoodsInfoResponse")
-	proto.RegisterType((*DelGoodsRequest)(nil), "goods.DelGoodsRequest")
-	proto.RegisterType((*DelGoodsResponse)(nil), "goods.DelGoodsResponse")
-	proto.RegisterType((*DeductStockRequest)(nil), "goods.DeductStockRequest")
-	proto.RegisterType((*DeductStockResponse)(nil), "goods.DeductStockResponse")
+type RestoreStockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	PayID                string   `protobuf:"bytes,3,opt,name=payID,proto3" json:"payID,omitempty"`
+	SkuID                string   `protobuf:"bytes,4,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func init() { proto.RegisterFile("goods.proto", fileDescriptor_a30593c5487368b0) }
+func (m *RestoreStockRequest) Reset()         { *m = RestoreStockRequest{} }
+func (m *RestoreStockRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreStockRequest) ProtoMessage()    {}
+func (*RestoreStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{20}
+}
 
-var fileDescriptor_a30593c5487368b0 = []byte{
-	// 505 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x55, 0xcf, 0x6b, 0xdb, 0x30,
-	0x14, 0x8e, 0x9a, 0xa6, 0xad, 0x5f, 0x56, 0x12, 0xb4, 0x91, 0x7a, 0x86, 0x16, 0x23, 0x36, 0x30,
-	0x0c, 0x72, 0xe8, 0x8e, 0x63, 0xb0, 0xad, 0x66, 0x21, 0xb0, 0x5e, 0xd4, 0xdb, 0x6e, 0x89, 0xad,
-	0x9a, 0xb0, 0xd5, 0xf2, 0x64, 0xa7, 0x90, 0xc3, 0x4e, 0xfb, 0x07, 0xf7, 0x27, 0x0d, 0xc9, 0x96,
-	0x6c, 0xab, 0x09, 0x1e, 0xbe, 0xf4, 0x14, 0x7d, 0xd2, 0xfb, 0xf1, 0xbd, 0xf7, 0xbe, 0x17, 0xc3,
-	0x38, 0xe1, 0x3c, 0xce, 0xe7, 0x99, 0xe0, 0x05, 0xc7, 0x23, 0x05, 0xc8, 0x6f, 0x70, 0x16, 0xf2,
-	0xb0, 0x4c, 0xef, 0x39, 0xc6, 0x70, 0x9c, 0xae, 0x1e, 0x98, 0x8b, 0x7c, 0x14, 0x38, 0x54, 0x9d,
-	0xf1, 0x2b, 0x18, 0x65, 0x62, 0x13, 0x31, 0xf7, 0xc8, 0x47, 0x01, 0xa2, 0x25, 0x90, 0xb7, 0x79,
-	0xc1, 0xa3, 0x1f, 0xee, 0xd0, 0x47, 0xc1, 0x88, 0x96, 0x00, 0x7b, 0x70, 0x16, 0xad, 0x0a, 0x96,
-	0x70, 0xb1, 0x73, 0x8f, 0x7d, 0x14, 0x9c, 0x53, 0x83, 0xa5, 0xc7, 0x5a, 0xac, 0xd2, 0xd8, 0x1d,
-	0xa9, 0xe0, 0x25, 0x20, 0x7f, 0x10, 0x4c, 0x3e, 0xc7, 0xb1, 0xa2, 0x40, 0xd9, 0xaf, 0x2d, 0xcb,
-	0x8b, 0x67, 0x60, 0xf1, 0x1d, 0xa6, 0x35, 0x89, 0x3c, 0xe3, 0x69, 0xce, 0x24, 0x8b, 0x88, 0xc7,
-	0x25, 0x8b, 0x73, 0xaa, 0xce, 0xd8, 0x85, 0x53, 0xf9, 0x7b, 0x9b, 0x27, 0x8a, 0x87, 0x43, 0x35,
-	0x94, 0x2f, 0xaa, 0x9f, 0xcb, 0x50, 0x71, 0x71, 0xa8, 0x86, 0xe4, 0x1d, 0x4c, 0x16, 0xac, 0x68,
-	0x15, 0xd8, 0x30, 0x46, 0x6d, 0xe3, 0x0c, 0xa6, 0xb5, 0x71, 0x2f, 0x22, 0x73, 0x70, 0x12, 0x3d,
-	0x4f, 0x45, 0x65, 0x7c, 0x3d, 0x9d, 0x97, 0x73, 0x37, 0x73, 0xa6, 0xb5, 0x09, 0xf9, 0x00, 0x97,
-	0x3a, 0xe3, 0xb7, 0x4d, 0x5e, 0x7c, 0xd9, 0xdd, 0x54, 0xad, 0xd2, 0x64, 0x9b, 0xdd, 0x44, 0xed,
-	0x6e, 0x92, 0x0c, 0xae, 0x0e, 0x39, 0xf7, 0x22, 0xef, 0x57, 0x12, 0x5d, 0x86, 0x32, 0x9c, 0x3b,
-	0xf4, 0x87, 0x81, 0x43, 0x9b, 0x57, 0x64, 0x0d, 0xb3, 0x5b, 0x1e, 0x6f, 0xee, 0x77, 0x75, 0x31,
-	0x5d, 0x4d, 0x6d, 0xb7, 0xe4, 0xa8, 0xbb, 0x25, 0x0b, 0xb8, 0x78, 0x92, 0xa3, 0x4f, 0x39, 0x72,
-	0xf4, 0x21, 0xfb, 0xf9, 0x9f, 0xa3, 0xff, 0x04, 0xd3, 0xda, 0xb8, 0x57, 0xba, 0x47, 0xc0, 0x21,
-	0x8b, 0xb7, 0x51, 0x71, 0x27, 0xd7, 0xa0, 0xbb, 0x2f, 0x2e, 0x9c, 0x72, 0x11, 0x33, 0xb1, 0x0c,
-	0x75, 0xa4, 0x0a, 0xaa, 0x6d, 0x5b, 0xed, 0x8c, 0x96, 0x4b, 0x80, 0x67, 0x70, 0x92, 0x6e, 0x1f,
-	0xd6, 0x4c, 0x54, 0x5b, 0x55, 0x21, 0x72, 0x03, 0x2f, 0x5b, 0x79, 0xfb, 0x90, 0xbf, 0xfe, 0x3b,
-	0x84, 0x17, 0xaa, 0xf8, 0x3b, 0x26, 0x1e, 0xe5, 0x6e, 0x7f, 0x84, 0x33, 0xbd, 0x93, 0x78, 0x56,
-	0x8d, 0xcb, 0xfa, 0xa7, 0xf0, 0x2e, 0x9e, 0xdc, 0x97, 0xb9, 0xc9, 0x40, 0xba, 0x6b, 0x69, 0x1a,
-	0x77, 0x6b, 0x0f, 0x8d, 0xbb, 0xbd, 0x72, 0x64, 0x80, 0x13, 0x98, 0xed, 0x57, 0x36, 0x7e, 0x63,
-	0x39, 0xed, 0xdd, 0x1a, 0xef, 0x6d, 0x87, 0x95, 0x49, 0x44, 0x61, 0x62, 0x89, 0x0d, 0x5f, 0x56,
-	0xbe, 0xfb, 0x85, 0xee, 0x5d, 0x1d, 0x7a, 0x6e, 0xd6, 0xae, 0xa5, 0x64, 0x6a, 0xb7, 0x84, 0x68,
-	0x6a, 0xb7, 0x35, 0x47, 0x06, 0xf8, 0x2b, 0x8c, 0x1b, 0xf3, 0xc4, 0xaf, 0x8d, 0xa5, 0xad, 0x2d,
-	0xcf, 0xdb, 0xf7, 0xa4, 0xe3, 0xac, 0x4f, 0xd4, 0x87, 0xe6, 0xfd, 0xbf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xa6, 0xf8, 0x98, 0x84, 0x77, 0x06, 0x00, 0x00,
+func (m *RestoreStockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreStockRequest.Unmarshal(m, b)
+}
+func (m *RestoreStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreStockRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreStockRequest.Merge(m, src)
+}
+func (m *RestoreStockRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreStockRequest.Size(m)
+}
+func (m *RestoreStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreStockRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_RestoreStockRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *RestoreStockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
 
-// GoodsServiceClient is the client API for GoodsService service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GoodsServiceClient interface {
-	AddGoods(ctx context.Context, in *AddGoodsRequest, opts ...grpc.CallOption) (*AddGoodsResponse, error)
-	GetGoods(ctx context.Context, in *GetGoodsRequest, opts ...grpc.CallOption) (*GetGoodsResponse, error)
-	GetGoodsListByCategory(ctx context.Context, in *GetGoodsListByCategoryRequest, opts ...grpc.CallOption) (*GetGoodsListByCategoryResponse, error)
-	ModifyGoodsInfo(ctx context.Context, in *ModifyGoodsInfoRequest, opts ...grpc.CallOption) (*ModifyGoodsInfoResponse, error)
-	DelGoods(ctx context.Context, in *DelGoodsRequest, opts ...grpc.CallOption) (*DelGoodsResponse, error)
-	DeductStock(ctx context.Context, in *DeductStockRequest, opts ...grpc.CallOption) (*DeductStockResponse, error)
+func (m *RestoreStockRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *RestoreStockRequest) GetPayID() string {
+	if m != nil {
+		return m.PayID
+	}
+	return ""
+}
+
+func (m *RestoreStockRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type RestoreStockResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreStockResponse) Reset()         { *m = RestoreStockResponse{} }
+func (m *RestoreStockResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreStockResponse) ProtoMessage()    {}
+func (*RestoreStockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{21}
+}
+
+func (m *RestoreStockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreStockResponse.Unmarshal(m, b)
+}
+func (m *RestoreStockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreStockResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreStockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreStockResponse.Merge(m, src)
+}
+func (m *RestoreStockResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreStockResponse.Size(m)
+}
+func (m *RestoreStockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreStockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreStockResponse proto.InternalMessageInfo
+
+func (m *RestoreStockResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreStockResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ReserveStockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Number               uint32   `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	ExpireSeconds        int64    `protobuf:"varint,4,opt,name=expireSeconds,proto3" json:"expireSeconds,omitempty"`
+	SkuID                string   `protobuf:"bytes,5,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveStockRequest) Reset()         { *m = ReserveStockRequest{} }
+func (m *ReserveStockRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveStockRequest) ProtoMessage()    {}
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{22}
+}
+
+func (m *ReserveStockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveStockRequest.Unmarshal(m, b)
+}
+func (m *ReserveStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveStockRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveStockRequest.Merge(m, src)
+}
+func (m *ReserveStockRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveStockRequest.Size(m)
+}
+func (m *ReserveStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveStockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveStockRequest proto.InternalMessageInfo
+
+func (m *ReserveStockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *ReserveStockRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ReserveStockRequest) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *ReserveStockRequest) GetExpireSeconds() int64 {
+	if m != nil {
+		return m.ExpireSeconds
+	}
+	return 0
+}
+
+func (m *ReserveStockRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type ReserveStockResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	ReservationID        string   `protobuf:"bytes,3,opt,name=reservationID,proto3" json:"reservationID,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,4,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveStockResponse) Reset()         { *m = ReserveStockResponse{} }
+func (m *ReserveStockResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveStockResponse) ProtoMessage()    {}
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{23}
+}
+
+func (m *ReserveStockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveStockResponse.Unmarshal(m, b)
+}
+func (m *ReserveStockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveStockResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveStockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveStockResponse.Merge(m, src)
+}
+func (m *ReserveStockResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveStockResponse.Size(m)
+}
+func (m *ReserveStockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveStockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveStockResponse proto.InternalMessageInfo
+
+func (m *ReserveStockResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReserveStockResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ReserveStockResponse) GetReservationID() string {
+	if m != nil {
+		return m.ReservationID
+	}
+	return ""
+}
+
+func (m *ReserveStockResponse) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+type StockItem struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Number               uint32   `protobuf:"varint,2,opt,name=number,proto3" json:"number,omitempty"`
+	SkuID                string   `protobuf:"bytes,3,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StockItem) Reset()         { *m = StockItem{} }
+func (m *StockItem) String() string { return proto.CompactTextString(m) }
+func (*StockItem) ProtoMessage()    {}
+func (*StockItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{24}
+}
+
+func (m *StockItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StockItem.Unmarshal(m, b)
+}
+func (m *StockItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StockItem.Marshal(b, m, deterministic)
+}
+func (m *StockItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockItem.Merge(m, src)
+}
+func (m *StockItem) XXX_Size() int {
+	return xxx_messageInfo_StockItem.Size(m)
+}
+func (m *StockItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StockItem proto.InternalMessageInfo
+
+func (m *StockItem) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *StockItem) GetNumber() uint32 {
+	if m != nil {
+		return m.Number
+	}
+	return 0
+}
+
+func (m *StockItem) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type ReserveStocksRequest struct {
+	OrderID              string       `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Items                []*StockItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	ExpireSeconds        int64        `protobuf:"varint,3,opt,name=expireSeconds,proto3" json:"expireSeconds,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ReserveStocksRequest) Reset()         { *m = ReserveStocksRequest{} }
+func (m *ReserveStocksRequest) String() string { return proto.CompactTextString(m) }
+func (*ReserveStocksRequest) ProtoMessage()    {}
+func (*ReserveStocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{25}
+}
+
+func (m *ReserveStocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveStocksRequest.Unmarshal(m, b)
+}
+func (m *ReserveStocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveStocksRequest.Marshal(b, m, deterministic)
+}
+func (m *ReserveStocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveStocksRequest.Merge(m, src)
+}
+func (m *ReserveStocksRequest) XXX_Size() int {
+	return xxx_messageInfo_ReserveStocksRequest.Size(m)
+}
+func (m *ReserveStocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveStocksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveStocksRequest proto.InternalMessageInfo
+
+func (m *ReserveStocksRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ReserveStocksRequest) GetItems() []*StockItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ReserveStocksRequest) GetExpireSeconds() int64 {
+	if m != nil {
+		return m.ExpireSeconds
+	}
+	return 0
+}
+
+type ReserveStocksResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	ExpireTime           int64    `protobuf:"varint,3,opt,name=expireTime,proto3" json:"expireTime,omitempty"`
+	FailedGoodsID        string   `protobuf:"bytes,4,opt,name=failedGoodsID,proto3" json:"failedGoodsID,omitempty"`
+	FailedSkuID          string   `protobuf:"bytes,5,opt,name=failedSkuID,proto3" json:"failedSkuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReserveStocksResponse) Reset()         { *m = ReserveStocksResponse{} }
+func (m *ReserveStocksResponse) String() string { return proto.CompactTextString(m) }
+func (*ReserveStocksResponse) ProtoMessage()    {}
+func (*ReserveStocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{26}
+}
+
+func (m *ReserveStocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReserveStocksResponse.Unmarshal(m, b)
+}
+func (m *ReserveStocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReserveStocksResponse.Marshal(b, m, deterministic)
+}
+func (m *ReserveStocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReserveStocksResponse.Merge(m, src)
+}
+func (m *ReserveStocksResponse) XXX_Size() int {
+	return xxx_messageInfo_ReserveStocksResponse.Size(m)
+}
+func (m *ReserveStocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReserveStocksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReserveStocksResponse proto.InternalMessageInfo
+
+func (m *ReserveStocksResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReserveStocksResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ReserveStocksResponse) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *ReserveStocksResponse) GetFailedGoodsID() string {
+	if m != nil {
+		return m.FailedGoodsID
+	}
+	return ""
+}
+
+func (m *ReserveStocksResponse) GetFailedSkuID() string {
+	if m != nil {
+		return m.FailedSkuID
+	}
+	return ""
+}
+
+type ConfirmReservationRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	PayID                string   `protobuf:"bytes,3,opt,name=payID,proto3" json:"payID,omitempty"`
+	SkuID                string   `protobuf:"bytes,4,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmReservationRequest) Reset()         { *m = ConfirmReservationRequest{} }
+func (m *ConfirmReservationRequest) String() string { return proto.CompactTextString(m) }
+func (*ConfirmReservationRequest) ProtoMessage()    {}
+func (*ConfirmReservationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{27}
+}
+
+func (m *ConfirmReservationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmReservationRequest.Unmarshal(m, b)
+}
+func (m *ConfirmReservationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmReservationRequest.Marshal(b, m, deterministic)
+}
+func (m *ConfirmReservationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmReservationRequest.Merge(m, src)
+}
+func (m *ConfirmReservationRequest) XXX_Size() int {
+	return xxx_messageInfo_ConfirmReservationRequest.Size(m)
+}
+func (m *ConfirmReservationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmReservationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmReservationRequest proto.InternalMessageInfo
+
+func (m *ConfirmReservationRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *ConfirmReservationRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ConfirmReservationRequest) GetPayID() string {
+	if m != nil {
+		return m.PayID
+	}
+	return ""
+}
+
+func (m *ConfirmReservationRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type ConfirmReservationResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ConfirmReservationResponse) Reset()         { *m = ConfirmReservationResponse{} }
+func (m *ConfirmReservationResponse) String() string { return proto.CompactTextString(m) }
+func (*ConfirmReservationResponse) ProtoMessage()    {}
+func (*ConfirmReservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{28}
+}
+
+func (m *ConfirmReservationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ConfirmReservationResponse.Unmarshal(m, b)
+}
+func (m *ConfirmReservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ConfirmReservationResponse.Marshal(b, m, deterministic)
+}
+func (m *ConfirmReservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConfirmReservationResponse.Merge(m, src)
+}
+func (m *ConfirmReservationResponse) XXX_Size() int {
+	return xxx_messageInfo_ConfirmReservationResponse.Size(m)
+}
+func (m *ConfirmReservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConfirmReservationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConfirmReservationResponse proto.InternalMessageInfo
+
+func (m *ConfirmReservationResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ConfirmReservationResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ReleaseReservationRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	OrderID              string   `protobuf:"bytes,2,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	SkuID                string   `protobuf:"bytes,3,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseReservationRequest) Reset()         { *m = ReleaseReservationRequest{} }
+func (m *ReleaseReservationRequest) String() string { return proto.CompactTextString(m) }
+func (*ReleaseReservationRequest) ProtoMessage()    {}
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{29}
+}
+
+func (m *ReleaseReservationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseReservationRequest.Unmarshal(m, b)
+}
+func (m *ReleaseReservationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseReservationRequest.Marshal(b, m, deterministic)
+}
+func (m *ReleaseReservationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseReservationRequest.Merge(m, src)
+}
+func (m *ReleaseReservationRequest) XXX_Size() int {
+	return xxx_messageInfo_ReleaseReservationRequest.Size(m)
+}
+func (m *ReleaseReservationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseReservationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseReservationRequest proto.InternalMessageInfo
+
+func (m *ReleaseReservationRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *ReleaseReservationRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ReleaseReservationRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type ReleaseReservationResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReleaseReservationResponse) Reset()         { *m = ReleaseReservationResponse{} }
+func (m *ReleaseReservationResponse) String() string { return proto.CompactTextString(m) }
+func (*ReleaseReservationResponse) ProtoMessage()    {}
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{30}
+}
+
+func (m *ReleaseReservationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReleaseReservationResponse.Unmarshal(m, b)
+}
+func (m *ReleaseReservationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReleaseReservationResponse.Marshal(b, m, deterministic)
+}
+func (m *ReleaseReservationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReleaseReservationResponse.Merge(m, src)
+}
+func (m *ReleaseReservationResponse) XXX_Size() int {
+	return xxx_messageInfo_ReleaseReservationResponse.Size(m)
+}
+func (m *ReleaseReservationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReleaseReservationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReleaseReservationResponse proto.InternalMessageInfo
+
+func (m *ReleaseReservationResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReleaseReservationResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type CategoryInfo struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	ParentID             uint32   `protobuf:"varint,2,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	Name                 string   `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Level                uint32   `protobuf:"varint,4,opt,name=level,proto3" json:"level,omitempty"`
+	Sort                 int32    `protobuf:"varint,5,opt,name=sort,proto3" json:"sort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CategoryInfo) Reset()         { *m = CategoryInfo{} }
+func (m *CategoryInfo) String() string { return proto.CompactTextString(m) }
+func (*CategoryInfo) ProtoMessage()    {}
+func (*CategoryInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{31}
+}
+
+func (m *CategoryInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CategoryInfo.Unmarshal(m, b)
+}
+func (m *CategoryInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CategoryInfo.Marshal(b, m, deterministic)
+}
+func (m *CategoryInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryInfo.Merge(m, src)
+}
+func (m *CategoryInfo) XXX_Size() int {
+	return xxx_messageInfo_CategoryInfo.Size(m)
+}
+func (m *CategoryInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryInfo proto.InternalMessageInfo
+
+func (m *CategoryInfo) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+func (m *CategoryInfo) GetParentID() uint32 {
+	if m != nil {
+		return m.ParentID
+	}
+	return 0
+}
+
+func (m *CategoryInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CategoryInfo) GetLevel() uint32 {
+	if m != nil {
+		return m.Level
+	}
+	return 0
+}
+
+func (m *CategoryInfo) GetSort() int32 {
+	if m != nil {
+		return m.Sort
+	}
+	return 0
+}
+
+type CategoryNode struct {
+	CategoryInfo         *CategoryInfo   `protobuf:"bytes,1,opt,name=categoryInfo,proto3" json:"categoryInfo,omitempty"`
+	Children             []*CategoryNode `protobuf:"bytes,2,rep,name=children,proto3" json:"children,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CategoryNode) Reset()         { *m = CategoryNode{} }
+func (m *CategoryNode) String() string { return proto.CompactTextString(m) }
+func (*CategoryNode) ProtoMessage()    {}
+func (*CategoryNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{32}
+}
+
+func (m *CategoryNode) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CategoryNode.Unmarshal(m, b)
+}
+func (m *CategoryNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CategoryNode.Marshal(b, m, deterministic)
+}
+func (m *CategoryNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CategoryNode.Merge(m, src)
+}
+func (m *CategoryNode) XXX_Size() int {
+	return xxx_messageInfo_CategoryNode.Size(m)
+}
+func (m *CategoryNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_CategoryNode.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CategoryNode proto.InternalMessageInfo
+
+func (m *CategoryNode) GetCategoryInfo() *CategoryInfo {
+	if m != nil {
+		return m.CategoryInfo
+	}
+	return nil
+}
+
+func (m *CategoryNode) GetChildren() []*CategoryNode {
+	if m != nil {
+		return m.Children
+	}
+	return nil
+}
+
+type AddCategoryRequest struct {
+	ParentID             uint32   `protobuf:"varint,1,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sort                 int32    `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCategoryRequest) Reset()         { *m = AddCategoryRequest{} }
+func (m *AddCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*AddCategoryRequest) ProtoMessage()    {}
+func (*AddCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{33}
+}
+
+func (m *AddCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCategoryRequest.Unmarshal(m, b)
+}
+func (m *AddCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *AddCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCategoryRequest.Merge(m, src)
+}
+func (m *AddCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_AddCategoryRequest.Size(m)
+}
+func (m *AddCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCategoryRequest proto.InternalMessageInfo
+
+func (m *AddCategoryRequest) GetParentID() uint32 {
+	if m != nil {
+		return m.ParentID
+	}
+	return 0
+}
+
+func (m *AddCategoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddCategoryRequest) GetSort() int32 {
+	if m != nil {
+		return m.Sort
+	}
+	return 0
+}
+
+type AddCategoryResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	CategoryID           uint32   `protobuf:"varint,3,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddCategoryResponse) Reset()         { *m = AddCategoryResponse{} }
+func (m *AddCategoryResponse) String() string { return proto.CompactTextString(m) }
+func (*AddCategoryResponse) ProtoMessage()    {}
+func (*AddCategoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{34}
+}
+
+func (m *AddCategoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddCategoryResponse.Unmarshal(m, b)
+}
+func (m *AddCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddCategoryResponse.Marshal(b, m, deterministic)
+}
+func (m *AddCategoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddCategoryResponse.Merge(m, src)
+}
+func (m *AddCategoryResponse) XXX_Size() int {
+	return xxx_messageInfo_AddCategoryResponse.Size(m)
+}
+func (m *AddCategoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddCategoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddCategoryResponse proto.InternalMessageInfo
+
+func (m *AddCategoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *AddCategoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *AddCategoryResponse) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+type GetCategoryRequest struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCategoryRequest) Reset()         { *m = GetCategoryRequest{} }
+func (m *GetCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryRequest) ProtoMessage()    {}
+func (*GetCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{35}
+}
+
+func (m *GetCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryRequest.Unmarshal(m, b)
+}
+func (m *GetCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryRequest.Merge(m, src)
+}
+func (m *GetCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryRequest.Size(m)
+}
+func (m *GetCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryRequest proto.InternalMessageInfo
+
+func (m *GetCategoryRequest) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+type GetCategoryResponse struct {
+	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string          `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	CategoryInfo         *CategoryInfo   `protobuf:"bytes,3,opt,name=categoryInfo,proto3" json:"categoryInfo,omitempty"`
+	Ancestors            []*CategoryInfo `protobuf:"bytes,4,rep,name=ancestors,proto3" json:"ancestors,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCategoryResponse) Reset()         { *m = GetCategoryResponse{} }
+func (m *GetCategoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryResponse) ProtoMessage()    {}
+func (*GetCategoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{36}
+}
+
+func (m *GetCategoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryResponse.Unmarshal(m, b)
+}
+func (m *GetCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCategoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryResponse.Merge(m, src)
+}
+func (m *GetCategoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryResponse.Size(m)
+}
+func (m *GetCategoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryResponse proto.InternalMessageInfo
+
+func (m *GetCategoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetCategoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetCategoryResponse) GetCategoryInfo() *CategoryInfo {
+	if m != nil {
+		return m.CategoryInfo
+	}
+	return nil
+}
+
+func (m *GetCategoryResponse) GetAncestors() []*CategoryInfo {
+	if m != nil {
+		return m.Ancestors
+	}
+	return nil
+}
+
+type ListCategoriesRequest struct {
+	ParentID             uint32   `protobuf:"varint,1,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListCategoriesRequest) Reset()         { *m = ListCategoriesRequest{} }
+func (m *ListCategoriesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListCategoriesRequest) ProtoMessage()    {}
+func (*ListCategoriesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{37}
+}
+
+func (m *ListCategoriesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCategoriesRequest.Unmarshal(m, b)
+}
+func (m *ListCategoriesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCategoriesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListCategoriesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCategoriesRequest.Merge(m, src)
+}
+func (m *ListCategoriesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListCategoriesRequest.Size(m)
+}
+func (m *ListCategoriesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCategoriesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCategoriesRequest proto.InternalMessageInfo
+
+func (m *ListCategoriesRequest) GetParentID() uint32 {
+	if m != nil {
+		return m.ParentID
+	}
+	return 0
+}
+
+type ListCategoriesResponse struct {
+	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string          `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Categories           []*CategoryInfo `protobuf:"bytes,3,rep,name=categories,proto3" json:"categories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListCategoriesResponse) Reset()         { *m = ListCategoriesResponse{} }
+func (m *ListCategoriesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListCategoriesResponse) ProtoMessage()    {}
+func (*ListCategoriesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{38}
+}
+
+func (m *ListCategoriesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListCategoriesResponse.Unmarshal(m, b)
+}
+func (m *ListCategoriesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListCategoriesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListCategoriesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListCategoriesResponse.Merge(m, src)
+}
+func (m *ListCategoriesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListCategoriesResponse.Size(m)
+}
+func (m *ListCategoriesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListCategoriesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListCategoriesResponse proto.InternalMessageInfo
+
+func (m *ListCategoriesResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListCategoriesResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListCategoriesResponse) GetCategories() []*CategoryInfo {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+type GetCategoryTreeRequest struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCategoryTreeRequest) Reset()         { *m = GetCategoryTreeRequest{} }
+func (m *GetCategoryTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryTreeRequest) ProtoMessage()    {}
+func (*GetCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{39}
+}
+
+func (m *GetCategoryTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryTreeRequest.Unmarshal(m, b)
+}
+func (m *GetCategoryTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *GetCategoryTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryTreeRequest.Merge(m, src)
+}
+func (m *GetCategoryTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryTreeRequest.Size(m)
+}
+func (m *GetCategoryTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryTreeRequest proto.InternalMessageInfo
+
+func (m *GetCategoryTreeRequest) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+type GetCategoryTreeResponse struct {
+	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string          `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Nodes                []*CategoryNode `protobuf:"bytes,3,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetCategoryTreeResponse) Reset()         { *m = GetCategoryTreeResponse{} }
+func (m *GetCategoryTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCategoryTreeResponse) ProtoMessage()    {}
+func (*GetCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{40}
+}
+
+func (m *GetCategoryTreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCategoryTreeResponse.Unmarshal(m, b)
+}
+func (m *GetCategoryTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCategoryTreeResponse.Marshal(b, m, deterministic)
+}
+func (m *GetCategoryTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCategoryTreeResponse.Merge(m, src)
+}
+func (m *GetCategoryTreeResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCategoryTreeResponse.Size(m)
+}
+func (m *GetCategoryTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCategoryTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCategoryTreeResponse proto.InternalMessageInfo
+
+func (m *GetCategoryTreeResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetCategoryTreeResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetCategoryTreeResponse) GetNodes() []*CategoryNode {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+type ModifyCategoryRequest struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Sort                 int32    `protobuf:"varint,3,opt,name=sort,proto3" json:"sort,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyCategoryRequest) Reset()         { *m = ModifyCategoryRequest{} }
+func (m *ModifyCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyCategoryRequest) ProtoMessage()    {}
+func (*ModifyCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{41}
+}
+
+func (m *ModifyCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyCategoryRequest.Unmarshal(m, b)
+}
+func (m *ModifyCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyCategoryRequest.Merge(m, src)
+}
+func (m *ModifyCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyCategoryRequest.Size(m)
+}
+func (m *ModifyCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyCategoryRequest proto.InternalMessageInfo
+
+func (m *ModifyCategoryRequest) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+func (m *ModifyCategoryRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ModifyCategoryRequest) GetSort() int32 {
+	if m != nil {
+		return m.Sort
+	}
+	return 0
+}
+
+type ModifyCategoryResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyCategoryResponse) Reset()         { *m = ModifyCategoryResponse{} }
+func (m *ModifyCategoryResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyCategoryResponse) ProtoMessage()    {}
+func (*ModifyCategoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{42}
+}
+
+func (m *ModifyCategoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyCategoryResponse.Unmarshal(m, b)
+}
+func (m *ModifyCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyCategoryResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyCategoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyCategoryResponse.Merge(m, src)
+}
+func (m *ModifyCategoryResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyCategoryResponse.Size(m)
+}
+func (m *ModifyCategoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyCategoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyCategoryResponse proto.InternalMessageInfo
+
+func (m *ModifyCategoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ModifyCategoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type MoveCategoryRequest struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	ParentID             uint32   `protobuf:"varint,2,opt,name=parentID,proto3" json:"parentID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveCategoryRequest) Reset()         { *m = MoveCategoryRequest{} }
+func (m *MoveCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*MoveCategoryRequest) ProtoMessage()    {}
+func (*MoveCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{43}
+}
+
+func (m *MoveCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveCategoryRequest.Unmarshal(m, b)
+}
+func (m *MoveCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *MoveCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveCategoryRequest.Merge(m, src)
+}
+func (m *MoveCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_MoveCategoryRequest.Size(m)
+}
+func (m *MoveCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveCategoryRequest proto.InternalMessageInfo
+
+func (m *MoveCategoryRequest) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+func (m *MoveCategoryRequest) GetParentID() uint32 {
+	if m != nil {
+		return m.ParentID
+	}
+	return 0
+}
+
+type MoveCategoryResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MoveCategoryResponse) Reset()         { *m = MoveCategoryResponse{} }
+func (m *MoveCategoryResponse) String() string { return proto.CompactTextString(m) }
+func (*MoveCategoryResponse) ProtoMessage()    {}
+func (*MoveCategoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{44}
+}
+
+func (m *MoveCategoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MoveCategoryResponse.Unmarshal(m, b)
+}
+func (m *MoveCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MoveCategoryResponse.Marshal(b, m, deterministic)
+}
+func (m *MoveCategoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MoveCategoryResponse.Merge(m, src)
+}
+func (m *MoveCategoryResponse) XXX_Size() int {
+	return xxx_messageInfo_MoveCategoryResponse.Size(m)
+}
+func (m *MoveCategoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MoveCategoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MoveCategoryResponse proto.InternalMessageInfo
+
+func (m *MoveCategoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *MoveCategoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type DelCategoryRequest struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelCategoryRequest) Reset()         { *m = DelCategoryRequest{} }
+func (m *DelCategoryRequest) String() string { return proto.CompactTextString(m) }
+func (*DelCategoryRequest) ProtoMessage()    {}
+func (*DelCategoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{45}
+}
+
+func (m *DelCategoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelCategoryRequest.Unmarshal(m, b)
+}
+func (m *DelCategoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelCategoryRequest.Marshal(b, m, deterministic)
+}
+func (m *DelCategoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelCategoryRequest.Merge(m, src)
+}
+func (m *DelCategoryRequest) XXX_Size() int {
+	return xxx_messageInfo_DelCategoryRequest.Size(m)
+}
+func (m *DelCategoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelCategoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelCategoryRequest proto.InternalMessageInfo
+
+func (m *DelCategoryRequest) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+type DelCategoryResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelCategoryResponse) Reset()         { *m = DelCategoryResponse{} }
+func (m *DelCategoryResponse) String() string { return proto.CompactTextString(m) }
+func (*DelCategoryResponse) ProtoMessage()    {}
+func (*DelCategoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{46}
+}
+
+func (m *DelCategoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelCategoryResponse.Unmarshal(m, b)
+}
+func (m *DelCategoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelCategoryResponse.Marshal(b, m, deterministic)
+}
+func (m *DelCategoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelCategoryResponse.Merge(m, src)
+}
+func (m *DelCategoryResponse) XXX_Size() int {
+	return xxx_messageInfo_DelCategoryResponse.Size(m)
+}
+func (m *DelCategoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelCategoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelCategoryResponse proto.InternalMessageInfo
+
+func (m *DelCategoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *DelCategoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ListGoodsByCategoryTreeRequest struct {
+	CategoryID           uint32   `protobuf:"varint,1,opt,name=categoryID,proto3" json:"categoryID,omitempty"`
+	Cursor               string   `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	NumPerPage           int64    `protobuf:"varint,3,opt,name=numPerPage,proto3" json:"numPerPage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListGoodsByCategoryTreeRequest) Reset()         { *m = ListGoodsByCategoryTreeRequest{} }
+func (m *ListGoodsByCategoryTreeRequest) String() string { return proto.CompactTextString(m) }
+func (*ListGoodsByCategoryTreeRequest) ProtoMessage()    {}
+func (*ListGoodsByCategoryTreeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{47}
+}
+
+func (m *ListGoodsByCategoryTreeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGoodsByCategoryTreeRequest.Unmarshal(m, b)
+}
+func (m *ListGoodsByCategoryTreeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGoodsByCategoryTreeRequest.Marshal(b, m, deterministic)
+}
+func (m *ListGoodsByCategoryTreeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGoodsByCategoryTreeRequest.Merge(m, src)
+}
+func (m *ListGoodsByCategoryTreeRequest) XXX_Size() int {
+	return xxx_messageInfo_ListGoodsByCategoryTreeRequest.Size(m)
+}
+func (m *ListGoodsByCategoryTreeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGoodsByCategoryTreeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGoodsByCategoryTreeRequest proto.InternalMessageInfo
+
+func (m *ListGoodsByCategoryTreeRequest) GetCategoryID() uint32 {
+	if m != nil {
+		return m.CategoryID
+	}
+	return 0
+}
+
+func (m *ListGoodsByCategoryTreeRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListGoodsByCategoryTreeRequest) GetNumPerPage() int64 {
+	if m != nil {
+		return m.NumPerPage
+	}
+	return 0
+}
+
+type ListGoodsByCategoryTreeResponse struct {
+	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string          `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Goods                []*GoodsSummary `protobuf:"bytes,3,rep,name=goods,proto3" json:"goods,omitempty"`
+	Total                int64           `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor           string          `protobuf:"bytes,5,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListGoodsByCategoryTreeResponse) Reset()         { *m = ListGoodsByCategoryTreeResponse{} }
+func (m *ListGoodsByCategoryTreeResponse) String() string { return proto.CompactTextString(m) }
+func (*ListGoodsByCategoryTreeResponse) ProtoMessage()    {}
+func (*ListGoodsByCategoryTreeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{48}
+}
+
+func (m *ListGoodsByCategoryTreeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListGoodsByCategoryTreeResponse.Unmarshal(m, b)
+}
+func (m *ListGoodsByCategoryTreeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListGoodsByCategoryTreeResponse.Marshal(b, m, deterministic)
+}
+func (m *ListGoodsByCategoryTreeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListGoodsByCategoryTreeResponse.Merge(m, src)
+}
+func (m *ListGoodsByCategoryTreeResponse) XXX_Size() int {
+	return xxx_messageInfo_ListGoodsByCategoryTreeResponse.Size(m)
+}
+func (m *ListGoodsByCategoryTreeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListGoodsByCategoryTreeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListGoodsByCategoryTreeResponse proto.InternalMessageInfo
+
+func (m *ListGoodsByCategoryTreeResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListGoodsByCategoryTreeResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListGoodsByCategoryTreeResponse) GetGoods() []*GoodsSummary {
+	if m != nil {
+		return m.Goods
+	}
+	return nil
+}
+
+func (m *ListGoodsByCategoryTreeResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListGoodsByCategoryTreeResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type SkuAttr struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values               []string `protobuf:"bytes,2,rep,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SkuAttr) Reset()         { *m = SkuAttr{} }
+func (m *SkuAttr) String() string { return proto.CompactTextString(m) }
+func (*SkuAttr) ProtoMessage()    {}
+func (*SkuAttr) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{49}
+}
+
+func (m *SkuAttr) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SkuAttr.Unmarshal(m, b)
+}
+func (m *SkuAttr) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SkuAttr.Marshal(b, m, deterministic)
+}
+func (m *SkuAttr) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkuAttr.Merge(m, src)
+}
+func (m *SkuAttr) XXX_Size() int {
+	return xxx_messageInfo_SkuAttr.Size(m)
+}
+func (m *SkuAttr) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkuAttr.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkuAttr proto.InternalMessageInfo
+
+func (m *SkuAttr) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SkuAttr) GetValues() []string {
+	if m != nil {
+		return m.Values
+	}
+	return nil
+}
+
+type SkuAttrValue struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SkuAttrValue) Reset()         { *m = SkuAttrValue{} }
+func (m *SkuAttrValue) String() string { return proto.CompactTextString(m) }
+func (*SkuAttrValue) ProtoMessage()    {}
+func (*SkuAttrValue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{50}
+}
+
+func (m *SkuAttrValue) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SkuAttrValue.Unmarshal(m, b)
+}
+func (m *SkuAttrValue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SkuAttrValue.Marshal(b, m, deterministic)
+}
+func (m *SkuAttrValue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkuAttrValue.Merge(m, src)
+}
+func (m *SkuAttrValue) XXX_Size() int {
+	return xxx_messageInfo_SkuAttrValue.Size(m)
+}
+func (m *SkuAttrValue) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkuAttrValue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkuAttrValue proto.InternalMessageInfo
+
+func (m *SkuAttrValue) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *SkuAttrValue) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
+}
+
+type SkuInfo struct {
+	SkuID                string          `protobuf:"bytes,1,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	GoodsID              string          `protobuf:"bytes,2,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Attrs                []*SkuAttrValue `protobuf:"bytes,3,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	Price                float64         `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Stock                int32           `protobuf:"varint,5,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *SkuInfo) Reset()         { *m = SkuInfo{} }
+func (m *SkuInfo) String() string { return proto.CompactTextString(m) }
+func (*SkuInfo) ProtoMessage()    {}
+func (*SkuInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{51}
+}
+
+func (m *SkuInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SkuInfo.Unmarshal(m, b)
+}
+func (m *SkuInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SkuInfo.Marshal(b, m, deterministic)
+}
+func (m *SkuInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SkuInfo.Merge(m, src)
+}
+func (m *SkuInfo) XXX_Size() int {
+	return xxx_messageInfo_SkuInfo.Size(m)
+}
+func (m *SkuInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SkuInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SkuInfo proto.InternalMessageInfo
+
+func (m *SkuInfo) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *SkuInfo) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *SkuInfo) GetAttrs() []*SkuAttrValue {
+	if m != nil {
+		return m.Attrs
+	}
+	return nil
+}
+
+func (m *SkuInfo) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *SkuInfo) GetStock() int32 {
+	if m != nil {
+		return m.Stock
+	}
+	return 0
+}
+
+type SetSkuAttrsRequest struct {
+	GoodsID              string     `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Attrs                []*SkuAttr `protobuf:"bytes,2,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetSkuAttrsRequest) Reset()         { *m = SetSkuAttrsRequest{} }
+func (m *SetSkuAttrsRequest) String() string { return proto.CompactTextString(m) }
+func (*SetSkuAttrsRequest) ProtoMessage()    {}
+func (*SetSkuAttrsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{52}
+}
+
+func (m *SetSkuAttrsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetSkuAttrsRequest.Unmarshal(m, b)
+}
+func (m *SetSkuAttrsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetSkuAttrsRequest.Marshal(b, m, deterministic)
+}
+func (m *SetSkuAttrsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSkuAttrsRequest.Merge(m, src)
+}
+func (m *SetSkuAttrsRequest) XXX_Size() int {
+	return xxx_messageInfo_SetSkuAttrsRequest.Size(m)
+}
+func (m *SetSkuAttrsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSkuAttrsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSkuAttrsRequest proto.InternalMessageInfo
+
+func (m *SetSkuAttrsRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *SetSkuAttrsRequest) GetAttrs() []*SkuAttr {
+	if m != nil {
+		return m.Attrs
+	}
+	return nil
+}
+
+type SetSkuAttrsResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetSkuAttrsResponse) Reset()         { *m = SetSkuAttrsResponse{} }
+func (m *SetSkuAttrsResponse) String() string { return proto.CompactTextString(m) }
+func (*SetSkuAttrsResponse) ProtoMessage()    {}
+func (*SetSkuAttrsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{53}
+}
+
+func (m *SetSkuAttrsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetSkuAttrsResponse.Unmarshal(m, b)
+}
+func (m *SetSkuAttrsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetSkuAttrsResponse.Marshal(b, m, deterministic)
+}
+func (m *SetSkuAttrsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetSkuAttrsResponse.Merge(m, src)
+}
+func (m *SetSkuAttrsResponse) XXX_Size() int {
+	return xxx_messageInfo_SetSkuAttrsResponse.Size(m)
+}
+func (m *SetSkuAttrsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetSkuAttrsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetSkuAttrsResponse proto.InternalMessageInfo
+
+func (m *SetSkuAttrsResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SetSkuAttrsResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type AddSkuRequest struct {
+	GoodsID              string          `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Attrs                []*SkuAttrValue `protobuf:"bytes,2,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	Price                float64         `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Stock                int32           `protobuf:"varint,4,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *AddSkuRequest) Reset()         { *m = AddSkuRequest{} }
+func (m *AddSkuRequest) String() string { return proto.CompactTextString(m) }
+func (*AddSkuRequest) ProtoMessage()    {}
+func (*AddSkuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{54}
+}
+
+func (m *AddSkuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSkuRequest.Unmarshal(m, b)
+}
+func (m *AddSkuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSkuRequest.Marshal(b, m, deterministic)
+}
+func (m *AddSkuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSkuRequest.Merge(m, src)
+}
+func (m *AddSkuRequest) XXX_Size() int {
+	return xxx_messageInfo_AddSkuRequest.Size(m)
+}
+func (m *AddSkuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSkuRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSkuRequest proto.InternalMessageInfo
+
+func (m *AddSkuRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *AddSkuRequest) GetAttrs() []*SkuAttrValue {
+	if m != nil {
+		return m.Attrs
+	}
+	return nil
+}
+
+func (m *AddSkuRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *AddSkuRequest) GetStock() int32 {
+	if m != nil {
+		return m.Stock
+	}
+	return 0
+}
+
+type AddSkuResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	SkuID                string   `protobuf:"bytes,3,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AddSkuResponse) Reset()         { *m = AddSkuResponse{} }
+func (m *AddSkuResponse) String() string { return proto.CompactTextString(m) }
+func (*AddSkuResponse) ProtoMessage()    {}
+func (*AddSkuResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{55}
+}
+
+func (m *AddSkuResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddSkuResponse.Unmarshal(m, b)
+}
+func (m *AddSkuResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddSkuResponse.Marshal(b, m, deterministic)
+}
+func (m *AddSkuResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddSkuResponse.Merge(m, src)
+}
+func (m *AddSkuResponse) XXX_Size() int {
+	return xxx_messageInfo_AddSkuResponse.Size(m)
+}
+func (m *AddSkuResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddSkuResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddSkuResponse proto.InternalMessageInfo
+
+func (m *AddSkuResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *AddSkuResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *AddSkuResponse) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type GetSkuRequest struct {
+	SkuID                string   `protobuf:"bytes,1,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSkuRequest) Reset()         { *m = GetSkuRequest{} }
+func (m *GetSkuRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSkuRequest) ProtoMessage()    {}
+func (*GetSkuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{56}
+}
+
+func (m *GetSkuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSkuRequest.Unmarshal(m, b)
+}
+func (m *GetSkuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSkuRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSkuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSkuRequest.Merge(m, src)
+}
+func (m *GetSkuRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSkuRequest.Size(m)
+}
+func (m *GetSkuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSkuRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSkuRequest proto.InternalMessageInfo
+
+func (m *GetSkuRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type GetSkuResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	SkuInfo              *SkuInfo `protobuf:"bytes,3,opt,name=skuInfo,proto3" json:"skuInfo,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSkuResponse) Reset()         { *m = GetSkuResponse{} }
+func (m *GetSkuResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSkuResponse) ProtoMessage()    {}
+func (*GetSkuResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{57}
+}
+
+func (m *GetSkuResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSkuResponse.Unmarshal(m, b)
+}
+func (m *GetSkuResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSkuResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSkuResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSkuResponse.Merge(m, src)
+}
+func (m *GetSkuResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSkuResponse.Size(m)
+}
+func (m *GetSkuResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSkuResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSkuResponse proto.InternalMessageInfo
+
+func (m *GetSkuResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetSkuResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetSkuResponse) GetSkuInfo() *SkuInfo {
+	if m != nil {
+		return m.SkuInfo
+	}
+	return nil
+}
+
+type ListSkusRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSkusRequest) Reset()         { *m = ListSkusRequest{} }
+func (m *ListSkusRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSkusRequest) ProtoMessage()    {}
+func (*ListSkusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{58}
+}
+
+func (m *ListSkusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSkusRequest.Unmarshal(m, b)
+}
+func (m *ListSkusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSkusRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSkusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSkusRequest.Merge(m, src)
+}
+func (m *ListSkusRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSkusRequest.Size(m)
+}
+func (m *ListSkusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSkusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSkusRequest proto.InternalMessageInfo
+
+func (m *ListSkusRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+type ListSkusResponse struct {
+	Code                 uint32     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string     `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Skus                 []*SkuInfo `protobuf:"bytes,3,rep,name=skus,proto3" json:"skus,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *ListSkusResponse) Reset()         { *m = ListSkusResponse{} }
+func (m *ListSkusResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSkusResponse) ProtoMessage()    {}
+func (*ListSkusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{59}
+}
+
+func (m *ListSkusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSkusResponse.Unmarshal(m, b)
+}
+func (m *ListSkusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSkusResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSkusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSkusResponse.Merge(m, src)
+}
+func (m *ListSkusResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSkusResponse.Size(m)
+}
+func (m *ListSkusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSkusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSkusResponse proto.InternalMessageInfo
+
+func (m *ListSkusResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListSkusResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListSkusResponse) GetSkus() []*SkuInfo {
+	if m != nil {
+		return m.Skus
+	}
+	return nil
+}
+
+type ModifySkuRequest struct {
+	SkuID                string   `protobuf:"bytes,1,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Price                float64  `protobuf:"fixed64,2,opt,name=price,proto3" json:"price,omitempty"`
+	Stock                int32    `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifySkuRequest) Reset()         { *m = ModifySkuRequest{} }
+func (m *ModifySkuRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifySkuRequest) ProtoMessage()    {}
+func (*ModifySkuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{60}
+}
+
+func (m *ModifySkuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifySkuRequest.Unmarshal(m, b)
+}
+func (m *ModifySkuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifySkuRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifySkuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifySkuRequest.Merge(m, src)
+}
+func (m *ModifySkuRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifySkuRequest.Size(m)
+}
+func (m *ModifySkuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifySkuRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifySkuRequest proto.InternalMessageInfo
+
+func (m *ModifySkuRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *ModifySkuRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ModifySkuRequest) GetStock() int32 {
+	if m != nil {
+		return m.Stock
+	}
+	return 0
+}
+
+type ModifySkuResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifySkuResponse) Reset()         { *m = ModifySkuResponse{} }
+func (m *ModifySkuResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifySkuResponse) ProtoMessage()    {}
+func (*ModifySkuResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{61}
+}
+
+func (m *ModifySkuResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifySkuResponse.Unmarshal(m, b)
+}
+func (m *ModifySkuResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifySkuResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifySkuResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifySkuResponse.Merge(m, src)
+}
+func (m *ModifySkuResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifySkuResponse.Size(m)
+}
+func (m *ModifySkuResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifySkuResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifySkuResponse proto.InternalMessageInfo
+
+func (m *ModifySkuResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ModifySkuResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type DelSkuRequest struct {
+	SkuID                string   `protobuf:"bytes,1,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelSkuRequest) Reset()         { *m = DelSkuRequest{} }
+func (m *DelSkuRequest) String() string { return proto.CompactTextString(m) }
+func (*DelSkuRequest) ProtoMessage()    {}
+func (*DelSkuRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{62}
+}
+
+func (m *DelSkuRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelSkuRequest.Unmarshal(m, b)
+}
+func (m *DelSkuRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelSkuRequest.Marshal(b, m, deterministic)
+}
+func (m *DelSkuRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelSkuRequest.Merge(m, src)
+}
+func (m *DelSkuRequest) XXX_Size() int {
+	return xxx_messageInfo_DelSkuRequest.Size(m)
+}
+func (m *DelSkuRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelSkuRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelSkuRequest proto.InternalMessageInfo
+
+func (m *DelSkuRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type DelSkuResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelSkuResponse) Reset()         { *m = DelSkuResponse{} }
+func (m *DelSkuResponse) String() string { return proto.CompactTextString(m) }
+func (*DelSkuResponse) ProtoMessage()    {}
+func (*DelSkuResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{63}
+}
+
+func (m *DelSkuResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelSkuResponse.Unmarshal(m, b)
+}
+func (m *DelSkuResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelSkuResponse.Marshal(b, m, deterministic)
+}
+func (m *DelSkuResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelSkuResponse.Merge(m, src)
+}
+func (m *DelSkuResponse) XXX_Size() int {
+	return xxx_messageInfo_DelSkuResponse.Size(m)
+}
+func (m *DelSkuResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelSkuResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelSkuResponse proto.InternalMessageInfo
+
+func (m *DelSkuResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *DelSkuResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ListSellerGoodsRequest struct {
+	Cursor               string   `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	NumPerPage           int64    `protobuf:"varint,2,opt,name=numPerPage,proto3" json:"numPerPage,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListSellerGoodsRequest) Reset()         { *m = ListSellerGoodsRequest{} }
+func (m *ListSellerGoodsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSellerGoodsRequest) ProtoMessage()    {}
+func (*ListSellerGoodsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{64}
+}
+
+func (m *ListSellerGoodsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSellerGoodsRequest.Unmarshal(m, b)
+}
+func (m *ListSellerGoodsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSellerGoodsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListSellerGoodsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSellerGoodsRequest.Merge(m, src)
+}
+func (m *ListSellerGoodsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSellerGoodsRequest.Size(m)
+}
+func (m *ListSellerGoodsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSellerGoodsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSellerGoodsRequest proto.InternalMessageInfo
+
+func (m *ListSellerGoodsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
+	}
+	return ""
+}
+
+func (m *ListSellerGoodsRequest) GetNumPerPage() int64 {
+	if m != nil {
+		return m.NumPerPage
+	}
+	return 0
+}
+
+type ListSellerGoodsResponse struct {
+	Code                 uint32          `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string          `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Goods                []*GoodsSummary `protobuf:"bytes,3,rep,name=goods,proto3" json:"goods,omitempty"`
+	Total                int64           `protobuf:"varint,4,opt,name=total,proto3" json:"total,omitempty"`
+	NextCursor           string          `protobuf:"bytes,5,opt,name=nextCursor,proto3" json:"nextCursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ListSellerGoodsResponse) Reset()         { *m = ListSellerGoodsResponse{} }
+func (m *ListSellerGoodsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSellerGoodsResponse) ProtoMessage()    {}
+func (*ListSellerGoodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{65}
+}
+
+func (m *ListSellerGoodsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSellerGoodsResponse.Unmarshal(m, b)
+}
+func (m *ListSellerGoodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSellerGoodsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListSellerGoodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSellerGoodsResponse.Merge(m, src)
+}
+func (m *ListSellerGoodsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSellerGoodsResponse.Size(m)
+}
+func (m *ListSellerGoodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSellerGoodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListSellerGoodsResponse proto.InternalMessageInfo
+
+func (m *ListSellerGoodsResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListSellerGoodsResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListSellerGoodsResponse) GetGoods() []*GoodsSummary {
+	if m != nil {
+		return m.Goods
+	}
+	return nil
+}
+
+func (m *ListSellerGoodsResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *ListSellerGoodsResponse) GetNextCursor() string {
+	if m != nil {
+		return m.NextCursor
+	}
+	return ""
+}
+
+type PriceRecord struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	OldPrice             float64  `protobuf:"fixed64,4,opt,name=oldPrice,proto3" json:"oldPrice,omitempty"`
+	EffectiveTime        int64    `protobuf:"varint,5,opt,name=effectiveTime,proto3" json:"effectiveTime,omitempty"`
+	Source               uint32   `protobuf:"varint,6,opt,name=source,proto3" json:"source,omitempty"`
+	ScheduleID           string   `protobuf:"bytes,7,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceRecord) Reset()         { *m = PriceRecord{} }
+func (m *PriceRecord) String() string { return proto.CompactTextString(m) }
+func (*PriceRecord) ProtoMessage()    {}
+func (*PriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{66}
+}
+
+func (m *PriceRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceRecord.Unmarshal(m, b)
+}
+func (m *PriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceRecord.Marshal(b, m, deterministic)
+}
+func (m *PriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceRecord.Merge(m, src)
+}
+func (m *PriceRecord) XXX_Size() int {
+	return xxx_messageInfo_PriceRecord.Size(m)
+}
+func (m *PriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceRecord proto.InternalMessageInfo
+
+func (m *PriceRecord) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *PriceRecord) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *PriceRecord) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetOldPrice() float64 {
+	if m != nil {
+		return m.OldPrice
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetSource() uint32 {
+	if m != nil {
+		return m.Source
+	}
+	return 0
+}
+
+func (m *PriceRecord) GetScheduleID() string {
+	if m != nil {
+		return m.ScheduleID
+	}
+	return ""
+}
+
+type PriceSchedule struct {
+	ScheduleID           string   `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	GoodsID              string   `protobuf:"bytes,2,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,3,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveTime        int64    `protobuf:"varint,5,opt,name=effectiveTime,proto3" json:"effectiveTime,omitempty"`
+	Status               uint32   `protobuf:"varint,6,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PriceSchedule) Reset()         { *m = PriceSchedule{} }
+func (m *PriceSchedule) String() string { return proto.CompactTextString(m) }
+func (*PriceSchedule) ProtoMessage()    {}
+func (*PriceSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{67}
+}
+
+func (m *PriceSchedule) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceSchedule.Unmarshal(m, b)
+}
+func (m *PriceSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceSchedule.Marshal(b, m, deterministic)
+}
+func (m *PriceSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSchedule.Merge(m, src)
+}
+func (m *PriceSchedule) XXX_Size() int {
+	return xxx_messageInfo_PriceSchedule.Size(m)
+}
+func (m *PriceSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSchedule proto.InternalMessageInfo
+
+func (m *PriceSchedule) GetScheduleID() string {
+	if m != nil {
+		return m.ScheduleID
+	}
+	return ""
+}
+
+func (m *PriceSchedule) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *PriceSchedule) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *PriceSchedule) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *PriceSchedule) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+func (m *PriceSchedule) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type SchedulePriceChangeRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveTime        int64    `protobuf:"varint,4,opt,name=effectiveTime,proto3" json:"effectiveTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchedulePriceChangeRequest) Reset()         { *m = SchedulePriceChangeRequest{} }
+func (m *SchedulePriceChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*SchedulePriceChangeRequest) ProtoMessage()    {}
+func (*SchedulePriceChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{68}
+}
+
+func (m *SchedulePriceChangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchedulePriceChangeRequest.Unmarshal(m, b)
+}
+func (m *SchedulePriceChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchedulePriceChangeRequest.Marshal(b, m, deterministic)
+}
+func (m *SchedulePriceChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePriceChangeRequest.Merge(m, src)
+}
+func (m *SchedulePriceChangeRequest) XXX_Size() int {
+	return xxx_messageInfo_SchedulePriceChangeRequest.Size(m)
+}
+func (m *SchedulePriceChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePriceChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePriceChangeRequest proto.InternalMessageInfo
+
+func (m *SchedulePriceChangeRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *SchedulePriceChangeRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *SchedulePriceChangeRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *SchedulePriceChangeRequest) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+type SchedulePriceChangeResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	ScheduleID           string   `protobuf:"bytes,3,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SchedulePriceChangeResponse) Reset()         { *m = SchedulePriceChangeResponse{} }
+func (m *SchedulePriceChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*SchedulePriceChangeResponse) ProtoMessage()    {}
+func (*SchedulePriceChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{69}
+}
+
+func (m *SchedulePriceChangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SchedulePriceChangeResponse.Unmarshal(m, b)
+}
+func (m *SchedulePriceChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SchedulePriceChangeResponse.Marshal(b, m, deterministic)
+}
+func (m *SchedulePriceChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePriceChangeResponse.Merge(m, src)
+}
+func (m *SchedulePriceChangeResponse) XXX_Size() int {
+	return xxx_messageInfo_SchedulePriceChangeResponse.Size(m)
+}
+func (m *SchedulePriceChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePriceChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePriceChangeResponse proto.InternalMessageInfo
+
+func (m *SchedulePriceChangeResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SchedulePriceChangeResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *SchedulePriceChangeResponse) GetScheduleID() string {
+	if m != nil {
+		return m.ScheduleID
+	}
+	return ""
+}
+
+type CancelPriceChangeRequest struct {
+	ScheduleID           string   `protobuf:"bytes,1,opt,name=scheduleID,proto3" json:"scheduleID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelPriceChangeRequest) Reset()         { *m = CancelPriceChangeRequest{} }
+func (m *CancelPriceChangeRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelPriceChangeRequest) ProtoMessage()    {}
+func (*CancelPriceChangeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{70}
+}
+
+func (m *CancelPriceChangeRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPriceChangeRequest.Unmarshal(m, b)
+}
+func (m *CancelPriceChangeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPriceChangeRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelPriceChangeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPriceChangeRequest.Merge(m, src)
+}
+func (m *CancelPriceChangeRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelPriceChangeRequest.Size(m)
+}
+func (m *CancelPriceChangeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPriceChangeRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPriceChangeRequest proto.InternalMessageInfo
+
+func (m *CancelPriceChangeRequest) GetScheduleID() string {
+	if m != nil {
+		return m.ScheduleID
+	}
+	return ""
+}
+
+type CancelPriceChangeResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelPriceChangeResponse) Reset()         { *m = CancelPriceChangeResponse{} }
+func (m *CancelPriceChangeResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelPriceChangeResponse) ProtoMessage()    {}
+func (*CancelPriceChangeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{71}
+}
+
+func (m *CancelPriceChangeResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelPriceChangeResponse.Unmarshal(m, b)
+}
+func (m *CancelPriceChangeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelPriceChangeResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelPriceChangeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelPriceChangeResponse.Merge(m, src)
+}
+func (m *CancelPriceChangeResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelPriceChangeResponse.Size(m)
+}
+func (m *CancelPriceChangeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelPriceChangeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelPriceChangeResponse proto.InternalMessageInfo
+
+func (m *CancelPriceChangeResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CancelPriceChangeResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ListPriceSchedulesRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Statuses             []uint32 `protobuf:"varint,2,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListPriceSchedulesRequest) Reset()         { *m = ListPriceSchedulesRequest{} }
+func (m *ListPriceSchedulesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListPriceSchedulesRequest) ProtoMessage()    {}
+func (*ListPriceSchedulesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{72}
+}
+
+func (m *ListPriceSchedulesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPriceSchedulesRequest.Unmarshal(m, b)
+}
+func (m *ListPriceSchedulesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPriceSchedulesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListPriceSchedulesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPriceSchedulesRequest.Merge(m, src)
+}
+func (m *ListPriceSchedulesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListPriceSchedulesRequest.Size(m)
+}
+func (m *ListPriceSchedulesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPriceSchedulesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPriceSchedulesRequest proto.InternalMessageInfo
+
+func (m *ListPriceSchedulesRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *ListPriceSchedulesRequest) GetStatuses() []uint32 {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+type ListPriceSchedulesResponse struct {
+	Code                 uint32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string           `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Schedules            []*PriceSchedule `protobuf:"bytes,3,rep,name=schedules,proto3" json:"schedules,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListPriceSchedulesResponse) Reset()         { *m = ListPriceSchedulesResponse{} }
+func (m *ListPriceSchedulesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListPriceSchedulesResponse) ProtoMessage()    {}
+func (*ListPriceSchedulesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{73}
+}
+
+func (m *ListPriceSchedulesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListPriceSchedulesResponse.Unmarshal(m, b)
+}
+func (m *ListPriceSchedulesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListPriceSchedulesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListPriceSchedulesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListPriceSchedulesResponse.Merge(m, src)
+}
+func (m *ListPriceSchedulesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListPriceSchedulesResponse.Size(m)
+}
+func (m *ListPriceSchedulesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListPriceSchedulesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListPriceSchedulesResponse proto.InternalMessageInfo
+
+func (m *ListPriceSchedulesResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListPriceSchedulesResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListPriceSchedulesResponse) GetSchedules() []*PriceSchedule {
+	if m != nil {
+		return m.Schedules
+	}
+	return nil
+}
+
+type GetPriceAtRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Timestamp            int64    `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPriceAtRequest) Reset()         { *m = GetPriceAtRequest{} }
+func (m *GetPriceAtRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceAtRequest) ProtoMessage()    {}
+func (*GetPriceAtRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{74}
+}
+
+func (m *GetPriceAtRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceAtRequest.Unmarshal(m, b)
+}
+func (m *GetPriceAtRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceAtRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPriceAtRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceAtRequest.Merge(m, src)
+}
+func (m *GetPriceAtRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPriceAtRequest.Size(m)
+}
+func (m *GetPriceAtRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceAtRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceAtRequest proto.InternalMessageInfo
+
+func (m *GetPriceAtRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *GetPriceAtRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *GetPriceAtRequest) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetPriceAtResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	EffectiveTime        int64    `protobuf:"varint,4,opt,name=effectiveTime,proto3" json:"effectiveTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPriceAtResponse) Reset()         { *m = GetPriceAtResponse{} }
+func (m *GetPriceAtResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceAtResponse) ProtoMessage()    {}
+func (*GetPriceAtResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{75}
+}
+
+func (m *GetPriceAtResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceAtResponse.Unmarshal(m, b)
+}
+func (m *GetPriceAtResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceAtResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPriceAtResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceAtResponse.Merge(m, src)
+}
+func (m *GetPriceAtResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPriceAtResponse.Size(m)
+}
+func (m *GetPriceAtResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceAtResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceAtResponse proto.InternalMessageInfo
+
+func (m *GetPriceAtResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetPriceAtResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetPriceAtResponse) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *GetPriceAtResponse) GetEffectiveTime() int64 {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return 0
+}
+
+type GetPriceHistoryRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	StartTime            int64    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Limit                int64    `protobuf:"varint,5,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPriceHistoryRequest) Reset()         { *m = GetPriceHistoryRequest{} }
+func (m *GetPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryRequest) ProtoMessage()    {}
+func (*GetPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{76}
+}
+
+func (m *GetPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryRequest.Merge(m, src)
+}
+func (m *GetPriceHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPriceHistoryRequest.Size(m)
+}
+func (m *GetPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *GetPriceHistoryRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *GetPriceHistoryRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *GetPriceHistoryRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *GetPriceHistoryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *GetPriceHistoryRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetPriceHistoryResponse struct {
+	Code                 uint32         `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string         `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Records              []*PriceRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetPriceHistoryResponse) Reset()         { *m = GetPriceHistoryResponse{} }
+func (m *GetPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceHistoryResponse) ProtoMessage()    {}
+func (*GetPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{77}
+}
+
+func (m *GetPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceHistoryResponse.Merge(m, src)
+}
+func (m *GetPriceHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPriceHistoryResponse.Size(m)
+}
+func (m *GetPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *GetPriceHistoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetPriceHistoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetPriceHistoryResponse) GetRecords() []*PriceRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type StockLedgerRecord struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	GoodsID              string   `protobuf:"bytes,2,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,3,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Type                 uint32   `protobuf:"varint,4,opt,name=type,proto3" json:"type,omitempty"`
+	Quantity             int32    `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	RefID                string   `protobuf:"bytes,6,opt,name=refID,proto3" json:"refID,omitempty"`
+	Reason               string   `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangeTime           int64    `protobuf:"varint,8,opt,name=changeTime,proto3" json:"changeTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StockLedgerRecord) Reset()         { *m = StockLedgerRecord{} }
+func (m *StockLedgerRecord) String() string { return proto.CompactTextString(m) }
+func (*StockLedgerRecord) ProtoMessage()    {}
+func (*StockLedgerRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{78}
+}
+
+func (m *StockLedgerRecord) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StockLedgerRecord.Unmarshal(m, b)
+}
+func (m *StockLedgerRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StockLedgerRecord.Marshal(b, m, deterministic)
+}
+func (m *StockLedgerRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockLedgerRecord.Merge(m, src)
+}
+func (m *StockLedgerRecord) XXX_Size() int {
+	return xxx_messageInfo_StockLedgerRecord.Size(m)
+}
+func (m *StockLedgerRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockLedgerRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StockLedgerRecord proto.InternalMessageInfo
+
+func (m *StockLedgerRecord) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *StockLedgerRecord) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *StockLedgerRecord) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *StockLedgerRecord) GetType() uint32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *StockLedgerRecord) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *StockLedgerRecord) GetRefID() string {
+	if m != nil {
+		return m.RefID
+	}
+	return ""
+}
+
+func (m *StockLedgerRecord) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *StockLedgerRecord) GetChangeTime() int64 {
+	if m != nil {
+		return m.ChangeTime
+	}
+	return 0
+}
+
+type StockMismatch struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Stock                int32    `protobuf:"varint,3,opt,name=stock,proto3" json:"stock,omitempty"`
+	LedgerStock          int64    `protobuf:"varint,4,opt,name=ledgerStock,proto3" json:"ledgerStock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StockMismatch) Reset()         { *m = StockMismatch{} }
+func (m *StockMismatch) String() string { return proto.CompactTextString(m) }
+func (*StockMismatch) ProtoMessage()    {}
+func (*StockMismatch) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{79}
+}
+
+func (m *StockMismatch) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StockMismatch.Unmarshal(m, b)
+}
+func (m *StockMismatch) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StockMismatch.Marshal(b, m, deterministic)
+}
+func (m *StockMismatch) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StockMismatch.Merge(m, src)
+}
+func (m *StockMismatch) XXX_Size() int {
+	return xxx_messageInfo_StockMismatch.Size(m)
+}
+func (m *StockMismatch) XXX_DiscardUnknown() {
+	xxx_messageInfo_StockMismatch.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StockMismatch proto.InternalMessageInfo
+
+func (m *StockMismatch) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *StockMismatch) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *StockMismatch) GetStock() int32 {
+	if m != nil {
+		return m.Stock
+	}
+	return 0
+}
+
+func (m *StockMismatch) GetLedgerStock() int64 {
+	if m != nil {
+		return m.LedgerStock
+	}
+	return 0
+}
+
+type AdjustStockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	Quantity             int32    `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	RefID                string   `protobuf:"bytes,5,opt,name=refID,proto3" json:"refID,omitempty"`
+	Receive              bool     `protobuf:"varint,6,opt,name=receive,proto3" json:"receive,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdjustStockRequest) Reset()         { *m = AdjustStockRequest{} }
+func (m *AdjustStockRequest) String() string { return proto.CompactTextString(m) }
+func (*AdjustStockRequest) ProtoMessage()    {}
+func (*AdjustStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{80}
+}
+
+func (m *AdjustStockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdjustStockRequest.Unmarshal(m, b)
+}
+func (m *AdjustStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdjustStockRequest.Marshal(b, m, deterministic)
+}
+func (m *AdjustStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdjustStockRequest.Merge(m, src)
+}
+func (m *AdjustStockRequest) XXX_Size() int {
+	return xxx_messageInfo_AdjustStockRequest.Size(m)
+}
+func (m *AdjustStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdjustStockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdjustStockRequest proto.InternalMessageInfo
+
+func (m *AdjustStockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *AdjustStockRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *AdjustStockRequest) GetQuantity() int32 {
+	if m != nil {
+		return m.Quantity
+	}
+	return 0
+}
+
+func (m *AdjustStockRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *AdjustStockRequest) GetRefID() string {
+	if m != nil {
+		return m.RefID
+	}
+	return ""
+}
+
+func (m *AdjustStockRequest) GetReceive() bool {
+	if m != nil {
+		return m.Receive
+	}
+	return false
+}
+
+type AdjustStockResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AdjustStockResponse) Reset()         { *m = AdjustStockResponse{} }
+func (m *AdjustStockResponse) String() string { return proto.CompactTextString(m) }
+func (*AdjustStockResponse) ProtoMessage()    {}
+func (*AdjustStockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{81}
+}
+
+func (m *AdjustStockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AdjustStockResponse.Unmarshal(m, b)
+}
+func (m *AdjustStockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AdjustStockResponse.Marshal(b, m, deterministic)
+}
+func (m *AdjustStockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AdjustStockResponse.Merge(m, src)
+}
+func (m *AdjustStockResponse) XXX_Size() int {
+	return xxx_messageInfo_AdjustStockResponse.Size(m)
+}
+func (m *AdjustStockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AdjustStockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AdjustStockResponse proto.InternalMessageInfo
+
+func (m *AdjustStockResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *AdjustStockResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type ListStockLedgerRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	SkuID                string   `protobuf:"bytes,2,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	LastID               int64    `protobuf:"varint,3,opt,name=lastID,proto3" json:"lastID,omitempty"`
+	Limit                int64    `protobuf:"varint,4,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListStockLedgerRequest) Reset()         { *m = ListStockLedgerRequest{} }
+func (m *ListStockLedgerRequest) String() string { return proto.CompactTextString(m) }
+func (*ListStockLedgerRequest) ProtoMessage()    {}
+func (*ListStockLedgerRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{82}
+}
+
+func (m *ListStockLedgerRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStockLedgerRequest.Unmarshal(m, b)
+}
+func (m *ListStockLedgerRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStockLedgerRequest.Marshal(b, m, deterministic)
+}
+func (m *ListStockLedgerRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStockLedgerRequest.Merge(m, src)
+}
+func (m *ListStockLedgerRequest) XXX_Size() int {
+	return xxx_messageInfo_ListStockLedgerRequest.Size(m)
+}
+func (m *ListStockLedgerRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStockLedgerRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStockLedgerRequest proto.InternalMessageInfo
+
+func (m *ListStockLedgerRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *ListStockLedgerRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+func (m *ListStockLedgerRequest) GetLastID() int64 {
+	if m != nil {
+		return m.LastID
+	}
+	return 0
+}
+
+func (m *ListStockLedgerRequest) GetLimit() int64 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type ListStockLedgerResponse struct {
+	Code                 uint32               `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string               `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Records              []*StockLedgerRecord `protobuf:"bytes,3,rep,name=records,proto3" json:"records,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ListStockLedgerResponse) Reset()         { *m = ListStockLedgerResponse{} }
+func (m *ListStockLedgerResponse) String() string { return proto.CompactTextString(m) }
+func (*ListStockLedgerResponse) ProtoMessage()    {}
+func (*ListStockLedgerResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{83}
+}
+
+func (m *ListStockLedgerResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListStockLedgerResponse.Unmarshal(m, b)
+}
+func (m *ListStockLedgerResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListStockLedgerResponse.Marshal(b, m, deterministic)
+}
+func (m *ListStockLedgerResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListStockLedgerResponse.Merge(m, src)
+}
+func (m *ListStockLedgerResponse) XXX_Size() int {
+	return xxx_messageInfo_ListStockLedgerResponse.Size(m)
+}
+func (m *ListStockLedgerResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListStockLedgerResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListStockLedgerResponse proto.InternalMessageInfo
+
+func (m *ListStockLedgerResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListStockLedgerResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListStockLedgerResponse) GetRecords() []*StockLedgerRecord {
+	if m != nil {
+		return m.Records
+	}
+	return nil
+}
+
+type ReconcileStockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReconcileStockRequest) Reset()         { *m = ReconcileStockRequest{} }
+func (m *ReconcileStockRequest) String() string { return proto.CompactTextString(m) }
+func (*ReconcileStockRequest) ProtoMessage()    {}
+func (*ReconcileStockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{84}
+}
+
+func (m *ReconcileStockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileStockRequest.Unmarshal(m, b)
+}
+func (m *ReconcileStockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileStockRequest.Marshal(b, m, deterministic)
+}
+func (m *ReconcileStockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileStockRequest.Merge(m, src)
+}
+func (m *ReconcileStockRequest) XXX_Size() int {
+	return xxx_messageInfo_ReconcileStockRequest.Size(m)
+}
+func (m *ReconcileStockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileStockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileStockRequest proto.InternalMessageInfo
+
+func (m *ReconcileStockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+type ReconcileStockResponse struct {
+	Code                 uint32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string           `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Checked              int64            `protobuf:"varint,3,opt,name=checked,proto3" json:"checked,omitempty"`
+	Mismatches           []*StockMismatch `protobuf:"bytes,4,rep,name=mismatches,proto3" json:"mismatches,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ReconcileStockResponse) Reset()         { *m = ReconcileStockResponse{} }
+func (m *ReconcileStockResponse) String() string { return proto.CompactTextString(m) }
+func (*ReconcileStockResponse) ProtoMessage()    {}
+func (*ReconcileStockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{85}
+}
+
+func (m *ReconcileStockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReconcileStockResponse.Unmarshal(m, b)
+}
+func (m *ReconcileStockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReconcileStockResponse.Marshal(b, m, deterministic)
+}
+func (m *ReconcileStockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReconcileStockResponse.Merge(m, src)
+}
+func (m *ReconcileStockResponse) XXX_Size() int {
+	return xxx_messageInfo_ReconcileStockResponse.Size(m)
+}
+func (m *ReconcileStockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReconcileStockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReconcileStockResponse proto.InternalMessageInfo
+
+func (m *ReconcileStockResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ReconcileStockResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ReconcileStockResponse) GetChecked() int64 {
+	if m != nil {
+		return m.Checked
+	}
+	return 0
+}
+
+func (m *ReconcileStockResponse) GetMismatches() []*StockMismatch {
+	if m != nil {
+		return m.Mismatches
+	}
+	return nil
+}
+
+type SetLowStockThresholdRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Threshold            int32    `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLowStockThresholdRequest) Reset()         { *m = SetLowStockThresholdRequest{} }
+func (m *SetLowStockThresholdRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLowStockThresholdRequest) ProtoMessage()    {}
+func (*SetLowStockThresholdRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{86}
+}
+
+func (m *SetLowStockThresholdRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLowStockThresholdRequest.Unmarshal(m, b)
+}
+func (m *SetLowStockThresholdRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLowStockThresholdRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLowStockThresholdRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLowStockThresholdRequest.Merge(m, src)
+}
+func (m *SetLowStockThresholdRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLowStockThresholdRequest.Size(m)
+}
+func (m *SetLowStockThresholdRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLowStockThresholdRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLowStockThresholdRequest proto.InternalMessageInfo
+
+func (m *SetLowStockThresholdRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *SetLowStockThresholdRequest) GetThreshold() int32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+type SetLowStockThresholdResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLowStockThresholdResponse) Reset()         { *m = SetLowStockThresholdResponse{} }
+func (m *SetLowStockThresholdResponse) String() string { return proto.CompactTextString(m) }
+func (*SetLowStockThresholdResponse) ProtoMessage()    {}
+func (*SetLowStockThresholdResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{87}
+}
+
+func (m *SetLowStockThresholdResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLowStockThresholdResponse.Unmarshal(m, b)
+}
+func (m *SetLowStockThresholdResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLowStockThresholdResponse.Marshal(b, m, deterministic)
+}
+func (m *SetLowStockThresholdResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLowStockThresholdResponse.Merge(m, src)
+}
+func (m *SetLowStockThresholdResponse) XXX_Size() int {
+	return xxx_messageInfo_SetLowStockThresholdResponse.Size(m)
+}
+func (m *SetLowStockThresholdResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLowStockThresholdResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLowStockThresholdResponse proto.InternalMessageInfo
+
+func (m *SetLowStockThresholdResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SetLowStockThresholdResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type SubscribeRestockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRestockRequest) Reset()         { *m = SubscribeRestockRequest{} }
+func (m *SubscribeRestockRequest) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRestockRequest) ProtoMessage()    {}
+func (*SubscribeRestockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{88}
+}
+
+func (m *SubscribeRestockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRestockRequest.Unmarshal(m, b)
+}
+func (m *SubscribeRestockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRestockRequest.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRestockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRestockRequest.Merge(m, src)
+}
+func (m *SubscribeRestockRequest) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRestockRequest.Size(m)
+}
+func (m *SubscribeRestockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRestockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRestockRequest proto.InternalMessageInfo
+
+func (m *SubscribeRestockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *SubscribeRestockRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type SubscribeRestockResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubscribeRestockResponse) Reset()         { *m = SubscribeRestockResponse{} }
+func (m *SubscribeRestockResponse) String() string { return proto.CompactTextString(m) }
+func (*SubscribeRestockResponse) ProtoMessage()    {}
+func (*SubscribeRestockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{89}
+}
+
+func (m *SubscribeRestockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubscribeRestockResponse.Unmarshal(m, b)
+}
+func (m *SubscribeRestockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubscribeRestockResponse.Marshal(b, m, deterministic)
+}
+func (m *SubscribeRestockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubscribeRestockResponse.Merge(m, src)
+}
+func (m *SubscribeRestockResponse) XXX_Size() int {
+	return xxx_messageInfo_SubscribeRestockResponse.Size(m)
+}
+func (m *SubscribeRestockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubscribeRestockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubscribeRestockResponse proto.InternalMessageInfo
+
+func (m *SubscribeRestockResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SubscribeRestockResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type UnsubscribeRestockRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	UserID               string   `protobuf:"bytes,2,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeRestockRequest) Reset()         { *m = UnsubscribeRestockRequest{} }
+func (m *UnsubscribeRestockRequest) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeRestockRequest) ProtoMessage()    {}
+func (*UnsubscribeRestockRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{90}
+}
+
+func (m *UnsubscribeRestockRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeRestockRequest.Unmarshal(m, b)
+}
+func (m *UnsubscribeRestockRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeRestockRequest.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeRestockRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeRestockRequest.Merge(m, src)
+}
+func (m *UnsubscribeRestockRequest) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeRestockRequest.Size(m)
+}
+func (m *UnsubscribeRestockRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeRestockRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeRestockRequest proto.InternalMessageInfo
+
+func (m *UnsubscribeRestockRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *UnsubscribeRestockRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type UnsubscribeRestockResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UnsubscribeRestockResponse) Reset()         { *m = UnsubscribeRestockResponse{} }
+func (m *UnsubscribeRestockResponse) String() string { return proto.CompactTextString(m) }
+func (*UnsubscribeRestockResponse) ProtoMessage()    {}
+func (*UnsubscribeRestockResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a30593c5487368b0, []int{91}
+}
+
+func (m *UnsubscribeRestockResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnsubscribeRestockResponse.Unmarshal(m, b)
+}
+func (m *UnsubscribeRestockResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnsubscribeRestockResponse.Marshal(b, m, deterministic)
+}
+func (m *UnsubscribeRestockResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnsubscribeRestockResponse.Merge(m, src)
+}
+func (m *UnsubscribeRestockResponse) XXX_Size() int {
+	return xxx_messageInfo_UnsubscribeRestockResponse.Size(m)
+}
+func (m *UnsubscribeRestockResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnsubscribeRestockResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UnsubscribeRestockResponse proto.InternalMessageInfo
+
+func (m *UnsubscribeRestockResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *UnsubscribeRestockResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GoodsInfo)(nil), "goods.GoodsInfo")
+	proto.RegisterType((*AddGoodsRequest)(nil), "goods.AddGoodsRequest")
+	proto.RegisterType((*AddGoodsResponse)(nil), "goods.AddGoodsResponse")
+	proto.RegisterType((*GetGoodsRequest)(nil), "goods.GetGoodsRequest")
+	proto.RegisterType((*GetGoodsResponse)(nil), "goods.GetGoodsResponse")
+	proto.RegisterType((*GetGoodsListByCategoryRequest)(nil), "goods.GetGoodsListByCategoryRequest")
+	proto.RegisterType((*GetGoodsListByCategoryResponse)(nil), "goods.GetGoodsListByCategoryResponse")
+	proto.RegisterType((*SearchGoodsRequest)(nil), "goods.SearchGoodsRequest")
+	proto.RegisterType((*GoodsSummary)(nil), "goods.GoodsSummary")
+	proto.RegisterType((*FacetBucket)(nil), "goods.FacetBucket")
+	proto.RegisterType((*Facet)(nil), "goods.Facet")
+	proto.RegisterType((*SearchGoodsResponse)(nil), "goods.SearchGoodsResponse")
+	proto.RegisterType((*ModifyGoodsInfoRequest)(nil), "goods.ModifyGoodsInfoRequest")
+	proto.RegisterType((*ModifyGoodsInfoResponse)(nil), "goods.ModifyGoodsInfoResponse")
+	proto.RegisterType((*DelGoodsRequest)(nil), "goods.DelGoodsRequest")
+	proto.RegisterType((*DelGoodsResponse)(nil), "goods.DelGoodsResponse")
+	proto.RegisterType((*RestoreGoodsRequest)(nil), "goods.RestoreGoodsRequest")
+	proto.RegisterType((*RestoreGoodsResponse)(nil), "goods.RestoreGoodsResponse")
+	proto.RegisterType((*DeductStockRequest)(nil), "goods.DeductStockRequest")
+	proto.RegisterType((*DeductStockResponse)(nil), "goods.DeductStockResponse")
+	proto.RegisterType((*RestoreStockRequest)(nil), "goods.RestoreStockRequest")
+	proto.RegisterType((*RestoreStockResponse)(nil), "goods.RestoreStockResponse")
+	proto.RegisterType((*ReserveStockRequest)(nil), "goods.ReserveStockRequest")
+	proto.RegisterType((*ReserveStockResponse)(nil), "goods.ReserveStockResponse")
+	proto.RegisterType((*StockItem)(nil), "goods.StockItem")
+	proto.RegisterType((*ReserveStocksRequest)(nil), "goods.ReserveStocksRequest")
+	proto.RegisterType((*ReserveStocksResponse)(nil), "goods.ReserveStocksResponse")
+	proto.RegisterType((*ConfirmReservationRequest)(nil), "goods.ConfirmReservationRequest")
+	proto.RegisterType((*ConfirmReservationResponse)(nil), "goods.ConfirmReservationResponse")
+	proto.RegisterType((*ReleaseReservationRequest)(nil), "goods.ReleaseReservationRequest")
+	proto.RegisterType((*ReleaseReservationResponse)(nil), "goods.ReleaseReservationResponse")
+	proto.RegisterType((*CategoryInfo)(nil), "goods.CategoryInfo")
+	proto.RegisterType((*CategoryNode)(nil), "goods.CategoryNode")
+	proto.RegisterType((*AddCategoryRequest)(nil), "goods.AddCategoryRequest")
+	proto.RegisterType((*AddCategoryResponse)(nil), "goods.AddCategoryResponse")
+	proto.RegisterType((*GetCategoryRequest)(nil), "goods.GetCategoryRequest")
+	proto.RegisterType((*GetCategoryResponse)(nil), "goods.GetCategoryResponse")
+	proto.RegisterType((*ListCategoriesRequest)(nil), "goods.ListCategoriesRequest")
+	proto.RegisterType((*ListCategoriesResponse)(nil), "goods.ListCategoriesResponse")
+	proto.RegisterType((*GetCategoryTreeRequest)(nil), "goods.GetCategoryTreeRequest")
+	proto.RegisterType((*GetCategoryTreeResponse)(nil), "goods.GetCategoryTreeResponse")
+	proto.RegisterType((*ModifyCategoryRequest)(nil), "goods.ModifyCategoryRequest")
+	proto.RegisterType((*ModifyCategoryResponse)(nil), "goods.ModifyCategoryResponse")
+	proto.RegisterType((*MoveCategoryRequest)(nil), "goods.MoveCategoryRequest")
+	proto.RegisterType((*MoveCategoryResponse)(nil), "goods.MoveCategoryResponse")
+	proto.RegisterType((*DelCategoryRequest)(nil), "goods.DelCategoryRequest")
+	proto.RegisterType((*DelCategoryResponse)(nil), "goods.DelCategoryResponse")
+	proto.RegisterType((*ListGoodsByCategoryTreeRequest)(nil), "goods.ListGoodsByCategoryTreeRequest")
+	proto.RegisterType((*ListGoodsByCategoryTreeResponse)(nil), "goods.ListGoodsByCategoryTreeResponse")
+	proto.RegisterType((*SkuAttr)(nil), "goods.SkuAttr")
+	proto.RegisterType((*SkuAttrValue)(nil), "goods.SkuAttrValue")
+	proto.RegisterType((*SkuInfo)(nil), "goods.SkuInfo")
+	proto.RegisterType((*SetSkuAttrsRequest)(nil), "goods.SetSkuAttrsRequest")
+	proto.RegisterType((*SetSkuAttrsResponse)(nil), "goods.SetSkuAttrsResponse")
+	proto.RegisterType((*AddSkuRequest)(nil), "goods.AddSkuRequest")
+	proto.RegisterType((*AddSkuResponse)(nil), "goods.AddSkuResponse")
+	proto.RegisterType((*GetSkuRequest)(nil), "goods.GetSkuRequest")
+	proto.RegisterType((*GetSkuResponse)(nil), "goods.GetSkuResponse")
+	proto.RegisterType((*ListSkusRequest)(nil), "goods.ListSkusRequest")
+	proto.RegisterType((*ListSkusResponse)(nil), "goods.ListSkusResponse")
+	proto.RegisterType((*ModifySkuRequest)(nil), "goods.ModifySkuRequest")
+	proto.RegisterType((*ModifySkuResponse)(nil), "goods.ModifySkuResponse")
+	proto.RegisterType((*DelSkuRequest)(nil), "goods.DelSkuRequest")
+	proto.RegisterType((*DelSkuResponse)(nil), "goods.DelSkuResponse")
+	proto.RegisterType((*ListSellerGoodsRequest)(nil), "goods.ListSellerGoodsRequest")
+	proto.RegisterType((*ListSellerGoodsResponse)(nil), "goods.ListSellerGoodsResponse")
+	proto.RegisterType((*PriceRecord)(nil), "goods.PriceRecord")
+	proto.RegisterType((*PriceSchedule)(nil), "goods.PriceSchedule")
+	proto.RegisterType((*SchedulePriceChangeRequest)(nil), "goods.SchedulePriceChangeRequest")
+	proto.RegisterType((*SchedulePriceChangeResponse)(nil), "goods.SchedulePriceChangeResponse")
+	proto.RegisterType((*CancelPriceChangeRequest)(nil), "goods.CancelPriceChangeRequest")
+	proto.RegisterType((*CancelPriceChangeResponse)(nil), "goods.CancelPriceChangeResponse")
+	proto.RegisterType((*ListPriceSchedulesRequest)(nil), "goods.ListPriceSchedulesRequest")
+	proto.RegisterType((*ListPriceSchedulesResponse)(nil), "goods.ListPriceSchedulesResponse")
+	proto.RegisterType((*GetPriceAtRequest)(nil), "goods.GetPriceAtRequest")
+	proto.RegisterType((*GetPriceAtResponse)(nil), "goods.GetPriceAtResponse")
+	proto.RegisterType((*GetPriceHistoryRequest)(nil), "goods.GetPriceHistoryRequest")
+	proto.RegisterType((*GetPriceHistoryResponse)(nil), "goods.GetPriceHistoryResponse")
+	proto.RegisterType((*StockLedgerRecord)(nil), "goods.StockLedgerRecord")
+	proto.RegisterType((*StockMismatch)(nil), "goods.StockMismatch")
+	proto.RegisterType((*AdjustStockRequest)(nil), "goods.AdjustStockRequest")
+	proto.RegisterType((*AdjustStockResponse)(nil), "goods.AdjustStockResponse")
+	proto.RegisterType((*ListStockLedgerRequest)(nil), "goods.ListStockLedgerRequest")
+	proto.RegisterType((*ListStockLedgerResponse)(nil), "goods.ListStockLedgerResponse")
+	proto.RegisterType((*ReconcileStockRequest)(nil), "goods.ReconcileStockRequest")
+	proto.RegisterType((*ReconcileStockResponse)(nil), "goods.ReconcileStockResponse")
+	proto.RegisterType((*SetLowStockThresholdRequest)(nil), "goods.SetLowStockThresholdRequest")
+	proto.RegisterType((*SetLowStockThresholdResponse)(nil), "goods.SetLowStockThresholdResponse")
+	proto.RegisterType((*SubscribeRestockRequest)(nil), "goods.SubscribeRestockRequest")
+	proto.RegisterType((*SubscribeRestockResponse)(nil), "goods.SubscribeRestockResponse")
+	proto.RegisterType((*UnsubscribeRestockRequest)(nil), "goods.UnsubscribeRestockRequest")
+	proto.RegisterType((*UnsubscribeRestockResponse)(nil), "goods.UnsubscribeRestockResponse")
+}
+
+func init() { proto.RegisterFile("goods.proto", fileDescriptor_a30593c5487368b0) }
+
+var fileDescriptor_a30593c5487368b0 = []byte{
+	// 2795 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x5b, 0x4f, 0x6f, 0x24, 0x47,
+	0x15, 0xa7, 0xa7, 0x67, 0x6c, 0xcf, 0xb3, 0xc7, 0xeb, 0x6d, 0xff, 0x6b, 0x97, 0xb3, 0xce, 0x6c,
+	0xb1, 0x1b, 0x39, 0x10, 0x25, 0x8a, 0x13, 0x94, 0x00, 0x02, 0xc5, 0x6b, 0x2b, 0x8e, 0x93, 0x5d,
+	0x58, 0x7a, 0x36, 0x28, 0x02, 0x09, 0xd4, 0xee, 0x29, 0xdb, 0x1d, 0xcf, 0x4c, 0x3b, 0xdd, 0x3d,
+	0xde, 0xb5, 0x56, 0xac, 0x10, 0x5c, 0x10, 0xe2, 0xc0, 0x89, 0x1b, 0x77, 0x2e, 0x08, 0x24, 0x6e,
+	0x7c, 0x04, 0x24, 0x0e, 0xdc, 0xf9, 0x30, 0xa8, 0xfe, 0x76, 0x75, 0x77, 0xf5, 0xcc, 0xb8, 0x37,
+	0x40, 0x4e, 0x9e, 0x57, 0xef, 0xd5, 0xab, 0x5f, 0xbd, 0xf7, 0xea, 0x75, 0xd5, 0xab, 0x32, 0x2c,
+	0x9e, 0x45, 0x51, 0x3f, 0x79, 0xf3, 0x32, 0x8e, 0xd2, 0xc8, 0x69, 0x31, 0x02, 0xff, 0x02, 0xda,
+	0x47, 0xf4, 0xc7, 0xf1, 0xe8, 0x34, 0x72, 0x1c, 0x68, 0x8e, 0xfc, 0x21, 0x71, 0xad, 0xae, 0xb5,
+	0xdb, 0xf6, 0xd8, 0x6f, 0x67, 0x0d, 0x5a, 0x97, 0x71, 0x18, 0x10, 0xb7, 0xd1, 0xb5, 0x76, 0x2d,
+	0x8f, 0x13, 0xb4, 0x35, 0x49, 0xa3, 0xe0, 0xc2, 0xb5, 0xbb, 0xd6, 0x6e, 0xcb, 0xe3, 0x84, 0x83,
+	0x60, 0x21, 0xf0, 0x53, 0x72, 0x16, 0xc5, 0xd7, 0x6e, 0xb3, 0x6b, 0xed, 0x76, 0x3c, 0x45, 0xd3,
+	0x1e, 0x27, 0xb1, 0x3f, 0xea, 0xbb, 0x2d, 0xa6, 0x9c, 0x13, 0xf8, 0xd7, 0x16, 0xdc, 0xda, 0xef,
+	0xf7, 0x19, 0x04, 0x8f, 0x7c, 0x31, 0x26, 0x49, 0xfa, 0x7f, 0x40, 0xf1, 0x13, 0x58, 0xc9, 0x40,
+	0x24, 0x97, 0xd1, 0x28, 0x21, 0x14, 0x45, 0x10, 0xf5, 0x39, 0x8a, 0x8e, 0xc7, 0x7e, 0x3b, 0x2e,
+	0xcc, 0xd3, 0xbf, 0x8f, 0x92, 0x33, 0x86, 0xa3, 0xed, 0x49, 0x92, 0x72, 0x98, 0x3d, 0x8f, 0x0f,
+	0x19, 0x96, 0xb6, 0x27, 0x49, 0x7c, 0x00, 0xb7, 0x8e, 0x48, 0x9a, 0x9b, 0xa0, 0x26, 0x6c, 0xe5,
+	0x84, 0x9d, 0x0d, 0x98, 0x3b, 0x8d, 0xa3, 0xe1, 0xe1, 0x03, 0xa6, 0x7f, 0xc1, 0x13, 0x14, 0xfe,
+	0xb7, 0x05, 0x2b, 0x99, 0x96, 0x5a, 0x08, 0xdf, 0x84, 0xf6, 0x99, 0x74, 0x34, 0xc3, 0xb8, 0xb8,
+	0xb7, 0xf2, 0x26, 0x0f, 0x08, 0x15, 0x00, 0x5e, 0x26, 0xe2, 0x7c, 0x03, 0x16, 0x92, 0x8b, 0xf1,
+	0x7e, 0x9a, 0xc6, 0x89, 0xdb, 0xec, 0xda, 0xbb, 0x8b, 0x7b, 0xcb, 0x42, 0xbc, 0xc7, 0x9b, 0x3d,
+	0xc5, 0x77, 0x30, 0x34, 0x93, 0x8b, 0x71, 0xe2, 0xb6, 0x8a, 0x72, 0x4c, 0x29, 0xe3, 0x51, 0xaf,
+	0x24, 0x64, 0x30, 0x20, 0xf1, 0xf1, 0xa1, 0x3b, 0xc7, 0xa0, 0x29, 0x1a, 0x7f, 0x17, 0xee, 0xc8,
+	0xd9, 0x3d, 0x0c, 0x93, 0xf4, 0xc1, 0xf5, 0x81, 0xf0, 0x97, 0xb4, 0x98, 0xee, 0x52, 0x2b, 0xef,
+	0x52, 0x7c, 0x09, 0x3b, 0x55, 0x9d, 0x6b, 0x19, 0xaa, 0x2b, 0xd6, 0xc9, 0xf1, 0x21, 0x55, 0xe7,
+	0xda, 0x5d, 0x7b, 0xb7, 0xed, 0xe9, 0x4d, 0xf8, 0xef, 0x0d, 0x70, 0x7a, 0xc4, 0x8f, 0x83, 0xf3,
+	0xa2, 0x5b, 0x2f, 0xc8, 0xf5, 0xd3, 0x28, 0xee, 0x4b, 0xb7, 0x0a, 0xd2, 0xd9, 0x01, 0x10, 0x70,
+	0x43, 0x92, 0xb8, 0x8d, 0xae, 0xbd, 0xdb, 0xf1, 0xb4, 0x16, 0xea, 0x76, 0x16, 0x88, 0x89, 0x18,
+	0x4d, 0x50, 0x74, 0xda, 0xc3, 0x70, 0xf4, 0x98, 0x05, 0x7e, 0x93, 0x05, 0xbe, 0xa2, 0x19, 0xcf,
+	0x7f, 0xc6, 0x79, 0x2d, 0xc1, 0x13, 0x34, 0x45, 0x12, 0x8e, 0x7a, 0x6c, 0x65, 0xcc, 0xb1, 0x38,
+	0x92, 0x24, 0x1d, 0x29, 0x89, 0xe2, 0xf4, 0xc1, 0xb5, 0x3b, 0xcf, 0x20, 0x0a, 0xca, 0x59, 0x01,
+	0xdb, 0x4f, 0x02, 0x77, 0x81, 0x49, 0xd3, 0x9f, 0x54, 0x32, 0x18, 0xc7, 0x49, 0x14, 0xbb, 0x6d,
+	0x2e, 0xc9, 0x29, 0x3a, 0x97, 0xd1, 0x78, 0xf8, 0x98, 0xc4, 0x8f, 0xfd, 0x33, 0xe2, 0x42, 0xd7,
+	0xda, 0xb5, 0x3d, 0xad, 0x85, 0xf2, 0x9f, 0x86, 0xe9, 0xf9, 0x87, 0x7e, 0x40, 0xd2, 0xc4, 0x5d,
+	0x64, 0x0a, 0xb5, 0x16, 0xfc, 0x19, 0x2c, 0x31, 0xab, 0xf5, 0xc6, 0xc3, 0xa1, 0x1f, 0x5f, 0x4f,
+	0x58, 0x0c, 0xb9, 0x88, 0x6d, 0x4c, 0x8d, 0x58, 0xfc, 0x6d, 0x58, 0x64, 0x63, 0x3c, 0x18, 0x07,
+	0x17, 0x24, 0xa5, 0x4b, 0xfd, 0xca, 0x1f, 0x8c, 0x65, 0x1e, 0xe1, 0x04, 0x6d, 0x0d, 0xa2, 0xf1,
+	0x28, 0x65, 0x0a, 0x6d, 0x8f, 0x13, 0xf8, 0x13, 0x68, 0xb1, 0xae, 0x94, 0x7d, 0x1a, 0x92, 0x81,
+	0xf4, 0x20, 0x27, 0x9c, 0x37, 0x60, 0xfe, 0x84, 0x29, 0xe5, 0xce, 0x5b, 0xdc, 0x73, 0x04, 0x0e,
+	0x6d, 0x3c, 0x4f, 0x8a, 0xe0, 0x7f, 0x58, 0xb0, 0x9a, 0x0b, 0x8f, 0x5a, 0x61, 0xf8, 0x3a, 0xf0,
+	0x0c, 0xcd, 0x42, 0x62, 0x71, 0x6f, 0x55, 0x9f, 0xb9, 0xb0, 0x9d, 0xc7, 0x25, 0x28, 0xe8, 0x34,
+	0x4a, 0xfd, 0x01, 0x8b, 0x11, 0xdb, 0xe3, 0x04, 0x73, 0x14, 0x79, 0x96, 0x1e, 0x70, 0x27, 0xf2,
+	0x7c, 0xa7, 0xb5, 0x38, 0xf7, 0x60, 0xee, 0x94, 0x3b, 0x69, 0x8e, 0x8d, 0xb0, 0xa4, 0xcf, 0xc9,
+	0x13, 0x3c, 0x7c, 0x02, 0x1b, 0x8f, 0xa2, 0x7e, 0x78, 0x7a, 0x9d, 0x99, 0x7c, 0x6a, 0x16, 0xbb,
+	0xa9, 0xe3, 0x8e, 0x60, 0xb3, 0x34, 0x46, 0x1d, 0x9b, 0xe1, 0x6f, 0xc2, 0xad, 0x43, 0x32, 0x98,
+	0x2d, 0xd7, 0xe2, 0x0f, 0x60, 0x25, 0x13, 0xae, 0x35, 0xdc, 0x5b, 0xb0, 0xea, 0x91, 0x24, 0x8d,
+	0x62, 0x32, 0xe3, 0x90, 0x87, 0xb0, 0x96, 0xef, 0x50, 0x6b, 0xd8, 0xdf, 0x59, 0xe0, 0x1c, 0x92,
+	0xfe, 0x38, 0x48, 0xd9, 0x9a, 0x9e, 0xee, 0x0f, 0x17, 0xe6, 0xa3, 0xb8, 0xcf, 0x32, 0xaf, 0x50,
+	0x25, 0x48, 0xf6, 0x59, 0xf5, 0xaf, 0xd5, 0x47, 0x8b, 0x13, 0x74, 0xe9, 0x8f, 0xc6, 0xc3, 0x13,
+	0x12, 0x8b, 0xcf, 0xa7, 0xa0, 0xa8, 0x74, 0x72, 0x31, 0x3e, 0x3e, 0x94, 0x1f, 0x4f, 0x46, 0xe0,
+	0x03, 0x58, 0xcd, 0xa1, 0xa9, 0x35, 0xa7, 0xb1, 0x32, 0xe5, 0x7f, 0x69, 0x4e, 0x0a, 0x7b, 0x53,
+	0xc7, 0x9e, 0x39, 0xe4, 0x65, 0xc0, 0xff, 0xd1, 0x62, 0xe8, 0x49, 0x7c, 0xf5, 0xf2, 0xe8, 0x33,
+	0xdb, 0xdb, 0x39, 0xdb, 0xdf, 0x83, 0x0e, 0x79, 0x76, 0x19, 0xc6, 0xa4, 0x47, 0x82, 0x88, 0x7e,
+	0x29, 0xf8, 0x5a, 0xcf, 0x37, 0x56, 0x78, 0xe8, 0xb7, 0x16, 0xac, 0xe5, 0xf1, 0xd5, 0xca, 0x48,
+	0xf7, 0xa0, 0x13, 0x33, 0x2d, 0x7e, 0x1a, 0x46, 0x23, 0x65, 0xe0, 0x7c, 0x23, 0x4d, 0x3b, 0x1c,
+	0xd3, 0x93, 0x70, 0x48, 0x04, 0x4a, 0xad, 0x05, 0xf7, 0xa0, 0xcd, 0x40, 0x1c, 0xa7, 0x64, 0x38,
+	0x79, 0x27, 0x24, 0xec, 0xd0, 0x30, 0xc7, 0xa0, 0xad, 0xcf, 0xf0, 0x45, 0x7e, 0x82, 0xfa, 0x52,
+	0x94, 0x76, 0xb6, 0xf2, 0x76, 0x7e, 0x0d, 0x5a, 0x61, 0x4a, 0x86, 0x32, 0xa1, 0xcb, 0xfc, 0xa4,
+	0xa0, 0x79, 0x9c, 0x5d, 0xb6, 0xbb, 0x6d, 0xb0, 0x3b, 0xfe, 0xb3, 0x05, 0xeb, 0x05, 0x00, 0xb5,
+	0x4c, 0x9c, 0x37, 0x9e, 0x5d, 0x34, 0x1e, 0x45, 0x73, 0xea, 0x87, 0x03, 0xc2, 0xf7, 0xaa, 0x2a,
+	0x9a, 0xf3, 0x8d, 0x74, 0x07, 0xc3, 0x1b, 0x7a, 0x5a, 0x2c, 0xe8, 0x4d, 0xf8, 0x39, 0x6c, 0x1d,
+	0x44, 0xa3, 0xd3, 0x30, 0x1e, 0x7a, 0x99, 0xf3, 0xfe, 0x57, 0x8b, 0xee, 0x63, 0x40, 0xa6, 0xc1,
+	0x6b, 0x2d, 0x3d, 0x02, 0x5b, 0x1e, 0x19, 0x10, 0x3f, 0x21, 0x5f, 0xde, 0x44, 0x0c, 0xf1, 0xf5,
+	0x31, 0x20, 0xd3, 0x30, 0xb5, 0x20, 0xff, 0xc6, 0x82, 0x25, 0xb9, 0x45, 0x65, 0x3b, 0xed, 0x6c,
+	0x77, 0x78, 0x2d, 0x90, 0x66, 0xbb, 0x43, 0x6a, 0x45, 0x04, 0x0b, 0x97, 0x7e, 0x4c, 0x46, 0xa9,
+	0x40, 0xdb, 0xf1, 0x14, 0xad, 0xce, 0x4a, 0x76, 0xfe, 0xac, 0x34, 0x20, 0x57, 0x64, 0x20, 0xb2,
+	0x37, 0x27, 0xa8, 0x24, 0xdd, 0xeb, 0xb1, 0x68, 0x68, 0x79, 0xec, 0x37, 0x7e, 0x96, 0x21, 0xf9,
+	0x01, 0x05, 0xfd, 0x1e, 0x2c, 0x05, 0x1a, 0x32, 0x86, 0x25, 0xdb, 0x7a, 0xe8, 0xa0, 0xbd, 0x9c,
+	0xa0, 0xf3, 0x16, 0x2c, 0x04, 0xe7, 0xe1, 0xa0, 0x1f, 0x93, 0x91, 0x58, 0x50, 0xc5, 0x4e, 0x54,
+	0xbf, 0xa7, 0x84, 0xf0, 0x67, 0xe0, 0xec, 0xf7, 0xfb, 0x86, 0x6d, 0xbe, 0x9a, 0xa9, 0x55, 0x31,
+	0xd3, 0x86, 0x36, 0x53, 0x39, 0x27, 0x5b, 0x9b, 0x53, 0x00, 0xab, 0x39, 0xcd, 0x75, 0xd7, 0xa1,
+	0xe6, 0x12, 0xbb, 0xe8, 0x12, 0xfc, 0x2e, 0x38, 0x47, 0x24, 0x2d, 0xc2, 0x9f, 0xe2, 0x48, 0xfc,
+	0x17, 0x0b, 0x56, 0x73, 0xdd, 0x6a, 0x61, 0x2b, 0x3a, 0xc9, 0x9e, 0xd5, 0x49, 0x6f, 0x43, 0xdb,
+	0x1f, 0x05, 0xec, 0x73, 0x27, 0x8f, 0x74, 0xc6, 0x5e, 0x99, 0x14, 0x7e, 0x07, 0xd6, 0xe9, 0x89,
+	0xe7, 0x40, 0x1d, 0x55, 0x66, 0xf0, 0x14, 0x7e, 0x0e, 0x1b, 0xc5, 0x4e, 0xb5, 0x26, 0xfa, 0x4e,
+	0xee, 0xd4, 0x64, 0x57, 0x03, 0xd6, 0xc4, 0xf0, 0xfb, 0xb0, 0xa1, 0x99, 0xf8, 0x49, 0x4c, 0xc8,
+	0xac, 0xde, 0x89, 0x61, 0xb3, 0xd4, 0xb3, 0xee, 0xce, 0x7d, 0x14, 0xf5, 0x2b, 0x21, 0xb3, 0x95,
+	0xc0, 0x25, 0xf0, 0xcf, 0x61, 0x9d, 0xef, 0x7c, 0x6f, 0x18, 0x4a, 0x33, 0xaf, 0x86, 0x0f, 0x61,
+	0xa3, 0x38, 0x40, 0xad, 0xa4, 0xf5, 0x23, 0x58, 0x7d, 0x14, 0x5d, 0x91, 0x9b, 0xc2, 0x9c, 0x90,
+	0xba, 0xe8, 0xde, 0x2b, 0xaf, 0xb2, 0x16, 0xb0, 0x77, 0xe9, 0x5e, 0x78, 0x70, 0xd3, 0x95, 0xc8,
+	0xf6, 0xac, 0x83, 0x97, 0x1c, 0xfa, 0x19, 0xec, 0xd0, 0x38, 0x67, 0x5f, 0xdd, 0x07, 0xd7, 0xf9,
+	0xc0, 0x99, 0xcd, 0x3c, 0xd9, 0x19, 0xbb, 0x31, 0xe1, 0x8c, 0x6d, 0x17, 0xcf, 0xd8, 0xf8, 0xaf,
+	0x16, 0xbc, 0x5a, 0x39, 0xf4, 0x57, 0xf2, 0xb4, 0x89, 0xbf, 0x05, 0xf3, 0xa2, 0x6e, 0x64, 0xac,
+	0xef, 0x6d, 0xc0, 0x1c, 0x3b, 0x9f, 0xf3, 0xfd, 0x58, 0xdb, 0x13, 0x14, 0x7e, 0x1f, 0x96, 0x44,
+	0xb7, 0x1f, 0xd3, 0x86, 0xaa, 0xda, 0x20, 0x93, 0x16, 0x73, 0xe2, 0x04, 0xfe, 0xbd, 0xc5, 0x46,
+	0x64, 0x99, 0x4f, 0x7d, 0xd4, 0x2d, 0xed, 0xa3, 0xae, 0x6f, 0x0f, 0x1a, 0xf9, 0xed, 0xc1, 0xeb,
+	0xd0, 0xf2, 0x59, 0xe1, 0x2b, 0x6f, 0x0d, 0x1d, 0x89, 0xc7, 0x25, 0xb2, 0xc2, 0x64, 0xd3, 0x58,
+	0x98, 0x6c, 0x69, 0x85, 0x49, 0xfc, 0x84, 0x96, 0x8d, 0x52, 0xa1, 0x65, 0x86, 0x6a, 0xe0, 0x3d,
+	0x09, 0xa3, 0x61, 0xac, 0xbf, 0x71, 0x26, 0x8d, 0xe5, 0x9c, 0xd6, 0x5a, 0xb1, 0xfc, 0x4b, 0x0b,
+	0x3a, 0xfb, 0x7d, 0xba, 0x3b, 0x9c, 0x0e, 0xeb, 0xf5, 0x3c, 0xac, 0x99, 0xac, 0x63, 0x1b, 0xad,
+	0xd3, 0xcc, 0x5b, 0x67, 0x59, 0x22, 0xa8, 0x15, 0xc2, 0xe6, 0x9d, 0xdb, 0x7d, 0xe8, 0x1c, 0x91,
+	0x54, 0x9b, 0x97, 0x31, 0x16, 0xf0, 0x39, 0x2c, 0x4b, 0xb1, 0x5a, 0x83, 0xef, 0xc2, 0x7c, 0xc2,
+	0x83, 0x4d, 0x7c, 0x8f, 0x8b, 0x45, 0x50, 0xc9, 0xa6, 0x35, 0x0a, 0xba, 0x74, 0x7b, 0x17, 0xe3,
+	0x19, 0x0a, 0x06, 0x7d, 0x58, 0xc9, 0x84, 0x6b, 0x01, 0x93, 0xa5, 0x59, 0xbb, 0xba, 0x34, 0x8b,
+	0x9f, 0xc0, 0x0a, 0xff, 0x48, 0x4c, 0x33, 0xd3, 0x4d, 0xca, 0xf0, 0x78, 0x1f, 0x6e, 0x6b, 0x5a,
+	0x6b, 0x45, 0xe5, 0x7d, 0xe8, 0x1c, 0x92, 0xc1, 0x54, 0xe7, 0x7d, 0x1f, 0x96, 0xa5, 0x58, 0xad,
+	0x61, 0x1e, 0xf3, 0x0d, 0x4b, 0x8f, 0x95, 0xa3, 0x73, 0xa5, 0x9c, 0x2c, 0x41, 0x5b, 0x13, 0x12,
+	0x74, 0xa3, 0x94, 0xa0, 0xff, 0x64, 0xc1, 0x66, 0x49, 0xe5, 0x57, 0x33, 0x31, 0xff, 0xd3, 0x82,
+	0x45, 0x56, 0x35, 0xf6, 0x48, 0x40, 0x6b, 0xd5, 0xd5, 0xeb, 0x5e, 0x19, 0xbf, 0x61, 0x0c, 0x89,
+	0xdc, 0x12, 0x47, 0xb0, 0x10, 0x0d, 0xfa, 0xb9, 0xca, 0xb5, 0xa4, 0xd9, 0x91, 0xfa, 0xf4, 0x94,
+	0x04, 0x69, 0x78, 0xc5, 0xcf, 0xb9, 0x2d, 0x71, 0xa4, 0xd6, 0x1b, 0x79, 0xa5, 0x7a, 0x1c, 0x07,
+	0x84, 0x95, 0xb0, 0x3b, 0x9e, 0xa0, 0xe8, 0x7c, 0x92, 0xe0, 0x9c, 0xf4, 0xc7, 0x03, 0x72, 0x7c,
+	0x28, 0xaa, 0xd8, 0x5a, 0x0b, 0xfe, 0x9b, 0x05, 0x1d, 0x36, 0x4e, 0x4f, 0xb4, 0x15, 0x7a, 0x58,
+	0xc5, 0x1e, 0x13, 0xbe, 0x03, 0xc6, 0x94, 0x52, 0x91, 0xf2, 0x67, 0x9f, 0x55, 0xea, 0xa7, 0xe3,
+	0x44, 0xcd, 0x8a, 0x51, 0xf4, 0x50, 0x88, 0x24, 0x60, 0x86, 0xfe, 0xe0, 0xdc, 0x1f, 0x9d, 0x91,
+	0xe9, 0xc9, 0xf8, 0x26, 0x4e, 0x29, 0x41, 0x6c, 0x1a, 0x20, 0xe2, 0x0b, 0xd8, 0x36, 0x22, 0xa9,
+	0x7b, 0x90, 0xd2, 0x6c, 0x6f, 0x97, 0xbc, 0xf5, 0x1d, 0x70, 0x0f, 0xe8, 0x71, 0x63, 0x60, 0x98,
+	0xf4, 0x14, 0xbf, 0xe1, 0x63, 0xd8, 0x32, 0xf4, 0xad, 0xb9, 0xbd, 0xdd, 0xa2, 0xcb, 0x35, 0x17,
+	0x37, 0x33, 0x7c, 0xa0, 0xe9, 0x9d, 0x16, 0xf3, 0x9f, 0xba, 0xd5, 0x51, 0x34, 0x7e, 0x01, 0xc8,
+	0xa4, 0xb2, 0x96, 0x15, 0xf7, 0xa0, 0x2d, 0xe7, 0x2d, 0x13, 0xc1, 0x9a, 0x48, 0x04, 0x39, 0xfd,
+	0x5e, 0x26, 0x86, 0x7d, 0xb8, 0x7d, 0x44, 0xf8, 0xf0, 0xfb, 0x69, 0xdd, 0x38, 0x7a, 0x05, 0xda,
+	0x69, 0x38, 0x24, 0x49, 0xea, 0x0f, 0x2f, 0xc5, 0x3e, 0x34, 0x6b, 0xc0, 0x2f, 0xc0, 0xd1, 0x87,
+	0xa8, 0xfb, 0xd5, 0xae, 0x1d, 0xa9, 0x7f, 0xb0, 0x60, 0x43, 0x02, 0xf8, 0x28, 0x4c, 0x52, 0xed,
+	0x00, 0x50, 0x63, 0xa2, 0x49, 0xea, 0xc7, 0xa9, 0x56, 0x77, 0xcb, 0x1a, 0xa8, 0x36, 0x32, 0xea,
+	0x6b, 0x40, 0x24, 0xc9, 0x6a, 0x2d, 0xe1, 0x30, 0x4c, 0xc5, 0x6a, 0xe7, 0x04, 0x1e, 0xc3, 0x66,
+	0x09, 0x57, 0x2d, 0xeb, 0xbc, 0x01, 0xf3, 0x31, 0x4b, 0xcb, 0xd2, 0xed, 0x8e, 0xee, 0x76, 0x9e,
+	0xb1, 0x3d, 0x29, 0x82, 0xff, 0x65, 0xc1, 0x6d, 0x56, 0x7e, 0x7c, 0x48, 0xfa, 0x67, 0x24, 0xe6,
+	0x6c, 0x67, 0x19, 0x1a, 0x21, 0xbf, 0xcf, 0xb2, 0xbd, 0x46, 0xd8, 0xbf, 0x71, 0xba, 0x73, 0xa0,
+	0x99, 0x5e, 0x5f, 0x12, 0x51, 0x4d, 0x62, 0xbf, 0x69, 0xe0, 0x7f, 0x31, 0xf6, 0x47, 0x69, 0x98,
+	0x5e, 0x8b, 0x2d, 0xae, 0xa2, 0xa9, 0x96, 0x98, 0x9c, 0xaa, 0x5b, 0x5e, 0x4e, 0xd0, 0xc4, 0x17,
+	0x13, 0x3f, 0x89, 0x46, 0xf2, 0xe2, 0x91, 0x53, 0xec, 0x88, 0xc4, 0x56, 0x2e, 0xb3, 0xee, 0x02,
+	0xff, 0x92, 0x66, 0x2d, 0xf8, 0x29, 0x74, 0xd8, 0x94, 0x1e, 0x85, 0xc9, 0xd0, 0x4f, 0x83, 0xf3,
+	0x3a, 0xa9, 0xd0, 0xf0, 0x46, 0xa0, 0x0b, 0x8b, 0x03, 0x66, 0xa4, 0x9e, 0xda, 0x88, 0xda, 0x9e,
+	0xde, 0x44, 0x3f, 0xe1, 0xce, 0x7e, 0xff, 0xf3, 0x71, 0x32, 0xeb, 0x2d, 0x8b, 0x79, 0x78, 0xdd,
+	0x52, 0x76, 0xc1, 0x52, 0x99, 0x4d, 0x9a, 0x39, 0x9b, 0x28, 0x0b, 0xb6, 0x74, 0x0b, 0xba, 0x2c,
+	0x16, 0x48, 0x78, 0x45, 0xe4, 0xa5, 0xae, 0x20, 0xe9, 0x09, 0x20, 0x87, 0xb4, 0x56, 0x0a, 0xbc,
+	0x12, 0x9b, 0x20, 0x3d, 0x7e, 0xea, 0x4d, 0x79, 0x03, 0xe6, 0x06, 0x7e, 0x92, 0x8a, 0x38, 0xb2,
+	0x3d, 0x41, 0x65, 0x6b, 0xa5, 0xa9, 0xaf, 0x95, 0xe7, 0xb0, 0x59, 0x1a, 0xb7, 0x66, 0x92, 0x2c,
+	0xac, 0x15, 0x57, 0xaf, 0xe9, 0xeb, 0x4b, 0x22, 0x5b, 0x31, 0x6f, 0xd3, 0xb2, 0x7d, 0x10, 0x8d,
+	0x82, 0x70, 0x30, 0xe3, 0xd5, 0x0d, 0x4b, 0x3a, 0xc5, 0x3e, 0x75, 0x9f, 0x8c, 0x04, 0xe7, 0x24,
+	0xb8, 0x20, 0x7d, 0x61, 0x27, 0x49, 0x3a, 0xef, 0x02, 0x0c, 0x45, 0xb8, 0x13, 0x59, 0xa9, 0x5b,
+	0xd3, 0x27, 0x23, 0x17, 0x83, 0xa7, 0xc9, 0xe1, 0x4f, 0x61, 0xbb, 0x47, 0xd2, 0x87, 0xd1, 0x53,
+	0x26, 0xf2, 0xe4, 0x3c, 0x26, 0xc9, 0x79, 0x34, 0xe8, 0x4f, 0xf7, 0x22, 0x4d, 0xf2, 0x52, 0x9a,
+	0x81, 0x6c, 0x79, 0x59, 0x03, 0x7e, 0x08, 0xaf, 0x98, 0xd5, 0xd6, 0x8a, 0xb2, 0x4f, 0x60, 0xb3,
+	0x37, 0x3e, 0x49, 0x82, 0x38, 0x3c, 0x21, 0xec, 0xe6, 0x6d, 0x96, 0x95, 0xb5, 0x01, 0x73, 0xe3,
+	0x44, 0x2b, 0xd6, 0x0b, 0x0a, 0x7f, 0x04, 0x6e, 0x59, 0x59, 0x2d, 0x58, 0x8f, 0x60, 0xeb, 0xd3,
+	0x51, 0xf2, 0xa5, 0x01, 0xfb, 0x18, 0x90, 0x49, 0x5d, 0x1d, 0x68, 0x7b, 0xbf, 0x72, 0xe5, 0x83,
+	0x09, 0x12, 0x5f, 0xd1, 0x6f, 0xe3, 0xf7, 0x60, 0x41, 0x3e, 0x56, 0x72, 0x36, 0x44, 0x54, 0x14,
+	0x9e, 0x50, 0xa1, 0xcd, 0x52, 0x3b, 0x1f, 0x1b, 0x7f, 0x8d, 0x76, 0x97, 0xcf, 0x65, 0x54, 0xf7,
+	0xc2, 0x03, 0x25, 0xb4, 0x59, 0x6a, 0x57, 0xdd, 0xcf, 0x60, 0x43, 0xb6, 0xe6, 0x5f, 0xdb, 0x38,
+	0xf7, 0x0a, 0x9d, 0x8c, 0x2f, 0x79, 0xd0, 0xfd, 0x29, 0x52, 0x6a, 0xa0, 0x0f, 0x61, 0x51, 0x7b,
+	0x44, 0xe1, 0x6c, 0xc9, 0xf8, 0x2f, 0xbd, 0xbb, 0x41, 0xc8, 0xc4, 0x52, 0x7a, 0x3c, 0xb8, 0x55,
+	0x78, 0x5c, 0xe0, 0xdc, 0x11, 0x1d, 0xcc, 0x0f, 0x1b, 0xd0, 0x4e, 0x15, 0x5b, 0xb7, 0xa1, 0x7c,
+	0x3a, 0xa0, 0x6c, 0x58, 0x78, 0x78, 0x80, 0x36, 0x4b, 0xed, 0xaa, 0xfb, 0x31, 0x2c, 0xe9, 0xcf,
+	0x00, 0x1c, 0x39, 0x01, 0xc3, 0x63, 0x02, 0xb4, 0x6d, 0xe4, 0xe9, 0x56, 0xd2, 0x2e, 0xdf, 0x95,
+	0x95, 0xca, 0xcf, 0x03, 0x10, 0x32, 0xb1, 0x0c, 0x90, 0xb8, 0xa2, 0x02, 0xa4, 0x9c, 0xa6, 0x6d,
+	0x23, 0xaf, 0xa0, 0x4a, 0x5d, 0x85, 0xea, 0xaa, 0x8a, 0x37, 0xe4, 0x68, 0xdb, 0xc8, 0x53, 0xaa,
+	0x1e, 0x42, 0x47, 0xe7, 0x24, 0x8e, 0x49, 0x5e, 0x99, 0xea, 0x15, 0x33, 0x53, 0x69, 0xfb, 0x29,
+	0x38, 0xe5, 0x7b, 0x47, 0xa7, 0x2b, 0x7a, 0x55, 0xde, 0x87, 0xa2, 0xbb, 0x13, 0x24, 0x74, 0xe5,
+	0xe5, 0x1b, 0x42, 0xa5, 0xbc, 0xf2, 0x8e, 0x12, 0xdd, 0x9d, 0x20, 0xa1, 0x7b, 0x59, 0xbb, 0xd3,
+	0x52, 0x5e, 0x2e, 0xdf, 0xa0, 0x21, 0x64, 0x62, 0xe9, 0x7a, 0xb4, 0x2b, 0x0e, 0xa5, 0xa7, 0x7c,
+	0x95, 0x85, 0x90, 0x89, 0xa5, 0xf4, 0xfc, 0x10, 0x96, 0xf3, 0x37, 0x3c, 0x8e, 0xb4, 0xbd, 0xf1,
+	0xb6, 0x08, 0xdd, 0xa9, 0xe0, 0xea, 0x8b, 0xb4, 0x70, 0xf7, 0xa2, 0x16, 0xa9, 0xf9, 0x36, 0x07,
+	0xed, 0x54, 0xb1, 0x75, 0x90, 0xf9, 0xab, 0x0f, 0x05, 0xd2, 0x78, 0xe5, 0x82, 0xee, 0x54, 0x70,
+	0xf5, 0xc0, 0xd6, 0x2f, 0x2c, 0x54, 0x60, 0x1b, 0x2e, 0x46, 0xd0, 0xb6, 0x91, 0x97, 0x5f, 0xb6,
+	0x83, 0x92, 0x23, 0xca, 0x37, 0x19, 0x08, 0x99, 0x58, 0x4a, 0xcf, 0xe7, 0x7c, 0xf3, 0x64, 0xb8,
+	0x07, 0x70, 0xee, 0x6b, 0x36, 0xaf, 0xbe, 0xa2, 0x40, 0xaf, 0x4d, 0x13, 0xcb, 0x27, 0x64, 0x55,
+	0x67, 0xd6, 0x12, 0x72, 0xb1, 0xa2, 0x8d, 0x90, 0x89, 0xa5, 0xf4, 0xbc, 0x07, 0x73, 0xbc, 0xce,
+	0xeb, 0xac, 0x65, 0xc1, 0x9a, 0xd5, 0xf8, 0xd0, 0x7a, 0xa1, 0x55, 0xef, 0xc8, 0x6b, 0xb4, 0xaa,
+	0x63, 0xae, 0xb2, 0x8b, 0xd6, 0x0b, 0xad, 0x7a, 0xba, 0x96, 0x55, 0x54, 0x95, 0xae, 0x0b, 0x35,
+	0x58, 0xb4, 0x59, 0x6a, 0x57, 0xdd, 0x3f, 0x80, 0xb6, 0x2a, 0x64, 0x3a, 0x9b, 0xb9, 0x28, 0xd1,
+	0x46, 0x77, 0xcb, 0x0c, 0x1d, 0x39, 0x2f, 0x50, 0x2a, 0xe4, 0xb9, 0xb2, 0x26, 0x5a, 0x2f, 0xb4,
+	0xea, 0xeb, 0xa2, 0x50, 0x46, 0x74, 0xf4, 0xb5, 0x54, 0xae, 0x58, 0xa2, 0x9d, 0x2a, 0xb6, 0xd2,
+	0xf9, 0x33, 0x58, 0x35, 0xd4, 0x77, 0x1c, 0x99, 0x88, 0xaa, 0xab, 0x50, 0x08, 0x4f, 0x12, 0x51,
+	0xfa, 0x3f, 0x83, 0xdb, 0xa5, 0xb2, 0x8c, 0xf3, 0xaa, 0xba, 0x04, 0x35, 0x17, 0x7b, 0x50, 0xb7,
+	0x5a, 0x40, 0xcf, 0xb1, 0xe5, 0x92, 0x8a, 0xca, 0xb1, 0x95, 0x05, 0x1c, 0x74, 0x77, 0x82, 0x84,
+	0x52, 0x7e, 0x00, 0x90, 0x15, 0x33, 0x1c, 0x37, 0x8b, 0xa5, 0x7c, 0x09, 0x05, 0x6d, 0x19, 0x38,
+	0x85, 0x3c, 0xa6, 0x1f, 0xfc, 0xf5, 0x3c, 0x66, 0x28, 0x54, 0xa0, 0x9d, 0x2a, 0x76, 0x3e, 0xf9,
+	0xab, 0xd3, 0x9d, 0x96, 0xfc, 0x8b, 0x67, 0x53, 0x84, 0x4c, 0xac, 0x52, 0x2c, 0x65, 0xa7, 0xa1,
+	0x7c, 0x2c, 0x95, 0x0e, 0x7e, 0x68, 0xa7, 0x8a, 0xad, 0xe7, 0xd8, 0xfc, 0x59, 0xc8, 0xc9, 0x3e,
+	0xc2, 0x86, 0x63, 0x15, 0xba, 0x53, 0xc1, 0x55, 0x0a, 0x7d, 0x58, 0x33, 0x9d, 0x36, 0x1c, 0x9c,
+	0xa5, 0x94, 0xaa, 0x13, 0x0e, 0xfa, 0xfa, 0x44, 0x19, 0x35, 0xc4, 0xa7, 0xb0, 0x52, 0x3c, 0x35,
+	0x38, 0x72, 0xa6, 0x15, 0x67, 0x13, 0xf4, 0x6a, 0x25, 0x5f, 0x0f, 0xce, 0xf2, 0x9e, 0x5f, 0x05,
+	0x67, 0xe5, 0xe9, 0x02, 0xdd, 0x9d, 0x20, 0x21, 0x95, 0x9f, 0xcc, 0xb1, 0xff, 0xd9, 0x78, 0xe7,
+	0x3f, 0x03, 0x00, 0x0e, 0x6a, 0x0c, 0x36, 0xc2, 0x31, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GoodsServiceClient is the client API for GoodsService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GoodsServiceClient interface {
+	AddGoods(ctx context.Context, in *AddGoodsRequest, opts ...grpc.CallOption) (*AddGoodsResponse, error)
+	GetGoods(ctx context.Context, in *GetGoodsRequest, opts ...grpc.CallOption) (*GetGoodsResponse, error)
+	GetGoodsListByCategory(ctx context.Context, in *GetGoodsListByCategoryRequest, opts ...grpc.CallOption) (*GetGoodsListByCategoryResponse, error)
+	SearchGoods(ctx context.Context, in *SearchGoodsRequest, opts ...grpc.CallOption) (*SearchGoodsResponse, error)
+	ModifyGoodsInfo(ctx context.Context, in *ModifyGoodsInfoRequest, opts ...grpc.CallOption) (*ModifyGoodsInfoResponse, error)
+	DelGoods(ctx context.Context, in *DelGoodsRequest, opts ...grpc.CallOption) (*DelGoodsResponse, error)
+	RestoreGoods(ctx context.Context, in *RestoreGoodsRequest, opts ...grpc.CallOption) (*RestoreGoodsResponse, error)
+	DeductStock(ctx context.Context, in *DeductStockRequest, opts ...grpc.CallOption) (*DeductStockResponse, error)
+	RestoreStock(ctx context.Context, in *RestoreStockRequest, opts ...grpc.CallOption) (*RestoreStockResponse, error)
+	ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error)
+	ReserveStocks(ctx context.Context, in *ReserveStocksRequest, opts ...grpc.CallOption) (*ReserveStocksResponse, error)
+	ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*ConfirmReservationResponse, error)
+	ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error)
+	AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryResponse, error)
+	GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error)
+	ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error)
+	GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error)
+	ModifyCategory(ctx context.Context, in *ModifyCategoryRequest, opts ...grpc.CallOption) (*ModifyCategoryResponse, error)
+	MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error)
+	DelCategory(ctx context.Context, in *DelCategoryRequest, opts ...grpc.CallOption) (*DelCategoryResponse, error)
+	ListGoodsByCategoryTree(ctx context.Context, in *ListGoodsByCategoryTreeRequest, opts ...grpc.CallOption) (*ListGoodsByCategoryTreeResponse, error)
+	SetSkuAttrs(ctx context.Context, in *SetSkuAttrsRequest, opts ...grpc.CallOption) (*SetSkuAttrsResponse, error)
+	AddSku(ctx context.Context, in *AddSkuRequest, opts ...grpc.CallOption) (*AddSkuResponse, error)
+	GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*GetSkuResponse, error)
+	ListSkus(ctx context.Context, in *ListSkusRequest, opts ...grpc.CallOption) (*ListSkusResponse, error)
+	ModifySku(ctx context.Context, in *ModifySkuRequest, opts ...grpc.CallOption) (*ModifySkuResponse, error)
+	DelSku(ctx context.Context, in *DelSkuRequest, opts ...grpc.CallOption) (*DelSkuResponse, error)
+	ListSellerGoods(ctx context.Context, in *ListSellerGoodsRequest, opts ...grpc.CallOption) (*ListSellerGoodsResponse, error)
+	SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error)
+	CancelPriceChange(ctx context.Context, in *CancelPriceChangeRequest, opts ...grpc.CallOption) (*CancelPriceChangeResponse, error)
+	ListPriceSchedules(ctx context.Context, in *ListPriceSchedulesRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error)
+	GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*GetPriceAtResponse, error)
+	GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error)
+	AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error)
+	ListStockLedger(ctx context.Context, in *ListStockLedgerRequest, opts ...grpc.CallOption) (*ListStockLedgerResponse, error)
+	ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error)
+	SetLowStockThreshold(ctx context.Context, in *SetLowStockThresholdRequest, opts ...grpc.CallOption) (*SetLowStockThresholdResponse, error)
+	SubscribeRestock(ctx context.Context, in *SubscribeRestockRequest, opts ...grpc.CallOption) (*SubscribeRestockResponse, error)
+	UnsubscribeRestock(ctx context.Context, in *UnsubscribeRestockRequest, opts ...grpc.CallOption) (*UnsubscribeRestockResponse, error)
+}
+
+type goodsServiceClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGoodsServiceClient(cc *grpc.ClientConn) GoodsServiceClient {
+	return &goodsServiceClient{cc}
+}
+
+func (c *goodsServiceClient) AddGoods(ctx context.Context, in *AddGoodsRequest, opts ...grpc.CallOption) (*AddGoodsResponse, error) {
+	out := new(AddGoodsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/AddGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetGoods(ctx context.Context, in *GetGoodsRequest, opts ...grpc.CallOption) (*GetGoodsResponse, error) {
+	out := new(GetGoodsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetGoodsListByCategory(ctx context.Context, in *GetGoodsListByCategoryRequest, opts ...grpc.CallOption) (*GetGoodsListByCategoryResponse, error) {
+	out := new(GetGoodsListByCategoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetGoodsListByCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) SearchGoods(ctx context.Context, in *SearchGoodsRequest, opts ...grpc.CallOption) (*SearchGoodsResponse, error) {
+	out := new(SearchGoodsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/SearchGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ModifyGoodsInfo(ctx context.Context, in *ModifyGoodsInfoRequest, opts ...grpc.CallOption) (*ModifyGoodsInfoResponse, error) {
+	out := new(ModifyGoodsInfoResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ModifyGoodsInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) DelGoods(ctx context.Context, in *DelGoodsRequest, opts ...grpc.CallOption) (*DelGoodsResponse, error) {
+	out := new(DelGoodsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/DelGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) RestoreGoods(ctx context.Context, in *RestoreGoodsRequest, opts ...grpc.CallOption) (*RestoreGoodsResponse, error) {
+	out := new(RestoreGoodsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/RestoreGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) DeductStock(ctx context.Context, in *DeductStockRequest, opts ...grpc.CallOption) (*DeductStockResponse, error) {
+	out := new(DeductStockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/DeductStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) RestoreStock(ctx context.Context, in *RestoreStockRequest, opts ...grpc.CallOption) (*RestoreStockResponse, error) {
+	out := new(RestoreStockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/RestoreStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ReserveStock(ctx context.Context, in *ReserveStockRequest, opts ...grpc.CallOption) (*ReserveStockResponse, error) {
+	out := new(ReserveStockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ReserveStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ReserveStocks(ctx context.Context, in *ReserveStocksRequest, opts ...grpc.CallOption) (*ReserveStocksResponse, error) {
+	out := new(ReserveStocksResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ReserveStocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ConfirmReservation(ctx context.Context, in *ConfirmReservationRequest, opts ...grpc.CallOption) (*ConfirmReservationResponse, error) {
+	out := new(ConfirmReservationResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ConfirmReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ReleaseReservation(ctx context.Context, in *ReleaseReservationRequest, opts ...grpc.CallOption) (*ReleaseReservationResponse, error) {
+	out := new(ReleaseReservationResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ReleaseReservation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) AddCategory(ctx context.Context, in *AddCategoryRequest, opts ...grpc.CallOption) (*AddCategoryResponse, error) {
+	out := new(AddCategoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/AddCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetCategory(ctx context.Context, in *GetCategoryRequest, opts ...grpc.CallOption) (*GetCategoryResponse, error) {
+	out := new(GetCategoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ListCategories(ctx context.Context, in *ListCategoriesRequest, opts ...grpc.CallOption) (*ListCategoriesResponse, error) {
+	out := new(ListCategoriesResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ListCategories", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetCategoryTree(ctx context.Context, in *GetCategoryTreeRequest, opts ...grpc.CallOption) (*GetCategoryTreeResponse, error) {
+	out := new(GetCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetCategoryTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ModifyCategory(ctx context.Context, in *ModifyCategoryRequest, opts ...grpc.CallOption) (*ModifyCategoryResponse, error) {
+	out := new(ModifyCategoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ModifyCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) MoveCategory(ctx context.Context, in *MoveCategoryRequest, opts ...grpc.CallOption) (*MoveCategoryResponse, error) {
+	out := new(MoveCategoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/MoveCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) DelCategory(ctx context.Context, in *DelCategoryRequest, opts ...grpc.CallOption) (*DelCategoryResponse, error) {
+	out := new(DelCategoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/DelCategory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ListGoodsByCategoryTree(ctx context.Context, in *ListGoodsByCategoryTreeRequest, opts ...grpc.CallOption) (*ListGoodsByCategoryTreeResponse, error) {
+	out := new(ListGoodsByCategoryTreeResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ListGoodsByCategoryTree", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) SetSkuAttrs(ctx context.Context, in *SetSkuAttrsRequest, opts ...grpc.CallOption) (*SetSkuAttrsResponse, error) {
+	out := new(SetSkuAttrsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/SetSkuAttrs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) AddSku(ctx context.Context, in *AddSkuRequest, opts ...grpc.CallOption) (*AddSkuResponse, error) {
+	out := new(AddSkuResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/AddSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetSku(ctx context.Context, in *GetSkuRequest, opts ...grpc.CallOption) (*GetSkuResponse, error) {
+	out := new(GetSkuResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ListSkus(ctx context.Context, in *ListSkusRequest, opts ...grpc.CallOption) (*ListSkusResponse, error) {
+	out := new(ListSkusResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ListSkus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ModifySku(ctx context.Context, in *ModifySkuRequest, opts ...grpc.CallOption) (*ModifySkuResponse, error) {
+	out := new(ModifySkuResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ModifySku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) DelSku(ctx context.Context, in *DelSkuRequest, opts ...grpc.CallOption) (*DelSkuResponse, error) {
+	out := new(DelSkuResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/DelSku", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ListSellerGoods(ctx context.Context, in *ListSellerGoodsRequest, opts ...grpc.CallOption) (*ListSellerGoodsResponse, error) {
+	out := new(ListSellerGoodsResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ListSellerGoods", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) SchedulePriceChange(ctx context.Context, in *SchedulePriceChangeRequest, opts ...grpc.CallOption) (*SchedulePriceChangeResponse, error) {
+	out := new(SchedulePriceChangeResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/SchedulePriceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) CancelPriceChange(ctx context.Context, in *CancelPriceChangeRequest, opts ...grpc.CallOption) (*CancelPriceChangeResponse, error) {
+	out := new(CancelPriceChangeResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/CancelPriceChange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ListPriceSchedules(ctx context.Context, in *ListPriceSchedulesRequest, opts ...grpc.CallOption) (*ListPriceSchedulesResponse, error) {
+	out := new(ListPriceSchedulesResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ListPriceSchedules", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetPriceAt(ctx context.Context, in *GetPriceAtRequest, opts ...grpc.CallOption) (*GetPriceAtResponse, error) {
+	out := new(GetPriceAtResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetPriceAt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) GetPriceHistory(ctx context.Context, in *GetPriceHistoryRequest, opts ...grpc.CallOption) (*GetPriceHistoryResponse, error) {
+	out := new(GetPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) AdjustStock(ctx context.Context, in *AdjustStockRequest, opts ...grpc.CallOption) (*AdjustStockResponse, error) {
+	out := new(AdjustStockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/AdjustStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ListStockLedger(ctx context.Context, in *ListStockLedgerRequest, opts ...grpc.CallOption) (*ListStockLedgerResponse, error) {
+	out := new(ListStockLedgerResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ListStockLedger", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) ReconcileStock(ctx context.Context, in *ReconcileStockRequest, opts ...grpc.CallOption) (*ReconcileStockResponse, error) {
+	out := new(ReconcileStockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/ReconcileStock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) SetLowStockThreshold(ctx context.Context, in *SetLowStockThresholdRequest, opts ...grpc.CallOption) (*SetLowStockThresholdResponse, error) {
+	out := new(SetLowStockThresholdResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/SetLowStockThreshold", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) SubscribeRestock(ctx context.Context, in *SubscribeRestockRequest, opts ...grpc.CallOption) (*SubscribeRestockResponse, error) {
+	out := new(SubscribeRestockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/SubscribeRestock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goodsServiceClient) UnsubscribeRestock(ctx context.Context, in *UnsubscribeRestockRequest, opts ...grpc.CallOption) (*UnsubscribeRestockResponse, error) {
+	out := new(UnsubscribeRestockResponse)
+	err := c.cc.Invoke(ctx, "/goods.GoodsService/UnsubscribeRestock", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoodsServiceServer is the server API for GoodsService service.
+type GoodsServiceServer interface {
+	AddGoods(context.Context, *AddGoodsRequest) (*AddGoodsResponse, error)
+	GetGoods(context.Context, *GetGoodsRequest) (*GetGoodsResponse, error)
+	GetGoodsListByCategory(context.Context, *GetGoodsListByCategoryRequest) (*GetGoodsListByCategoryResponse, error)
+	SearchGoods(context.Context, *SearchGoodsRequest) (*SearchGoodsResponse, error)
+	ModifyGoodsInfo(context.Context, *ModifyGoodsInfoRequest) (*ModifyGoodsInfoResponse, error)
+	DelGoods(context.Context, *DelGoodsRequest) (*DelGoodsResponse, error)
+	RestoreGoods(context.Context, *RestoreGoodsRequest) (*RestoreGoodsResponse, error)
+	DeductStock(context.Context, *DeductStockRequest) (*DeductStockResponse, error)
+	RestoreStock(context.Context, *RestoreStockRequest) (*RestoreStockResponse, error)
+	ReserveStock(context.Context, *ReserveStockRequest) (*ReserveStockResponse, error)
+	ReserveStocks(context.Context, *ReserveStocksRequest) (*ReserveStocksResponse, error)
+	ConfirmReservation(context.Context, *ConfirmReservationRequest) (*ConfirmReservationResponse, error)
+	ReleaseReservation(context.Context, *ReleaseReservationRequest) (*ReleaseReservationResponse, error)
+	AddCategory(context.Context, *AddCategoryRequest) (*AddCategoryResponse, error)
+	GetCategory(context.Context, *GetCategoryRequest) (*GetCategoryResponse, error)
+	ListCategories(context.Context, *ListCategoriesRequest) (*ListCategoriesResponse, error)
+	GetCategoryTree(context.Context, *GetCategoryTreeRequest) (*GetCategoryTreeResponse, error)
+	ModifyCategory(context.Context, *ModifyCategoryRequest) (*ModifyCategoryResponse, error)
+	MoveCategory(context.Context, *MoveCategoryRequest) (*MoveCategoryResponse, error)
+	DelCategory(context.Context, *DelCategoryRequest) (*DelCategoryResponse, error)
+	ListGoodsByCategoryTree(context.Context, *ListGoodsByCategoryTreeRequest) (*ListGoodsByCategoryTreeResponse, error)
+	SetSkuAttrs(context.Context, *SetSkuAttrsRequest) (*SetSkuAttrsResponse, error)
+	AddSku(context.Context, *AddSkuRequest) (*AddSkuResponse, error)
+	GetSku(context.Context, *GetSkuRequest) (*GetSkuResponse, error)
+	ListSkus(context.Context, *ListSkusRequest) (*ListSkusResponse, error)
+	ModifySku(context.Context, *ModifySkuRequest) (*ModifySkuResponse, error)
+	DelSku(context.Context, *DelSkuRequest) (*DelSkuResponse, error)
+	ListSellerGoods(context.Context, *ListSellerGoodsRequest) (*ListSellerGoodsResponse, error)
+	SchedulePriceChange(context.Context, *SchedulePriceChangeRequest) (*SchedulePriceChangeResponse, error)
+	CancelPriceChange(context.Context, *CancelPriceChangeRequest) (*CancelPriceChangeResponse, error)
+	ListPriceSchedules(context.Context, *ListPriceSchedulesRequest) (*ListPriceSchedulesResponse, error)
+	GetPriceAt(context.Context, *GetPriceAtRequest) (*GetPriceAtResponse, error)
+	GetPriceHistory(context.Context, *GetPriceHistoryRequest) (*GetPriceHistoryResponse, error)
+	AdjustStock(context.Context, *AdjustStockRequest) (*AdjustStockResponse, error)
+	ListStockLedger(context.Context, *ListStockLedgerRequest) (*ListStockLedgerResponse, error)
+	ReconcileStock(context.Context, *ReconcileStockRequest) (*ReconcileStockResponse, error)
+	SetLowStockThreshold(context.Context, *SetLowStockThresholdRequest) (*SetLowStockThresholdResponse, error)
+	SubscribeRestock(context.Context, *SubscribeRestockRequest) (*SubscribeRestockResponse, error)
+	UnsubscribeRestock(context.Context, *UnsubscribeRestockRequest) (*UnsubscribeRestockResponse, error)
+}
+
+func RegisterGoodsServiceServer(s *grpc.Server, srv GoodsServiceServer) {
+	s.RegisterService(&_GoodsService_serviceDesc, srv)
+}
+
+func _GoodsService_AddGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).AddGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/AddGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).AddGoods(ctx, req.(*AddGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_GetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).GetGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/GetGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).GetGoods(ctx, req.(*GetGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_GetGoodsListByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetGoodsListByCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).GetGoodsListByCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/GetGoodsListByCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).GetGoodsListByCategory(ctx, req.(*GetGoodsListByCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_SearchGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).SearchGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/SearchGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).SearchGoods(ctx, req.(*SearchGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ModifyGoodsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyGoodsInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ModifyGoodsInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ModifyGoodsInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ModifyGoodsInfo(ctx, req.(*ModifyGoodsInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_DelGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).DelGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/DelGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).DelGoods(ctx, req.(*DelGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_RestoreGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).RestoreGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/RestoreGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).RestoreGoods(ctx, req.(*RestoreGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_DeductStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeductStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).DeductStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/DeductStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).DeductStock(ctx, req.(*DeductStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_RestoreStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).RestoreStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/RestoreStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).RestoreStock(ctx, req.(*RestoreStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ReserveStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ReserveStock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ReserveStock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ReserveStock(ctx, req.(*ReserveStockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ReserveStocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveStocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ReserveStocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ReserveStocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ReserveStocks(ctx, req.(*ReserveStocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ConfirmReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConfirmReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ConfirmReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ConfirmReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ConfirmReservation(ctx, req.(*ConfirmReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ReleaseReservation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseReservationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ReleaseReservation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ReleaseReservation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ReleaseReservation(ctx, req.(*ReleaseReservationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_AddCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).AddCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/AddCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).AddCategory(ctx, req.(*AddCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_GetCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).GetCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/GetCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).GetCategory(ctx, req.(*GetCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ListCategories_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListCategoriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ListCategories(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ListCategories",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ListCategories(ctx, req.(*ListCategoriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_GetCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).GetCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/GetCategoryTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).GetCategoryTree(ctx, req.(*GetCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ModifyCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ModifyCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ModifyCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ModifyCategory(ctx, req.(*ModifyCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_MoveCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).MoveCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/MoveCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).MoveCategory(ctx, req.(*MoveCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_DelCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelCategoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).DelCategory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/DelCategory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).DelCategory(ctx, req.(*DelCategoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_ListGoodsByCategoryTree_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListGoodsByCategoryTreeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ListGoodsByCategoryTree(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ListGoodsByCategoryTree",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ListGoodsByCategoryTree(ctx, req.(*ListGoodsByCategoryTreeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_SetSkuAttrs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetSkuAttrsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).SetSkuAttrs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/SetSkuAttrs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).SetSkuAttrs(ctx, req.(*SetSkuAttrsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-type goodsServiceClient struct {
-	cc *grpc.ClientConn
+func _GoodsService_AddSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).AddSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/AddSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).AddSku(ctx, req.(*AddSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func NewGoodsServiceClient(cc *grpc.ClientConn) GoodsServiceClient {
-	return &goodsServiceClient{cc}
+func _GoodsService_GetSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSkuRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).GetSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/GetSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).GetSku(ctx, req.(*GetSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goodsServiceClient) AddGoods(ctx context.Context, in *AddGoodsRequest, opts ...grpc.CallOption) (*AddGoodsResponse, error) {
-	out := new(AddGoodsResponse)
-	err := c.cc.Invoke(ctx, "/goods.GoodsService/AddGoods", in, out, opts...)
-	if err != nil {
+func _GoodsService_ListSkus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSkusRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ListSkus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ListSkus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ListSkus(ctx, req.(*ListSkusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goodsServiceClient) GetGoods(ctx context.Context, in *GetGoodsRequest, opts ...grpc.CallOption) (*GetGoodsResponse, error) {
-	out := new(GetGoodsResponse)
-	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetGoods", in, out, opts...)
-	if err != nil {
+func _GoodsService_ModifySku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifySkuRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ModifySku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ModifySku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ModifySku(ctx, req.(*ModifySkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goodsServiceClient) GetGoodsListByCategory(ctx context.Context, in *GetGoodsListByCategoryRequest, opts ...grpc.CallOption) (*GetGoodsListByCategoryResponse, error) {
-	out := new(GetGoodsListByCategoryResponse)
-	err := c.cc.Invoke(ctx, "/goods.GoodsService/GetGoodsListByCategory", in, out, opts...)
-	if err != nil {
+func _GoodsService_DelSku_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DelSkuRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).DelSku(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/DelSku",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).DelSku(ctx, req.(*DelSkuRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goodsServiceClient) ModifyGoodsInfo(ctx context.Context, in *ModifyGoodsInfoRequest, opts ...grpc.CallOption) (*ModifyGoodsInfoResponse, error) {
-	out := new(ModifyGoodsInfoResponse)
-	err := c.cc.Invoke(ctx, "/goods.GoodsService/ModifyGoodsInfo", in, out, opts...)
-	if err != nil {
+func _GoodsService_ListSellerGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSellerGoodsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ListSellerGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ListSellerGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ListSellerGoods(ctx, req.(*ListSellerGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goodsServiceClient) DelGoods(ctx context.Context, in *DelGoodsRequest, opts ...grpc.CallOption) (*DelGoodsResponse, error) {
-	out := new(DelGoodsResponse)
-	err := c.cc.Invoke(ctx, "/goods.GoodsService/DelGoods", in, out, opts...)
-	if err != nil {
+func _GoodsService_SchedulePriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SchedulePriceChangeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).SchedulePriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/SchedulePriceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).SchedulePriceChange(ctx, req.(*SchedulePriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (c *goodsServiceClient) DeductStock(ctx context.Context, in *DeductStockRequest, opts ...grpc.CallOption) (*DeductStockResponse, error) {
-	out := new(DeductStockResponse)
-	err := c.cc.Invoke(ctx, "/goods.GoodsService/DeductStock", in, out, opts...)
-	if err != nil {
+func _GoodsService_CancelPriceChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelPriceChangeRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return out, nil
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).CancelPriceChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/CancelPriceChange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).CancelPriceChange(ctx, req.(*CancelPriceChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-// GoodsServiceServer is the server API for GoodsService service.
-type GoodsServiceServer interface {
-	AddGoods(context.Context, *AddGoodsRequest) (*AddGoodsResponse, error)
-	GetGoods(context.Context, *GetGoodsRequest) (*GetGoodsResponse, error)
-	GetGoodsListByCategory(context.Context, *GetGoodsListByCategoryRequest) (*GetGoodsListByCategoryResponse, error)
-	ModifyGoodsInfo(context.Context, *ModifyGoodsInfoRequest) (*ModifyGoodsInfoResponse, error)
-	DelGoods(context.Context, *DelGoodsRequest) (*DelGoodsResponse, error)
-	DeductStock(context.Context, *DeductStockRequest) (*DeductStockResponse, error)
+func _GoodsService_ListPriceSchedules_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPriceSchedulesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).ListPriceSchedules(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/ListPriceSchedules",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).ListPriceSchedules(ctx, req.(*ListPriceSchedulesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoodsServiceServer(s *grpc.Server, srv GoodsServiceServer) {
-	s.RegisterService(&_GoodsService_serviceDesc, srv)
+func _GoodsService_GetPriceAt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceAtRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).GetPriceAt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/GetPriceAt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).GetPriceAt(ctx, req.(*GetPriceAtRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoodsService_AddGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddGoodsRequest)
+func _GoodsService_GetPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServiceServer).AddGoods(ctx, in)
+		return srv.(GoodsServiceServer).GetPriceHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/goods.GoodsService/AddGoods",
+		FullMethod: "/goods.GoodsService/GetPriceHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServiceServer).AddGoods(ctx, req.(*AddGoodsRequest))
+		return srv.(GoodsServiceServer).GetPriceHistory(ctx, req.(*GetPriceHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoodsService_GetGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGoodsRequest)
+func _GoodsService_AdjustStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdjustStockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServiceServer).GetGoods(ctx, in)
+		return srv.(GoodsServiceServer).AdjustStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/goods.GoodsService/GetGoods",
+		FullMethod: "/goods.GoodsService/AdjustStock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServiceServer).GetGoods(ctx, req.(*GetGoodsRequest))
+		return srv.(GoodsServiceServer).AdjustStock(ctx, req.(*AdjustStockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoodsService_GetGoodsListByCategory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetGoodsListByCategoryRequest)
+func _GoodsService_ListStockLedger_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListStockLedgerRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServiceServer).GetGoodsListByCategory(ctx, in)
+		return srv.(GoodsServiceServer).ListStockLedger(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/goods.GoodsService/GetGoodsListByCategory",
+		FullMethod: "/goods.GoodsService/ListStockLedger",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServiceServer).GetGoodsListByCategory(ctx, req.(*GetGoodsListByCategoryRequest))
+		return srv.(GoodsServiceServer).ListStockLedger(ctx, req.(*ListStockLedgerRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoodsService_ModifyGoodsInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyGoodsInfoRequest)
+func _GoodsService_ReconcileStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReconcileStockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServiceServer).ModifyGoodsInfo(ctx, in)
+		return srv.(GoodsServiceServer).ReconcileStock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/goods.GoodsService/ModifyGoodsInfo",
+		FullMethod: "/goods.GoodsService/ReconcileStock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServiceServer).ModifyGoodsInfo(ctx, req.(*ModifyGoodsInfoRequest))
+		return srv.(GoodsServiceServer).ReconcileStock(ctx, req.(*ReconcileStockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoodsService_DelGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DelGoodsRequest)
+func _GoodsService_SetLowStockThreshold_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLowStockThresholdRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServiceServer).DelGoods(ctx, in)
+		return srv.(GoodsServiceServer).SetLowStockThreshold(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/goods.GoodsService/DelGoods",
+		FullMethod: "/goods.GoodsService/SetLowStockThreshold",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServiceServer).DelGoods(ctx, req.(*DelGoodsRequest))
+		return srv.(GoodsServiceServer).SetLowStockThreshold(ctx, req.(*SetLowStockThresholdRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoodsService_DeductStock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(DeductStockRequest)
+func _GoodsService_SubscribeRestock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubscribeRestockRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoodsServiceServer).DeductStock(ctx, in)
+		return srv.(GoodsServiceServer).SubscribeRestock(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/goods.GoodsService/DeductStock",
+		FullMethod: "/goods.GoodsService/SubscribeRestock",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoodsServiceServer).DeductStock(ctx, req.(*DeductStockRequest))
+		return srv.(GoodsServiceServer).SubscribeRestock(ctx, req.(*SubscribeRestockRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoodsService_UnsubscribeRestock_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnsubscribeRestockRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoodsServiceServer).UnsubscribeRestock(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/goods.GoodsService/UnsubscribeRestock",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoodsServiceServer).UnsubscribeRestock(ctx, req.(*UnsubscribeRestockRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -971,6 +6551,10 @@ var _GoodsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetGoodsListByCategory",
 			Handler:    _GoodsService_GetGoodsListByCategory_Handler,
 		},
+		{
+			MethodName: "SearchGoods",
+			Handler:    _GoodsService_SearchGoods_Handler,
+		},
 		{
 			MethodName: "ModifyGoodsInfo",
 			Handler:    _GoodsService_ModifyGoodsInfo_Handler,
@@ -979,10 +6563,138 @@ var _GoodsService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelGoods",
 			Handler:    _GoodsService_DelGoods_Handler,
 		},
+		{
+			MethodName: "RestoreGoods",
+			Handler:    _GoodsService_RestoreGoods_Handler,
+		},
 		{
 			MethodName: "DeductStock",
 			Handler:    _GoodsService_DeductStock_Handler,
 		},
+		{
+			MethodName: "RestoreStock",
+			Handler:    _GoodsService_RestoreStock_Handler,
+		},
+		{
+			MethodName: "ReserveStock",
+			Handler:    _GoodsService_ReserveStock_Handler,
+		},
+		{
+			MethodName: "ReserveStocks",
+			Handler:    _GoodsService_ReserveStocks_Handler,
+		},
+		{
+			MethodName: "ConfirmReservation",
+			Handler:    _GoodsService_ConfirmReservation_Handler,
+		},
+		{
+			MethodName: "ReleaseReservation",
+			Handler:    _GoodsService_ReleaseReservation_Handler,
+		},
+		{
+			MethodName: "AddCategory",
+			Handler:    _GoodsService_AddCategory_Handler,
+		},
+		{
+			MethodName: "GetCategory",
+			Handler:    _GoodsService_GetCategory_Handler,
+		},
+		{
+			MethodName: "ListCategories",
+			Handler:    _GoodsService_ListCategories_Handler,
+		},
+		{
+			MethodName: "GetCategoryTree",
+			Handler:    _GoodsService_GetCategoryTree_Handler,
+		},
+		{
+			MethodName: "ModifyCategory",
+			Handler:    _GoodsService_ModifyCategory_Handler,
+		},
+		{
+			MethodName: "MoveCategory",
+			Handler:    _GoodsService_MoveCategory_Handler,
+		},
+		{
+			MethodName: "DelCategory",
+			Handler:    _GoodsService_DelCategory_Handler,
+		},
+		{
+			MethodName: "ListGoodsByCategoryTree",
+			Handler:    _GoodsService_ListGoodsByCategoryTree_Handler,
+		},
+		{
+			MethodName: "SetSkuAttrs",
+			Handler:    _GoodsService_SetSkuAttrs_Handler,
+		},
+		{
+			MethodName: "AddSku",
+			Handler:    _GoodsService_AddSku_Handler,
+		},
+		{
+			MethodName: "GetSku",
+			Handler:    _GoodsService_GetSku_Handler,
+		},
+		{
+			MethodName: "ListSkus",
+			Handler:    _GoodsService_ListSkus_Handler,
+		},
+		{
+			MethodName: "ModifySku",
+			Handler:    _GoodsService_ModifySku_Handler,
+		},
+		{
+			MethodName: "DelSku",
+			Handler:    _GoodsService_DelSku_Handler,
+		},
+		{
+			MethodName: "ListSellerGoods",
+			Handler:    _GoodsService_ListSellerGoods_Handler,
+		},
+		{
+			MethodName: "SchedulePriceChange",
+			Handler:    _GoodsService_SchedulePriceChange_Handler,
+		},
+		{
+			MethodName: "CancelPriceChange",
+			Handler:    _GoodsService_CancelPriceChange_Handler,
+		},
+		{
+			MethodName: "ListPriceSchedules",
+			Handler:    _GoodsService_ListPriceSchedules_Handler,
+		},
+		{
+			MethodName: "GetPriceAt",
+			Handler:    _GoodsService_GetPriceAt_Handler,
+		},
+		{
+			MethodName: "GetPriceHistory",
+			Handler:    _GoodsService_GetPriceHistory_Handler,
+		},
+		{
+			MethodName: "AdjustStock",
+			Handler:    _GoodsService_AdjustStock_Handler,
+		},
+		{
+			MethodName: "ListStockLedger",
+			Handler:    _GoodsService_ListStockLedger_Handler,
+		},
+		{
+			MethodName: "ReconcileStock",
+			Handler:    _GoodsService_ReconcileStock_Handler,
+		},
+		{
+			MethodName: "SetLowStockThreshold",
+			Handler:    _GoodsService_SetLowStockThreshold_Handler,
+		},
+		{
+			MethodName: "SubscribeRestock",
+			Handler:    _GoodsService_SubscribeRestock_Handler,
+		},
+		{
+			MethodName: "UnsubscribeRestock",
+			Handler:    _GoodsService_UnsubscribeRestock_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "goods.proto",
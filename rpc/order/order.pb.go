@@ -23,17 +23,24 @@ var _ = math.Inf
 const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type OrderInfo struct {
-	SellerID             string   `protobuf:"bytes,1,opt,name=sellerID,proto3" json:"sellerID,omitempty"`
-	BuyerID              string   `protobuf:"bytes,2,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
-	GoodsID              string   `protobuf:"bytes,3,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
-	GoodsName            string   `protobuf:"bytes,4,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
-	Count                uint32   `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
-	Price                float64  `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
-	Pay                  float64  `protobuf:"fixed64,7,opt,name=pay,proto3" json:"pay,omitempty"`
-	Status               uint32   `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	SellerID             string       `protobuf:"bytes,1,opt,name=sellerID,proto3" json:"sellerID,omitempty"`
+	BuyerID              string       `protobuf:"bytes,2,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	GoodsID              string       `protobuf:"bytes,3,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	GoodsName            string       `protobuf:"bytes,4,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	Count                uint32       `protobuf:"varint,5,opt,name=count,proto3" json:"count,omitempty"`
+	Price                float64      `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Pay                  float64      `protobuf:"fixed64,7,opt,name=pay,proto3" json:"pay,omitempty"`
+	Status               uint32       `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	PayID                string       `protobuf:"bytes,9,opt,name=payID,proto3" json:"payID,omitempty"`
+	OrderID              string       `protobuf:"bytes,10,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Items                []*OrderItem `protobuf:"bytes,11,rep,name=items,proto3" json:"items,omitempty"`
+	ActivityID           string       `protobuf:"bytes,12,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	Discount             float64      `protobuf:"fixed64,13,opt,name=discount,proto3" json:"discount,omitempty"`
+	CouponID             string       `protobuf:"bytes,14,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	CouponDiscount       float64      `protobuf:"fixed64,15,opt,name=couponDiscount,proto3" json:"couponDiscount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
 func (m *OrderInfo) Reset()         { *m = OrderInfo{} }
@@ -117,10 +124,156 @@ func (m *OrderInfo) GetStatus() uint32 {
 	return 0
 }
 
+func (m *OrderInfo) GetPayID() string {
+	if m != nil {
+		return m.PayID
+	}
+	return ""
+}
+
+func (m *OrderInfo) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *OrderInfo) GetItems() []*OrderItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *OrderInfo) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+func (m *OrderInfo) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *OrderInfo) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+func (m *OrderInfo) GetCouponDiscount() float64 {
+	if m != nil {
+		return m.CouponDiscount
+	}
+	return 0
+}
+
+type OrderItem struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	GoodsName            string   `protobuf:"bytes,2,opt,name=goodsName,proto3" json:"goodsName,omitempty"`
+	Price                float64  `protobuf:"fixed64,3,opt,name=price,proto3" json:"price,omitempty"`
+	Count                uint32   `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	Amount               float64  `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	ActivityID           string   `protobuf:"bytes,6,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	Discount             float64  `protobuf:"fixed64,7,opt,name=discount,proto3" json:"discount,omitempty"`
+	SkuID                string   `protobuf:"bytes,8,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderItem) Reset()         { *m = OrderItem{} }
+func (m *OrderItem) String() string { return proto.CompactTextString(m) }
+func (*OrderItem) ProtoMessage()    {}
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{1}
+}
+
+func (m *OrderItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderItem.Unmarshal(m, b)
+}
+func (m *OrderItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderItem.Marshal(b, m, deterministic)
+}
+func (m *OrderItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderItem.Merge(m, src)
+}
+func (m *OrderItem) XXX_Size() int {
+	return xxx_messageInfo_OrderItem.Size(m)
+}
+func (m *OrderItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderItem proto.InternalMessageInfo
+
+func (m *OrderItem) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *OrderItem) GetGoodsName() string {
+	if m != nil {
+		return m.GoodsName
+	}
+	return ""
+}
+
+func (m *OrderItem) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *OrderItem) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *OrderItem) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OrderItem) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+func (m *OrderItem) GetDiscount() float64 {
+	if m != nil {
+		return m.Discount
+	}
+	return 0
+}
+
+func (m *OrderItem) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
 type AddOrderRequest struct {
 	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
 	GoodsID              string   `protobuf:"bytes,2,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
 	Count                uint32   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	CouponID             string   `protobuf:"bytes,4,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	SkuID                string   `protobuf:"bytes,5,opt,name=skuID,proto3" json:"skuID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -130,7 +283,7 @@ func (m *AddOrderRequest) Reset()         { *m = AddOrderRequest{} }
 func (m *AddOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*AddOrderRequest) ProtoMessage()    {}
 func (*AddOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{1}
+	return fileDescriptor_cd01338c35d87077, []int{2}
 }
 
 func (m *AddOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -172,10 +325,25 @@ func (m *AddOrderRequest) GetCount() uint32 {
 	return 0
 }
 
+func (m *AddOrderRequest) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+func (m *AddOrderRequest) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
 type AddOrderResponse struct {
 	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
 	OrderID              string   `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	Queued               bool     `protobuf:"varint,4,opt,name=queued,proto3" json:"queued,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -185,7 +353,7 @@ func (m *AddOrderResponse) Reset()         { *m = AddOrderResponse{} }
 func (m *AddOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*AddOrderResponse) ProtoMessage()    {}
 func (*AddOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{2}
+	return fileDescriptor_cd01338c35d87077, []int{3}
 }
 
 func (m *AddOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -227,6 +395,194 @@ func (m *AddOrderResponse) GetOrderID() string {
 	return ""
 }
 
+func (m *AddOrderResponse) GetQueued() bool {
+	if m != nil {
+		return m.Queued
+	}
+	return false
+}
+
+type CartItem struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Count                uint32   `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	SkuID                string   `protobuf:"bytes,3,opt,name=skuID,proto3" json:"skuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CartItem) Reset()         { *m = CartItem{} }
+func (m *CartItem) String() string { return proto.CompactTextString(m) }
+func (*CartItem) ProtoMessage()    {}
+func (*CartItem) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{4}
+}
+
+func (m *CartItem) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CartItem.Unmarshal(m, b)
+}
+func (m *CartItem) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CartItem.Marshal(b, m, deterministic)
+}
+func (m *CartItem) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CartItem.Merge(m, src)
+}
+func (m *CartItem) XXX_Size() int {
+	return xxx_messageInfo_CartItem.Size(m)
+}
+func (m *CartItem) XXX_DiscardUnknown() {
+	xxx_messageInfo_CartItem.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CartItem proto.InternalMessageInfo
+
+func (m *CartItem) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *CartItem) GetCount() uint32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *CartItem) GetSkuID() string {
+	if m != nil {
+		return m.SkuID
+	}
+	return ""
+}
+
+type CheckoutRequest struct {
+	BuyerID              string      `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	Items                []*CartItem `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	CouponID             string      `protobuf:"bytes,3,opt,name=couponID,proto3" json:"couponID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CheckoutRequest) Reset()         { *m = CheckoutRequest{} }
+func (m *CheckoutRequest) String() string { return proto.CompactTextString(m) }
+func (*CheckoutRequest) ProtoMessage()    {}
+func (*CheckoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{5}
+}
+
+func (m *CheckoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckoutRequest.Unmarshal(m, b)
+}
+func (m *CheckoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckoutRequest.Marshal(b, m, deterministic)
+}
+func (m *CheckoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckoutRequest.Merge(m, src)
+}
+func (m *CheckoutRequest) XXX_Size() int {
+	return xxx_messageInfo_CheckoutRequest.Size(m)
+}
+func (m *CheckoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckoutRequest proto.InternalMessageInfo
+
+func (m *CheckoutRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *CheckoutRequest) GetItems() []*CartItem {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *CheckoutRequest) GetCouponID() string {
+	if m != nil {
+		return m.CouponID
+	}
+	return ""
+}
+
+type CheckoutResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	OrderID              string   `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	FailedGoodsID        string   `protobuf:"bytes,4,opt,name=failedGoodsID,proto3" json:"failedGoodsID,omitempty"`
+	FailedSkuID          string   `protobuf:"bytes,5,opt,name=failedSkuID,proto3" json:"failedSkuID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CheckoutResponse) Reset()         { *m = CheckoutResponse{} }
+func (m *CheckoutResponse) String() string { return proto.CompactTextString(m) }
+func (*CheckoutResponse) ProtoMessage()    {}
+func (*CheckoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{6}
+}
+
+func (m *CheckoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CheckoutResponse.Unmarshal(m, b)
+}
+func (m *CheckoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CheckoutResponse.Marshal(b, m, deterministic)
+}
+func (m *CheckoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CheckoutResponse.Merge(m, src)
+}
+func (m *CheckoutResponse) XXX_Size() int {
+	return xxx_messageInfo_CheckoutResponse.Size(m)
+}
+func (m *CheckoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CheckoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CheckoutResponse proto.InternalMessageInfo
+
+func (m *CheckoutResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CheckoutResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *CheckoutResponse) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *CheckoutResponse) GetFailedGoodsID() string {
+	if m != nil {
+		return m.FailedGoodsID
+	}
+	return ""
+}
+
+func (m *CheckoutResponse) GetFailedSkuID() string {
+	if m != nil {
+		return m.FailedSkuID
+	}
+	return ""
+}
+
 type GetOrderRequest struct {
 	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -238,7 +594,7 @@ func (m *GetOrderRequest) Reset()         { *m = GetOrderRequest{} }
 func (m *GetOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderRequest) ProtoMessage()    {}
 func (*GetOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{3}
+	return fileDescriptor_cd01338c35d87077, []int{7}
 }
 
 func (m *GetOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -279,7 +635,7 @@ func (m *GetOrderResponse) Reset()         { *m = GetOrderResponse{} }
 func (m *GetOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*GetOrderResponse) ProtoMessage()    {}
 func (*GetOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{4}
+	return fileDescriptor_cd01338c35d87077, []int{8}
 }
 
 func (m *GetOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -321,41 +677,239 @@ func (m *GetOrderResponse) GetOrderInfo() *OrderInfo {
 	return nil
 }
 
-type DelOrderRequest struct {
-	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+type ListOrdersRequest struct {
+	BuyerID              string   `protobuf:"bytes,1,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	SellerID             string   `protobuf:"bytes,2,opt,name=sellerID,proto3" json:"sellerID,omitempty"`
+	GoodsID              string   `protobuf:"bytes,3,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Statuses             []uint32 `protobuf:"varint,4,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	StartTime            int64    `protobuf:"varint,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	SortBy               string   `protobuf:"bytes,7,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Asc                  bool     `protobuf:"varint,8,opt,name=asc,proto3" json:"asc,omitempty"`
+	NumPerPage           int64    `protobuf:"varint,9,opt,name=numPerPage,proto3" json:"numPerPage,omitempty"`
+	LastID               int64    `protobuf:"varint,10,opt,name=lastID,proto3" json:"lastID,omitempty"`
+	LastPrice            float64  `protobuf:"fixed64,11,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DelOrderRequest) Reset()         { *m = DelOrderRequest{} }
-func (m *DelOrderRequest) String() string { return proto.CompactTextString(m) }
-func (*DelOrderRequest) ProtoMessage()    {}
-func (*DelOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{5}
+func (m *ListOrdersRequest) Reset()         { *m = ListOrdersRequest{} }
+func (m *ListOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersRequest) ProtoMessage()    {}
+func (*ListOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{9}
 }
 
-func (m *DelOrderRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DelOrderRequest.Unmarshal(m, b)
+func (m *ListOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersRequest.Unmarshal(m, b)
 }
-func (m *DelOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DelOrderRequest.Marshal(b, m, deterministic)
+func (m *ListOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersRequest.Marshal(b, m, deterministic)
 }
-func (m *DelOrderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DelOrderRequest.Merge(m, src)
+func (m *ListOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersRequest.Merge(m, src)
 }
-func (m *DelOrderRequest) XXX_Size() int {
-	return xxx_messageInfo_DelOrderRequest.Size(m)
+func (m *ListOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersRequest.Size(m)
 }
-func (m *DelOrderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_DelOrderRequest.DiscardUnknown(m)
+func (m *ListOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DelOrderRequest proto.InternalMessageInfo
+var xxx_messageInfo_ListOrdersRequest proto.InternalMessageInfo
 
-func (m *DelOrderRequest) GetOrderID() string {
+func (m *ListOrdersRequest) GetBuyerID() string {
 	if m != nil {
-		return m.OrderID
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *ListOrdersRequest) GetSellerID() string {
+	if m != nil {
+		return m.SellerID
+	}
+	return ""
+}
+
+func (m *ListOrdersRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *ListOrdersRequest) GetStatuses() []uint32 {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
+func (m *ListOrdersRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *ListOrdersRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
+}
+
+func (m *ListOrdersRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
+}
+
+func (m *ListOrdersRequest) GetAsc() bool {
+	if m != nil {
+		return m.Asc
+	}
+	return false
+}
+
+func (m *ListOrdersRequest) GetNumPerPage() int64 {
+	if m != nil {
+		return m.NumPerPage
+	}
+	return 0
+}
+
+func (m *ListOrdersRequest) GetLastID() int64 {
+	if m != nil {
+		return m.LastID
+	}
+	return 0
+}
+
+func (m *ListOrdersRequest) GetLastPrice() float64 {
+	if m != nil {
+		return m.LastPrice
+	}
+	return 0
+}
+
+type ListOrdersResponse struct {
+	Code                 uint32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string       `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	OrderInfos           []*OrderInfo `protobuf:"bytes,3,rep,name=orderInfos,proto3" json:"orderInfos,omitempty"`
+	HasMore              bool         `protobuf:"varint,4,opt,name=hasMore,proto3" json:"hasMore,omitempty"`
+	LastID               int64        `protobuf:"varint,5,opt,name=lastID,proto3" json:"lastID,omitempty"`
+	LastPrice            float64      `protobuf:"fixed64,6,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *ListOrdersResponse) Reset()         { *m = ListOrdersResponse{} }
+func (m *ListOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListOrdersResponse) ProtoMessage()    {}
+func (*ListOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{10}
+}
+
+func (m *ListOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListOrdersResponse.Unmarshal(m, b)
+}
+func (m *ListOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListOrdersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListOrdersResponse.Merge(m, src)
+}
+func (m *ListOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListOrdersResponse.Size(m)
+}
+func (m *ListOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListOrdersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListOrdersResponse proto.InternalMessageInfo
+
+func (m *ListOrdersResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ListOrdersResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *ListOrdersResponse) GetOrderInfos() []*OrderInfo {
+	if m != nil {
+		return m.OrderInfos
+	}
+	return nil
+}
+
+func (m *ListOrdersResponse) GetHasMore() bool {
+	if m != nil {
+		return m.HasMore
+	}
+	return false
+}
+
+func (m *ListOrdersResponse) GetLastID() int64 {
+	if m != nil {
+		return m.LastID
+	}
+	return 0
+}
+
+func (m *ListOrdersResponse) GetLastPrice() float64 {
+	if m != nil {
+		return m.LastPrice
+	}
+	return 0
+}
+
+type DelOrderRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DelOrderRequest) Reset()         { *m = DelOrderRequest{} }
+func (m *DelOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*DelOrderRequest) ProtoMessage()    {}
+func (*DelOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{11}
+}
+
+func (m *DelOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DelOrderRequest.Unmarshal(m, b)
+}
+func (m *DelOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DelOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *DelOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DelOrderRequest.Merge(m, src)
+}
+func (m *DelOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_DelOrderRequest.Size(m)
+}
+func (m *DelOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DelOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DelOrderRequest proto.InternalMessageInfo
+
+func (m *DelOrderRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
 	}
 	return ""
 }
@@ -372,7 +926,7 @@ func (m *DelOrderResponse) Reset()         { *m = DelOrderResponse{} }
 func (m *DelOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*DelOrderResponse) ProtoMessage()    {}
 func (*DelOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{6}
+	return fileDescriptor_cd01338c35d87077, []int{12}
 }
 
 func (m *DelOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -407,6 +961,92 @@ func (m *DelOrderResponse) GetCodeMsg() string {
 	return ""
 }
 
+type RestoreOrderRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreOrderRequest) Reset()         { *m = RestoreOrderRequest{} }
+func (m *RestoreOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreOrderRequest) ProtoMessage()    {}
+func (*RestoreOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{13}
+}
+
+func (m *RestoreOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreOrderRequest.Unmarshal(m, b)
+}
+func (m *RestoreOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreOrderRequest.Merge(m, src)
+}
+func (m *RestoreOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreOrderRequest.Size(m)
+}
+func (m *RestoreOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreOrderRequest proto.InternalMessageInfo
+
+func (m *RestoreOrderRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+type RestoreOrderResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreOrderResponse) Reset()         { *m = RestoreOrderResponse{} }
+func (m *RestoreOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreOrderResponse) ProtoMessage()    {}
+func (*RestoreOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{14}
+}
+
+func (m *RestoreOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreOrderResponse.Unmarshal(m, b)
+}
+func (m *RestoreOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreOrderResponse.Merge(m, src)
+}
+func (m *RestoreOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreOrderResponse.Size(m)
+}
+func (m *RestoreOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreOrderResponse proto.InternalMessageInfo
+
+func (m *RestoreOrderResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreOrderResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
 type PayOrderRequest struct {
 	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
@@ -418,7 +1058,7 @@ func (m *PayOrderRequest) Reset()         { *m = PayOrderRequest{} }
 func (m *PayOrderRequest) String() string { return proto.CompactTextString(m) }
 func (*PayOrderRequest) ProtoMessage()    {}
 func (*PayOrderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{7}
+	return fileDescriptor_cd01338c35d87077, []int{15}
 }
 
 func (m *PayOrderRequest) XXX_Unmarshal(b []byte) error {
@@ -449,6 +1089,8 @@ func (m *PayOrderRequest) GetOrderID() string {
 type PayOrderResponse struct {
 	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
 	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	PayID                string   `protobuf:"bytes,3,opt,name=payID,proto3" json:"payID,omitempty"`
+	Pending              bool     `protobuf:"varint,4,opt,name=pending,proto3" json:"pending,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -458,7 +1100,7 @@ func (m *PayOrderResponse) Reset()         { *m = PayOrderResponse{} }
 func (m *PayOrderResponse) String() string { return proto.CompactTextString(m) }
 func (*PayOrderResponse) ProtoMessage()    {}
 func (*PayOrderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{8}
+	return fileDescriptor_cd01338c35d87077, []int{16}
 }
 
 func (m *PayOrderResponse) XXX_Unmarshal(b []byte) error {
@@ -493,426 +1135,2602 @@ func (m *PayOrderResponse) GetCodeMsg() string {
 	return ""
 }
 
-type SetActivityRequest struct {
-	ActivityID           string   `protobuf:"bytes,1,opt,name=activityID,proto3" json:"activityID,omitempty"`
-	ActivityName         string   `protobuf:"bytes,2,opt,name=activityName,proto3" json:"activityName,omitempty"`
-	StartTime            string   `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime              string   `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+func (m *PayOrderResponse) GetPayID() string {
+	if m != nil {
+		return m.PayID
+	}
+	return ""
+}
+
+func (m *PayOrderResponse) GetPending() bool {
+	if m != nil {
+		return m.Pending
+	}
+	return false
+}
+
+type GetPaymentRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetActivityRequest) Reset()         { *m = SetActivityRequest{} }
-func (m *SetActivityRequest) String() string { return proto.CompactTextString(m) }
-func (*SetActivityRequest) ProtoMessage()    {}
-func (*SetActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{9}
+func (m *GetPaymentRequest) Reset()         { *m = GetPaymentRequest{} }
+func (m *GetPaymentRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentRequest) ProtoMessage()    {}
+func (*GetPaymentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{17}
 }
 
-func (m *SetActivityRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetActivityRequest.Unmarshal(m, b)
+func (m *GetPaymentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentRequest.Unmarshal(m, b)
 }
-func (m *SetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetActivityRequest.Marshal(b, m, deterministic)
+func (m *GetPaymentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentRequest.Marshal(b, m, deterministic)
 }
-func (m *SetActivityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetActivityRequest.Merge(m, src)
+func (m *GetPaymentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentRequest.Merge(m, src)
 }
-func (m *SetActivityRequest) XXX_Size() int {
-	return xxx_messageInfo_SetActivityRequest.Size(m)
+func (m *GetPaymentRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentRequest.Size(m)
 }
-func (m *SetActivityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetActivityRequest.DiscardUnknown(m)
+func (m *GetPaymentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetActivityRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetPaymentRequest proto.InternalMessageInfo
 
-func (m *SetActivityRequest) GetActivityID() string {
+func (m *GetPaymentRequest) GetOrderID() string {
 	if m != nil {
-		return m.ActivityID
+		return m.OrderID
 	}
 	return ""
 }
 
-func (m *SetActivityRequest) GetActivityName() string {
+type GetPaymentResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	PayID                string   `protobuf:"bytes,3,opt,name=payID,proto3" json:"payID,omitempty"`
+	Provider             string   `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+	TradeNo              string   `protobuf:"bytes,5,opt,name=tradeNo,proto3" json:"tradeNo,omitempty"`
+	Amount               float64  `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	RefundedAmount       float64  `protobuf:"fixed64,7,opt,name=refundedAmount,proto3" json:"refundedAmount,omitempty"`
+	Status               uint32   `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPaymentResponse) Reset()         { *m = GetPaymentResponse{} }
+func (m *GetPaymentResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPaymentResponse) ProtoMessage()    {}
+func (*GetPaymentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{18}
+}
+
+func (m *GetPaymentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPaymentResponse.Unmarshal(m, b)
+}
+func (m *GetPaymentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPaymentResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPaymentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPaymentResponse.Merge(m, src)
+}
+func (m *GetPaymentResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPaymentResponse.Size(m)
+}
+func (m *GetPaymentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPaymentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPaymentResponse proto.InternalMessageInfo
+
+func (m *GetPaymentResponse) GetCode() uint32 {
 	if m != nil {
-		return m.ActivityName
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetPaymentResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
 	}
 	return ""
 }
 
-func (m *SetActivityRequest) GetStartTime() string {
+func (m *GetPaymentResponse) GetPayID() string {
 	if m != nil {
-		return m.StartTime
+		return m.PayID
+	}
+	return ""
+}
+
+func (m *GetPaymentResponse) GetProvider() string {
+	if m != nil {
+		return m.Provider
 	}
 	return ""
 }
 
-func (m *SetActivityRequest) GetEndTime() string {
+func (m *GetPaymentResponse) GetTradeNo() string {
+	if m != nil {
+		return m.TradeNo
+	}
+	return ""
+}
+
+func (m *GetPaymentResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *GetPaymentResponse) GetRefundedAmount() float64 {
+	if m != nil {
+		return m.RefundedAmount
+	}
+	return 0
+}
+
+func (m *GetPaymentResponse) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+type CancelOrderRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	BuyerID              string   `protobuf:"bytes,2,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOrderRequest) Reset()         { *m = CancelOrderRequest{} }
+func (m *CancelOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CancelOrderRequest) ProtoMessage()    {}
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{19}
+}
+
+func (m *CancelOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrderRequest.Unmarshal(m, b)
+}
+func (m *CancelOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *CancelOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrderRequest.Merge(m, src)
+}
+func (m *CancelOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CancelOrderRequest.Size(m)
+}
+func (m *CancelOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOrderRequest proto.InternalMessageInfo
+
+func (m *CancelOrderRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *CancelOrderRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *CancelOrderRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	RefundID             string   `protobuf:"bytes,3,opt,name=refundID,proto3" json:"refundID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CancelOrderResponse) Reset()         { *m = CancelOrderResponse{} }
+func (m *CancelOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*CancelOrderResponse) ProtoMessage()    {}
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{20}
+}
+
+func (m *CancelOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CancelOrderResponse.Unmarshal(m, b)
+}
+func (m *CancelOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CancelOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *CancelOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CancelOrderResponse.Merge(m, src)
+}
+func (m *CancelOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_CancelOrderResponse.Size(m)
+}
+func (m *CancelOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CancelOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CancelOrderResponse proto.InternalMessageInfo
+
+func (m *CancelOrderResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CancelOrderResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *CancelOrderResponse) GetRefundID() string {
+	if m != nil {
+		return m.RefundID
+	}
+	return ""
+}
+
+type RefundOrderRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	BuyerID              string   `protobuf:"bytes,2,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefundOrderRequest) Reset()         { *m = RefundOrderRequest{} }
+func (m *RefundOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*RefundOrderRequest) ProtoMessage()    {}
+func (*RefundOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{21}
+}
+
+func (m *RefundOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundOrderRequest.Unmarshal(m, b)
+}
+func (m *RefundOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *RefundOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundOrderRequest.Merge(m, src)
+}
+func (m *RefundOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_RefundOrderRequest.Size(m)
+}
+func (m *RefundOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundOrderRequest proto.InternalMessageInfo
+
+func (m *RefundOrderRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *RefundOrderRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+func (m *RefundOrderRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type RefundOrderResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	RefundID             string   `protobuf:"bytes,3,opt,name=refundID,proto3" json:"refundID,omitempty"`
+	Amount               float64  `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RefundOrderResponse) Reset()         { *m = RefundOrderResponse{} }
+func (m *RefundOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*RefundOrderResponse) ProtoMessage()    {}
+func (*RefundOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{22}
+}
+
+func (m *RefundOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RefundOrderResponse.Unmarshal(m, b)
+}
+func (m *RefundOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RefundOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *RefundOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RefundOrderResponse.Merge(m, src)
+}
+func (m *RefundOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_RefundOrderResponse.Size(m)
+}
+func (m *RefundOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RefundOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RefundOrderResponse proto.InternalMessageInfo
+
+func (m *RefundOrderResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RefundOrderResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *RefundOrderResponse) GetRefundID() string {
+	if m != nil {
+		return m.RefundID
+	}
+	return ""
+}
+
+func (m *RefundOrderResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type SagaStepLog struct {
+	Step                 uint32   `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	StepName             string   `protobuf:"bytes,2,opt,name=stepName,proto3" json:"stepName,omitempty"`
+	Action               uint32   `protobuf:"varint,3,opt,name=action,proto3" json:"action,omitempty"`
+	Result               uint32   `protobuf:"varint,4,opt,name=result,proto3" json:"result,omitempty"`
+	ErrMsg               string   `protobuf:"bytes,5,opt,name=errMsg,proto3" json:"errMsg,omitempty"`
+	LogTime              int64    `protobuf:"varint,6,opt,name=logTime,proto3" json:"logTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SagaStepLog) Reset()         { *m = SagaStepLog{} }
+func (m *SagaStepLog) String() string { return proto.CompactTextString(m) }
+func (*SagaStepLog) ProtoMessage()    {}
+func (*SagaStepLog) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{23}
+}
+
+func (m *SagaStepLog) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SagaStepLog.Unmarshal(m, b)
+}
+func (m *SagaStepLog) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SagaStepLog.Marshal(b, m, deterministic)
+}
+func (m *SagaStepLog) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SagaStepLog.Merge(m, src)
+}
+func (m *SagaStepLog) XXX_Size() int {
+	return xxx_messageInfo_SagaStepLog.Size(m)
+}
+func (m *SagaStepLog) XXX_DiscardUnknown() {
+	xxx_messageInfo_SagaStepLog.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SagaStepLog proto.InternalMessageInfo
+
+func (m *SagaStepLog) GetStep() uint32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *SagaStepLog) GetStepName() string {
+	if m != nil {
+		return m.StepName
+	}
+	return ""
+}
+
+func (m *SagaStepLog) GetAction() uint32 {
+	if m != nil {
+		return m.Action
+	}
+	return 0
+}
+
+func (m *SagaStepLog) GetResult() uint32 {
+	if m != nil {
+		return m.Result
+	}
+	return 0
+}
+
+func (m *SagaStepLog) GetErrMsg() string {
+	if m != nil {
+		return m.ErrMsg
+	}
+	return ""
+}
+
+func (m *SagaStepLog) GetLogTime() int64 {
+	if m != nil {
+		return m.LogTime
+	}
+	return 0
+}
+
+type SagaInfo struct {
+	SagaID               string         `protobuf:"bytes,1,opt,name=sagaID,proto3" json:"sagaID,omitempty"`
+	SagaType             string         `protobuf:"bytes,2,opt,name=sagaType,proto3" json:"sagaType,omitempty"`
+	OrderID              string         `protobuf:"bytes,3,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	PayID                string         `protobuf:"bytes,4,opt,name=payID,proto3" json:"payID,omitempty"`
+	Step                 uint32         `protobuf:"varint,5,opt,name=step,proto3" json:"step,omitempty"`
+	StepName             string         `protobuf:"bytes,6,opt,name=stepName,proto3" json:"stepName,omitempty"`
+	Status               uint32         `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	RetryTimes           uint32         `protobuf:"varint,8,opt,name=retryTimes,proto3" json:"retryTimes,omitempty"`
+	LastError            string         `protobuf:"bytes,9,opt,name=lastError,proto3" json:"lastError,omitempty"`
+	Logs                 []*SagaStepLog `protobuf:"bytes,10,rep,name=logs,proto3" json:"logs,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SagaInfo) Reset()         { *m = SagaInfo{} }
+func (m *SagaInfo) String() string { return proto.CompactTextString(m) }
+func (*SagaInfo) ProtoMessage()    {}
+func (*SagaInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{24}
+}
+
+func (m *SagaInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SagaInfo.Unmarshal(m, b)
+}
+func (m *SagaInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SagaInfo.Marshal(b, m, deterministic)
+}
+func (m *SagaInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SagaInfo.Merge(m, src)
+}
+func (m *SagaInfo) XXX_Size() int {
+	return xxx_messageInfo_SagaInfo.Size(m)
+}
+func (m *SagaInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_SagaInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SagaInfo proto.InternalMessageInfo
+
+func (m *SagaInfo) GetSagaID() string {
+	if m != nil {
+		return m.SagaID
+	}
+	return ""
+}
+
+func (m *SagaInfo) GetSagaType() string {
+	if m != nil {
+		return m.SagaType
+	}
+	return ""
+}
+
+func (m *SagaInfo) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *SagaInfo) GetPayID() string {
+	if m != nil {
+		return m.PayID
+	}
+	return ""
+}
+
+func (m *SagaInfo) GetStep() uint32 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *SagaInfo) GetStepName() string {
+	if m != nil {
+		return m.StepName
+	}
+	return ""
+}
+
+func (m *SagaInfo) GetStatus() uint32 {
+	if m != nil {
+		return m.Status
+	}
+	return 0
+}
+
+func (m *SagaInfo) GetRetryTimes() uint32 {
+	if m != nil {
+		return m.RetryTimes
+	}
+	return 0
+}
+
+func (m *SagaInfo) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *SagaInfo) GetLogs() []*SagaStepLog {
+	if m != nil {
+		return m.Logs
+	}
+	return nil
+}
+
+type GetOrderSagaRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrderSagaRequest) Reset()         { *m = GetOrderSagaRequest{} }
+func (m *GetOrderSagaRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderSagaRequest) ProtoMessage()    {}
+func (*GetOrderSagaRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{25}
+}
+
+func (m *GetOrderSagaRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderSagaRequest.Unmarshal(m, b)
+}
+func (m *GetOrderSagaRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderSagaRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderSagaRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderSagaRequest.Merge(m, src)
+}
+func (m *GetOrderSagaRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderSagaRequest.Size(m)
+}
+func (m *GetOrderSagaRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderSagaRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderSagaRequest proto.InternalMessageInfo
+
+func (m *GetOrderSagaRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+type GetOrderSagaResponse struct {
+	Code                 uint32      `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string      `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	SagaInfos            []*SagaInfo `protobuf:"bytes,3,rep,name=sagaInfos,proto3" json:"sagaInfos,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetOrderSagaResponse) Reset()         { *m = GetOrderSagaResponse{} }
+func (m *GetOrderSagaResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderSagaResponse) ProtoMessage()    {}
+func (*GetOrderSagaResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{26}
+}
+
+func (m *GetOrderSagaResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderSagaResponse.Unmarshal(m, b)
+}
+func (m *GetOrderSagaResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderSagaResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderSagaResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderSagaResponse.Merge(m, src)
+}
+func (m *GetOrderSagaResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderSagaResponse.Size(m)
+}
+func (m *GetOrderSagaResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderSagaResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderSagaResponse proto.InternalMessageInfo
+
+func (m *GetOrderSagaResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetOrderSagaResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetOrderSagaResponse) GetSagaInfos() []*SagaInfo {
+	if m != nil {
+		return m.SagaInfos
+	}
+	return nil
+}
+
+type ShipOrderRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	SellerID             string   `protobuf:"bytes,2,opt,name=sellerID,proto3" json:"sellerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShipOrderRequest) Reset()         { *m = ShipOrderRequest{} }
+func (m *ShipOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ShipOrderRequest) ProtoMessage()    {}
+func (*ShipOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{27}
+}
+
+func (m *ShipOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShipOrderRequest.Unmarshal(m, b)
+}
+func (m *ShipOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShipOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *ShipOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShipOrderRequest.Merge(m, src)
+}
+func (m *ShipOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_ShipOrderRequest.Size(m)
+}
+func (m *ShipOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShipOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShipOrderRequest proto.InternalMessageInfo
+
+func (m *ShipOrderRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *ShipOrderRequest) GetSellerID() string {
+	if m != nil {
+		return m.SellerID
+	}
+	return ""
+}
+
+type ShipOrderResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ShipOrderResponse) Reset()         { *m = ShipOrderResponse{} }
+func (m *ShipOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*ShipOrderResponse) ProtoMessage()    {}
+func (*ShipOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{28}
+}
+
+func (m *ShipOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ShipOrderResponse.Unmarshal(m, b)
+}
+func (m *ShipOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ShipOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *ShipOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ShipOrderResponse.Merge(m, src)
+}
+func (m *ShipOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_ShipOrderResponse.Size(m)
+}
+func (m *ShipOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ShipOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ShipOrderResponse proto.InternalMessageInfo
+
+func (m *ShipOrderResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *ShipOrderResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type CompleteOrderRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	BuyerID              string   `protobuf:"bytes,2,opt,name=buyerID,proto3" json:"buyerID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteOrderRequest) Reset()         { *m = CompleteOrderRequest{} }
+func (m *CompleteOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteOrderRequest) ProtoMessage()    {}
+func (*CompleteOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{29}
+}
+
+func (m *CompleteOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteOrderRequest.Unmarshal(m, b)
+}
+func (m *CompleteOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *CompleteOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteOrderRequest.Merge(m, src)
+}
+func (m *CompleteOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteOrderRequest.Size(m)
+}
+func (m *CompleteOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteOrderRequest proto.InternalMessageInfo
+
+func (m *CompleteOrderRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+func (m *CompleteOrderRequest) GetBuyerID() string {
+	if m != nil {
+		return m.BuyerID
+	}
+	return ""
+}
+
+type CompleteOrderResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteOrderResponse) Reset()         { *m = CompleteOrderResponse{} }
+func (m *CompleteOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*CompleteOrderResponse) ProtoMessage()    {}
+func (*CompleteOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{30}
+}
+
+func (m *CompleteOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteOrderResponse.Unmarshal(m, b)
+}
+func (m *CompleteOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *CompleteOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteOrderResponse.Merge(m, src)
+}
+func (m *CompleteOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_CompleteOrderResponse.Size(m)
+}
+func (m *CompleteOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteOrderResponse proto.InternalMessageInfo
+
+func (m *CompleteOrderResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *CompleteOrderResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type OrderStatusHistory struct {
+	FromStatus           uint32   `protobuf:"varint,1,opt,name=fromStatus,proto3" json:"fromStatus,omitempty"`
+	ToStatus             uint32   `protobuf:"varint,2,opt,name=toStatus,proto3" json:"toStatus,omitempty"`
+	Actor                string   `protobuf:"bytes,3,opt,name=actor,proto3" json:"actor,omitempty"`
+	Reason               string   `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	LogTime              int64    `protobuf:"varint,5,opt,name=logTime,proto3" json:"logTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OrderStatusHistory) Reset()         { *m = OrderStatusHistory{} }
+func (m *OrderStatusHistory) String() string { return proto.CompactTextString(m) }
+func (*OrderStatusHistory) ProtoMessage()    {}
+func (*OrderStatusHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{31}
+}
+
+func (m *OrderStatusHistory) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OrderStatusHistory.Unmarshal(m, b)
+}
+func (m *OrderStatusHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OrderStatusHistory.Marshal(b, m, deterministic)
+}
+func (m *OrderStatusHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OrderStatusHistory.Merge(m, src)
+}
+func (m *OrderStatusHistory) XXX_Size() int {
+	return xxx_messageInfo_OrderStatusHistory.Size(m)
+}
+func (m *OrderStatusHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_OrderStatusHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OrderStatusHistory proto.InternalMessageInfo
+
+func (m *OrderStatusHistory) GetFromStatus() uint32 {
+	if m != nil {
+		return m.FromStatus
+	}
+	return 0
+}
+
+func (m *OrderStatusHistory) GetToStatus() uint32 {
+	if m != nil {
+		return m.ToStatus
+	}
+	return 0
+}
+
+func (m *OrderStatusHistory) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *OrderStatusHistory) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *OrderStatusHistory) GetLogTime() int64 {
+	if m != nil {
+		return m.LogTime
+	}
+	return 0
+}
+
+type GetOrderHistoryRequest struct {
+	OrderID              string   `protobuf:"bytes,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetOrderHistoryRequest) Reset()         { *m = GetOrderHistoryRequest{} }
+func (m *GetOrderHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOrderHistoryRequest) ProtoMessage()    {}
+func (*GetOrderHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{32}
+}
+
+func (m *GetOrderHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderHistoryRequest.Unmarshal(m, b)
+}
+func (m *GetOrderHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderHistoryRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOrderHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderHistoryRequest.Merge(m, src)
+}
+func (m *GetOrderHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOrderHistoryRequest.Size(m)
+}
+func (m *GetOrderHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderHistoryRequest proto.InternalMessageInfo
+
+func (m *GetOrderHistoryRequest) GetOrderID() string {
+	if m != nil {
+		return m.OrderID
+	}
+	return ""
+}
+
+type GetOrderHistoryResponse struct {
+	Code                 uint32                `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string                `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	Histories            []*OrderStatusHistory `protobuf:"bytes,3,rep,name=histories,proto3" json:"histories,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *GetOrderHistoryResponse) Reset()         { *m = GetOrderHistoryResponse{} }
+func (m *GetOrderHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOrderHistoryResponse) ProtoMessage()    {}
+func (*GetOrderHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{33}
+}
+
+func (m *GetOrderHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOrderHistoryResponse.Unmarshal(m, b)
+}
+func (m *GetOrderHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOrderHistoryResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOrderHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOrderHistoryResponse.Merge(m, src)
+}
+func (m *GetOrderHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOrderHistoryResponse.Size(m)
+}
+func (m *GetOrderHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOrderHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOrderHistoryResponse proto.InternalMessageInfo
+
+func (m *GetOrderHistoryResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetOrderHistoryResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetOrderHistoryResponse) GetHistories() []*OrderStatusHistory {
+	if m != nil {
+		return m.Histories
+	}
+	return nil
+}
+
+type Promotion struct {
+	PromotionType        string   `protobuf:"bytes,1,opt,name=promotionType,proto3" json:"promotionType,omitempty"`
+	GoodsIDs             []string `protobuf:"bytes,2,rep,name=goodsIDs,proto3" json:"goodsIDs,omitempty"`
+	Categories           []uint32 `protobuf:"varint,3,rep,packed,name=categories,proto3" json:"categories,omitempty"`
+	DiscountRate         float64  `protobuf:"fixed64,4,opt,name=discountRate,proto3" json:"discountRate,omitempty"`
+	Threshold            float64  `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	Reduction            float64  `protobuf:"fixed64,6,opt,name=reduction,proto3" json:"reduction,omitempty"`
+	BuyCount             uint32   `protobuf:"varint,7,opt,name=buyCount,proto3" json:"buyCount,omitempty"`
+	FreeCount            uint32   `protobuf:"varint,8,opt,name=freeCount,proto3" json:"freeCount,omitempty"`
+	LimitPerUser         uint32   `protobuf:"varint,9,opt,name=limitPerUser,proto3" json:"limitPerUser,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Promotion) Reset()         { *m = Promotion{} }
+func (m *Promotion) String() string { return proto.CompactTextString(m) }
+func (*Promotion) ProtoMessage()    {}
+func (*Promotion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{34}
+}
+
+func (m *Promotion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Promotion.Unmarshal(m, b)
+}
+func (m *Promotion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Promotion.Marshal(b, m, deterministic)
+}
+func (m *Promotion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Promotion.Merge(m, src)
+}
+func (m *Promotion) XXX_Size() int {
+	return xxx_messageInfo_Promotion.Size(m)
+}
+func (m *Promotion) XXX_DiscardUnknown() {
+	xxx_messageInfo_Promotion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Promotion proto.InternalMessageInfo
+
+func (m *Promotion) GetPromotionType() string {
+	if m != nil {
+		return m.PromotionType
+	}
+	return ""
+}
+
+func (m *Promotion) GetGoodsIDs() []string {
+	if m != nil {
+		return m.GoodsIDs
+	}
+	return nil
+}
+
+func (m *Promotion) GetCategories() []uint32 {
+	if m != nil {
+		return m.Categories
+	}
+	return nil
+}
+
+func (m *Promotion) GetDiscountRate() float64 {
+	if m != nil {
+		return m.DiscountRate
+	}
+	return 0
+}
+
+func (m *Promotion) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *Promotion) GetReduction() float64 {
+	if m != nil {
+		return m.Reduction
+	}
+	return 0
+}
+
+func (m *Promotion) GetBuyCount() uint32 {
+	if m != nil {
+		return m.BuyCount
+	}
+	return 0
+}
+
+func (m *Promotion) GetFreeCount() uint32 {
+	if m != nil {
+		return m.FreeCount
+	}
+	return 0
+}
+
+func (m *Promotion) GetLimitPerUser() uint32 {
+	if m != nil {
+		return m.LimitPerUser
+	}
+	return 0
+}
+
+type SetActivityRequest struct {
+	ActivityID           string     `protobuf:"bytes,1,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	ActivityName         string     `protobuf:"bytes,2,opt,name=activityName,proto3" json:"activityName,omitempty"`
+	StartTime            string     `protobuf:"bytes,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string     `protobuf:"bytes,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Promotion            *Promotion `protobuf:"bytes,5,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *SetActivityRequest) Reset()         { *m = SetActivityRequest{} }
+func (m *SetActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*SetActivityRequest) ProtoMessage()    {}
+func (*SetActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{35}
+}
+
+func (m *SetActivityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetActivityRequest.Unmarshal(m, b)
+}
+func (m *SetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetActivityRequest.Marshal(b, m, deterministic)
+}
+func (m *SetActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetActivityRequest.Merge(m, src)
+}
+func (m *SetActivityRequest) XXX_Size() int {
+	return xxx_messageInfo_SetActivityRequest.Size(m)
+}
+func (m *SetActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetActivityRequest proto.InternalMessageInfo
+
+func (m *SetActivityRequest) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+func (m *SetActivityRequest) GetActivityName() string {
+	if m != nil {
+		return m.ActivityName
+	}
+	return ""
+}
+
+func (m *SetActivityRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *SetActivityRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *SetActivityRequest) GetPromotion() *Promotion {
+	if m != nil {
+		return m.Promotion
+	}
+	return nil
+}
+
+type SetActivityResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetActivityResponse) Reset()         { *m = SetActivityResponse{} }
+func (m *SetActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*SetActivityResponse) ProtoMessage()    {}
+func (*SetActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{36}
+}
+
+func (m *SetActivityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetActivityResponse.Unmarshal(m, b)
+}
+func (m *SetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetActivityResponse.Marshal(b, m, deterministic)
+}
+func (m *SetActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetActivityResponse.Merge(m, src)
+}
+func (m *SetActivityResponse) XXX_Size() int {
+	return xxx_messageInfo_SetActivityResponse.Size(m)
+}
+func (m *SetActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetActivityResponse proto.InternalMessageInfo
+
+func (m *SetActivityResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SetActivityResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type GetActivityRequest struct {
+	ActivityID           string   `protobuf:"bytes,1,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetActivityRequest) Reset()         { *m = GetActivityRequest{} }
+func (m *GetActivityRequest) String() string { return proto.CompactTextString(m) }
+func (*GetActivityRequest) ProtoMessage()    {}
+func (*GetActivityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{37}
+}
+
+func (m *GetActivityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActivityRequest.Unmarshal(m, b)
+}
+func (m *GetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActivityRequest.Marshal(b, m, deterministic)
+}
+func (m *GetActivityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActivityRequest.Merge(m, src)
+}
+func (m *GetActivityRequest) XXX_Size() int {
+	return xxx_messageInfo_GetActivityRequest.Size(m)
+}
+func (m *GetActivityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActivityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActivityRequest proto.InternalMessageInfo
+
+func (m *GetActivityRequest) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+type GetActivityResponse struct {
+	Code                 uint32     `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string     `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	ActivityID           string     `protobuf:"bytes,3,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	ActivityName         string     `protobuf:"bytes,4,opt,name=activityName,proto3" json:"activityName,omitempty"`
+	StartTime            string     `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string     `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Promotion            *Promotion `protobuf:"bytes,7,opt,name=promotion,proto3" json:"promotion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetActivityResponse) Reset()         { *m = GetActivityResponse{} }
+func (m *GetActivityResponse) String() string { return proto.CompactTextString(m) }
+func (*GetActivityResponse) ProtoMessage()    {}
+func (*GetActivityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{38}
+}
+
+func (m *GetActivityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetActivityResponse.Unmarshal(m, b)
+}
+func (m *GetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetActivityResponse.Marshal(b, m, deterministic)
+}
+func (m *GetActivityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetActivityResponse.Merge(m, src)
+}
+func (m *GetActivityResponse) XXX_Size() int {
+	return xxx_messageInfo_GetActivityResponse.Size(m)
+}
+func (m *GetActivityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetActivityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetActivityResponse proto.InternalMessageInfo
+
+func (m *GetActivityResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetActivityResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetActivityResponse) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+func (m *GetActivityResponse) GetActivityName() string {
+	if m != nil {
+		return m.ActivityName
+	}
+	return ""
+}
+
+func (m *GetActivityResponse) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetActivityResponse) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetActivityResponse) GetPromotion() *Promotion {
+	if m != nil {
+		return m.Promotion
+	}
+	return nil
+}
+
+type SetFlashSaleRequest struct {
+	ActivityID           string   `protobuf:"bytes,1,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	GoodsID              string   `protobuf:"bytes,2,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Enable               bool     `protobuf:"varint,3,opt,name=enable,proto3" json:"enable,omitempty"`
+	Price                float64  `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetFlashSaleRequest) Reset()         { *m = SetFlashSaleRequest{} }
+func (m *SetFlashSaleRequest) String() string { return proto.CompactTextString(m) }
+func (*SetFlashSaleRequest) ProtoMessage()    {}
+func (*SetFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{39}
+}
+
+func (m *SetFlashSaleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFlashSaleRequest.Unmarshal(m, b)
+}
+func (m *SetFlashSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFlashSaleRequest.Marshal(b, m, deterministic)
+}
+func (m *SetFlashSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFlashSaleRequest.Merge(m, src)
+}
+func (m *SetFlashSaleRequest) XXX_Size() int {
+	return xxx_messageInfo_SetFlashSaleRequest.Size(m)
+}
+func (m *SetFlashSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFlashSaleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFlashSaleRequest proto.InternalMessageInfo
+
+func (m *SetFlashSaleRequest) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+func (m *SetFlashSaleRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *SetFlashSaleRequest) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *SetFlashSaleRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+type SetFlashSaleResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetFlashSaleResponse) Reset()         { *m = SetFlashSaleResponse{} }
+func (m *SetFlashSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*SetFlashSaleResponse) ProtoMessage()    {}
+func (*SetFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{40}
+}
+
+func (m *SetFlashSaleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetFlashSaleResponse.Unmarshal(m, b)
+}
+func (m *SetFlashSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetFlashSaleResponse.Marshal(b, m, deterministic)
+}
+func (m *SetFlashSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetFlashSaleResponse.Merge(m, src)
+}
+func (m *SetFlashSaleResponse) XXX_Size() int {
+	return xxx_messageInfo_SetFlashSaleResponse.Size(m)
+}
+func (m *SetFlashSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetFlashSaleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetFlashSaleResponse proto.InternalMessageInfo
+
+func (m *SetFlashSaleResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *SetFlashSaleResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+type GetFlashSaleRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlashSaleRequest) Reset()         { *m = GetFlashSaleRequest{} }
+func (m *GetFlashSaleRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFlashSaleRequest) ProtoMessage()    {}
+func (*GetFlashSaleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{41}
+}
+
+func (m *GetFlashSaleRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlashSaleRequest.Unmarshal(m, b)
+}
+func (m *GetFlashSaleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlashSaleRequest.Marshal(b, m, deterministic)
+}
+func (m *GetFlashSaleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlashSaleRequest.Merge(m, src)
+}
+func (m *GetFlashSaleRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFlashSaleRequest.Size(m)
+}
+func (m *GetFlashSaleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlashSaleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlashSaleRequest proto.InternalMessageInfo
+
+func (m *GetFlashSaleRequest) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+type GetFlashSaleResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	ActivityID           string   `protobuf:"bytes,3,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	GoodsID              string   `protobuf:"bytes,4,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Enable               bool     `protobuf:"varint,5,opt,name=enable,proto3" json:"enable,omitempty"`
+	Price                float64  `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
+	Stock                int64    `protobuf:"varint,7,opt,name=stock,proto3" json:"stock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetFlashSaleResponse) Reset()         { *m = GetFlashSaleResponse{} }
+func (m *GetFlashSaleResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFlashSaleResponse) ProtoMessage()    {}
+func (*GetFlashSaleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{42}
+}
+
+func (m *GetFlashSaleResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFlashSaleResponse.Unmarshal(m, b)
+}
+func (m *GetFlashSaleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFlashSaleResponse.Marshal(b, m, deterministic)
+}
+func (m *GetFlashSaleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFlashSaleResponse.Merge(m, src)
+}
+func (m *GetFlashSaleResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFlashSaleResponse.Size(m)
+}
+func (m *GetFlashSaleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFlashSaleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetFlashSaleResponse proto.InternalMessageInfo
+
+func (m *GetFlashSaleResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *GetFlashSaleResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
+func (m *GetFlashSaleResponse) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+func (m *GetFlashSaleResponse) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *GetFlashSaleResponse) GetEnable() bool {
+	if m != nil {
+		return m.Enable
+	}
+	return false
+}
+
+func (m *GetFlashSaleResponse) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *GetFlashSaleResponse) GetStock() int64 {
+	if m != nil {
+		return m.Stock
+	}
+	return 0
+}
+
+type GetUniquePayersRequest struct {
+	Granularity          string   `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	StartTime            string   `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string   `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	ActivityID           string   `protobuf:"bytes,4,opt,name=activityID,proto3" json:"activityID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetUniquePayersRequest) Reset()         { *m = GetUniquePayersRequest{} }
+func (m *GetUniquePayersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUniquePayersRequest) ProtoMessage()    {}
+func (*GetUniquePayersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{43}
+}
+
+func (m *GetUniquePayersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUniquePayersRequest.Unmarshal(m, b)
+}
+func (m *GetUniquePayersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUniquePayersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetUniquePayersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUniquePayersRequest.Merge(m, src)
+}
+func (m *GetUniquePayersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUniquePayersRequest.Size(m)
+}
+func (m *GetUniquePayersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUniquePayersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUniquePayersRequest proto.InternalMessageInfo
+
+func (m *GetUniquePayersRequest) GetGranularity() string {
+	if m != nil {
+		return m.Granularity
+	}
+	return ""
+}
+
+func (m *GetUniquePayersRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetUniquePayersRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetUniquePayersRequest) GetActivityID() string {
+	if m != nil {
+		return m.ActivityID
+	}
+	return ""
+}
+
+type PayersBucket struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Payers               int64    `protobuf:"varint,2,opt,name=payers,proto3" json:"payers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PayersBucket) Reset()         { *m = PayersBucket{} }
+func (m *PayersBucket) String() string { return proto.CompactTextString(m) }
+func (*PayersBucket) ProtoMessage()    {}
+func (*PayersBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{44}
+}
+
+func (m *PayersBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PayersBucket.Unmarshal(m, b)
+}
+func (m *PayersBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PayersBucket.Marshal(b, m, deterministic)
+}
+func (m *PayersBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayersBucket.Merge(m, src)
+}
+func (m *PayersBucket) XXX_Size() int {
+	return xxx_messageInfo_PayersBucket.Size(m)
+}
+func (m *PayersBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayersBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayersBucket proto.InternalMessageInfo
+
+func (m *PayersBucket) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *PayersBucket) GetPayers() int64 {
+	if m != nil {
+		return m.Payers
+	}
+	return 0
+}
+
+type GetUniquePayersResponse struct {
+	Total                int64           `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Buckets              []*PayersBucket `protobuf:"bytes,2,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetUniquePayersResponse) Reset()         { *m = GetUniquePayersResponse{} }
+func (m *GetUniquePayersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUniquePayersResponse) ProtoMessage()    {}
+func (*GetUniquePayersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{45}
+}
+
+func (m *GetUniquePayersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUniquePayersResponse.Unmarshal(m, b)
+}
+func (m *GetUniquePayersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUniquePayersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetUniquePayersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUniquePayersResponse.Merge(m, src)
+}
+func (m *GetUniquePayersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUniquePayersResponse.Size(m)
+}
+func (m *GetUniquePayersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUniquePayersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUniquePayersResponse proto.InternalMessageInfo
+
+func (m *GetUniquePayersResponse) GetTotal() int64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetUniquePayersResponse) GetBuckets() []*PayersBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type GetSalesStatsRequest struct {
+	Granularity          string   `protobuf:"bytes,1,opt,name=granularity,proto3" json:"granularity,omitempty"`
+	StartTime            string   `protobuf:"bytes,2,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string   `protobuf:"bytes,3,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSalesStatsRequest) Reset()         { *m = GetSalesStatsRequest{} }
+func (m *GetSalesStatsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSalesStatsRequest) ProtoMessage()    {}
+func (*GetSalesStatsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{46}
+}
+
+func (m *GetSalesStatsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSalesStatsRequest.Unmarshal(m, b)
+}
+func (m *GetSalesStatsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSalesStatsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetSalesStatsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSalesStatsRequest.Merge(m, src)
+}
+func (m *GetSalesStatsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSalesStatsRequest.Size(m)
+}
+func (m *GetSalesStatsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSalesStatsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSalesStatsRequest proto.InternalMessageInfo
+
+func (m *GetSalesStatsRequest) GetGranularity() string {
+	if m != nil {
+		return m.Granularity
+	}
+	return ""
+}
+
+func (m *GetSalesStatsRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetSalesStatsRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+type SalesBucket struct {
+	Time                 string   `protobuf:"bytes,1,opt,name=time,proto3" json:"time,omitempty"`
+	Gmv                  float64  `protobuf:"fixed64,2,opt,name=gmv,proto3" json:"gmv,omitempty"`
+	OrderCount           int64    `protobuf:"varint,3,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SalesBucket) Reset()         { *m = SalesBucket{} }
+func (m *SalesBucket) String() string { return proto.CompactTextString(m) }
+func (*SalesBucket) ProtoMessage()    {}
+func (*SalesBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{47}
+}
+
+func (m *SalesBucket) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SalesBucket.Unmarshal(m, b)
+}
+func (m *SalesBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SalesBucket.Marshal(b, m, deterministic)
+}
+func (m *SalesBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SalesBucket.Merge(m, src)
+}
+func (m *SalesBucket) XXX_Size() int {
+	return xxx_messageInfo_SalesBucket.Size(m)
+}
+func (m *SalesBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_SalesBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SalesBucket proto.InternalMessageInfo
+
+func (m *SalesBucket) GetTime() string {
+	if m != nil {
+		return m.Time
+	}
+	return ""
+}
+
+func (m *SalesBucket) GetGmv() float64 {
+	if m != nil {
+		return m.Gmv
+	}
+	return 0
+}
+
+func (m *SalesBucket) GetOrderCount() int64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+type GetSalesStatsResponse struct {
+	Gmv                  float64        `protobuf:"fixed64,1,opt,name=gmv,proto3" json:"gmv,omitempty"`
+	OrderCount           int64          `protobuf:"varint,2,opt,name=orderCount,proto3" json:"orderCount,omitempty"`
+	Buckets              []*SalesBucket `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetSalesStatsResponse) Reset()         { *m = GetSalesStatsResponse{} }
+func (m *GetSalesStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSalesStatsResponse) ProtoMessage()    {}
+func (*GetSalesStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{48}
+}
+
+func (m *GetSalesStatsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSalesStatsResponse.Unmarshal(m, b)
+}
+func (m *GetSalesStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSalesStatsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetSalesStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSalesStatsResponse.Merge(m, src)
+}
+func (m *GetSalesStatsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSalesStatsResponse.Size(m)
+}
+func (m *GetSalesStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSalesStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSalesStatsResponse proto.InternalMessageInfo
+
+func (m *GetSalesStatsResponse) GetGmv() float64 {
+	if m != nil {
+		return m.Gmv
+	}
+	return 0
+}
+
+func (m *GetSalesStatsResponse) GetOrderCount() int64 {
+	if m != nil {
+		return m.OrderCount
+	}
+	return 0
+}
+
+func (m *GetSalesStatsResponse) GetBuckets() []*SalesBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+type GetTopGoodsRequest struct {
+	StartTime            string   `protobuf:"bytes,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              string   `protobuf:"bytes,2,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	TopN                 uint32   `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTopGoodsRequest) Reset()         { *m = GetTopGoodsRequest{} }
+func (m *GetTopGoodsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTopGoodsRequest) ProtoMessage()    {}
+func (*GetTopGoodsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{49}
+}
+
+func (m *GetTopGoodsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTopGoodsRequest.Unmarshal(m, b)
+}
+func (m *GetTopGoodsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTopGoodsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTopGoodsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopGoodsRequest.Merge(m, src)
+}
+func (m *GetTopGoodsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTopGoodsRequest.Size(m)
+}
+func (m *GetTopGoodsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopGoodsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopGoodsRequest proto.InternalMessageInfo
+
+func (m *GetTopGoodsRequest) GetStartTime() string {
+	if m != nil {
+		return m.StartTime
+	}
+	return ""
+}
+
+func (m *GetTopGoodsRequest) GetEndTime() string {
+	if m != nil {
+		return m.EndTime
+	}
+	return ""
+}
+
+func (m *GetTopGoodsRequest) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
+}
+
+type GoodsSales struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Sales                int64    `protobuf:"varint,2,opt,name=sales,proto3" json:"sales,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GoodsSales) Reset()         { *m = GoodsSales{} }
+func (m *GoodsSales) String() string { return proto.CompactTextString(m) }
+func (*GoodsSales) ProtoMessage()    {}
+func (*GoodsSales) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{50}
+}
+
+func (m *GoodsSales) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GoodsSales.Unmarshal(m, b)
+}
+func (m *GoodsSales) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GoodsSales.Marshal(b, m, deterministic)
+}
+func (m *GoodsSales) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GoodsSales.Merge(m, src)
+}
+func (m *GoodsSales) XXX_Size() int {
+	return xxx_messageInfo_GoodsSales.Size(m)
+}
+func (m *GoodsSales) XXX_DiscardUnknown() {
+	xxx_messageInfo_GoodsSales.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GoodsSales proto.InternalMessageInfo
+
+func (m *GoodsSales) GetGoodsID() string {
+	if m != nil {
+		return m.GoodsID
+	}
+	return ""
+}
+
+func (m *GoodsSales) GetSales() int64 {
+	if m != nil {
+		return m.Sales
+	}
+	return 0
+}
+
+type GetTopGoodsResponse struct {
+	Goods                []*GoodsSales `protobuf:"bytes,1,rep,name=goods,proto3" json:"goods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTopGoodsResponse) Reset()         { *m = GetTopGoodsResponse{} }
+func (m *GetTopGoodsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTopGoodsResponse) ProtoMessage()    {}
+func (*GetTopGoodsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{51}
+}
+
+func (m *GetTopGoodsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTopGoodsResponse.Unmarshal(m, b)
+}
+func (m *GetTopGoodsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTopGoodsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTopGoodsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTopGoodsResponse.Merge(m, src)
+}
+func (m *GetTopGoodsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTopGoodsResponse.Size(m)
+}
+func (m *GetTopGoodsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTopGoodsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTopGoodsResponse proto.InternalMessageInfo
+
+func (m *GetTopGoodsResponse) GetGoods() []*GoodsSales {
 	if m != nil {
-		return m.EndTime
+		return m.Goods
 	}
-	return ""
+	return nil
 }
 
-type SetActivityResponse struct {
-	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+type ListSellerOrdersRequest struct {
+	GoodsID              string   `protobuf:"bytes,1,opt,name=goodsID,proto3" json:"goodsID,omitempty"`
+	Statuses             []uint32 `protobuf:"varint,2,rep,packed,name=statuses,proto3" json:"statuses,omitempty"`
+	StartTime            int64    `protobuf:"varint,3,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,4,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	SortBy               string   `protobuf:"bytes,5,opt,name=sortBy,proto3" json:"sortBy,omitempty"`
+	Asc                  bool     `protobuf:"varint,6,opt,name=asc,proto3" json:"asc,omitempty"`
+	NumPerPage           int64    `protobuf:"varint,7,opt,name=numPerPage,proto3" json:"numPerPage,omitempty"`
+	LastID               int64    `protobuf:"varint,8,opt,name=lastID,proto3" json:"lastID,omitempty"`
+	LastPrice            float64  `protobuf:"fixed64,9,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SetActivityResponse) Reset()         { *m = SetActivityResponse{} }
-func (m *SetActivityResponse) String() string { return proto.CompactTextString(m) }
-func (*SetActivityResponse) ProtoMessage()    {}
-func (*SetActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{10}
+func (m *ListSellerOrdersRequest) Reset()         { *m = ListSellerOrdersRequest{} }
+func (m *ListSellerOrdersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListSellerOrdersRequest) ProtoMessage()    {}
+func (*ListSellerOrdersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{52}
 }
 
-func (m *SetActivityResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SetActivityResponse.Unmarshal(m, b)
+func (m *ListSellerOrdersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSellerOrdersRequest.Unmarshal(m, b)
 }
-func (m *SetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SetActivityResponse.Marshal(b, m, deterministic)
+func (m *ListSellerOrdersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSellerOrdersRequest.Marshal(b, m, deterministic)
 }
-func (m *SetActivityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SetActivityResponse.Merge(m, src)
+func (m *ListSellerOrdersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSellerOrdersRequest.Merge(m, src)
 }
-func (m *SetActivityResponse) XXX_Size() int {
-	return xxx_messageInfo_SetActivityResponse.Size(m)
+func (m *ListSellerOrdersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListSellerOrdersRequest.Size(m)
 }
-func (m *SetActivityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SetActivityResponse.DiscardUnknown(m)
+func (m *ListSellerOrdersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSellerOrdersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SetActivityResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListSellerOrdersRequest proto.InternalMessageInfo
 
-func (m *SetActivityResponse) GetCode() uint32 {
+func (m *ListSellerOrdersRequest) GetGoodsID() string {
 	if m != nil {
-		return m.Code
+		return m.GoodsID
 	}
-	return 0
+	return ""
 }
 
-func (m *SetActivityResponse) GetCodeMsg() string {
+func (m *ListSellerOrdersRequest) GetStatuses() []uint32 {
 	if m != nil {
-		return m.CodeMsg
+		return m.Statuses
 	}
-	return ""
+	return nil
 }
 
-type GetActivityRequest struct {
-	ActivityID           string   `protobuf:"bytes,1,opt,name=activityID,proto3" json:"activityID,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ListSellerOrdersRequest) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
 }
 
-func (m *GetActivityRequest) Reset()         { *m = GetActivityRequest{} }
-func (m *GetActivityRequest) String() string { return proto.CompactTextString(m) }
-func (*GetActivityRequest) ProtoMessage()    {}
-func (*GetActivityRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{11}
+func (m *ListSellerOrdersRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
 
-func (m *GetActivityRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetActivityRequest.Unmarshal(m, b)
-}
-func (m *GetActivityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetActivityRequest.Marshal(b, m, deterministic)
-}
-func (m *GetActivityRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetActivityRequest.Merge(m, src)
+func (m *ListSellerOrdersRequest) GetSortBy() string {
+	if m != nil {
+		return m.SortBy
+	}
+	return ""
 }
-func (m *GetActivityRequest) XXX_Size() int {
-	return xxx_messageInfo_GetActivityRequest.Size(m)
+
+func (m *ListSellerOrdersRequest) GetAsc() bool {
+	if m != nil {
+		return m.Asc
+	}
+	return false
 }
-func (m *GetActivityRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetActivityRequest.DiscardUnknown(m)
+
+func (m *ListSellerOrdersRequest) GetNumPerPage() int64 {
+	if m != nil {
+		return m.NumPerPage
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetActivityRequest proto.InternalMessageInfo
+func (m *ListSellerOrdersRequest) GetLastID() int64 {
+	if m != nil {
+		return m.LastID
+	}
+	return 0
+}
 
-func (m *GetActivityRequest) GetActivityID() string {
+func (m *ListSellerOrdersRequest) GetLastPrice() float64 {
 	if m != nil {
-		return m.ActivityID
+		return m.LastPrice
 	}
-	return ""
+	return 0
 }
 
-type GetActivityResponse struct {
-	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
-	ActivityID           string   `protobuf:"bytes,3,opt,name=activityID,proto3" json:"activityID,omitempty"`
-	ActivityName         string   `protobuf:"bytes,4,opt,name=activityName,proto3" json:"activityName,omitempty"`
-	StartTime            string   `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
-	EndTime              string   `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ListSellerOrdersResponse struct {
+	Code                 uint32       `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string       `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	OrderInfos           []*OrderInfo `protobuf:"bytes,3,rep,name=orderInfos,proto3" json:"orderInfos,omitempty"`
+	HasMore              bool         `protobuf:"varint,4,opt,name=hasMore,proto3" json:"hasMore,omitempty"`
+	LastID               int64        `protobuf:"varint,5,opt,name=lastID,proto3" json:"lastID,omitempty"`
+	LastPrice            float64      `protobuf:"fixed64,6,opt,name=lastPrice,proto3" json:"lastPrice,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *GetActivityResponse) Reset()         { *m = GetActivityResponse{} }
-func (m *GetActivityResponse) String() string { return proto.CompactTextString(m) }
-func (*GetActivityResponse) ProtoMessage()    {}
-func (*GetActivityResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{12}
+func (m *ListSellerOrdersResponse) Reset()         { *m = ListSellerOrdersResponse{} }
+func (m *ListSellerOrdersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListSellerOrdersResponse) ProtoMessage()    {}
+func (*ListSellerOrdersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{53}
 }
 
-func (m *GetActivityResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetActivityResponse.Unmarshal(m, b)
+func (m *ListSellerOrdersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListSellerOrdersResponse.Unmarshal(m, b)
 }
-func (m *GetActivityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetActivityResponse.Marshal(b, m, deterministic)
+func (m *ListSellerOrdersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListSellerOrdersResponse.Marshal(b, m, deterministic)
 }
-func (m *GetActivityResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetActivityResponse.Merge(m, src)
+func (m *ListSellerOrdersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListSellerOrdersResponse.Merge(m, src)
 }
-func (m *GetActivityResponse) XXX_Size() int {
-	return xxx_messageInfo_GetActivityResponse.Size(m)
+func (m *ListSellerOrdersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListSellerOrdersResponse.Size(m)
 }
-func (m *GetActivityResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetActivityResponse.DiscardUnknown(m)
+func (m *ListSellerOrdersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListSellerOrdersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetActivityResponse proto.InternalMessageInfo
+var xxx_messageInfo_ListSellerOrdersResponse proto.InternalMessageInfo
 
-func (m *GetActivityResponse) GetCode() uint32 {
+func (m *ListSellerOrdersResponse) GetCode() uint32 {
 	if m != nil {
 		return m.Code
 	}
 	return 0
 }
 
-func (m *GetActivityResponse) GetCodeMsg() string {
+func (m *ListSellerOrdersResponse) GetCodeMsg() string {
 	if m != nil {
 		return m.CodeMsg
 	}
 	return ""
 }
 
-func (m *GetActivityResponse) GetActivityID() string {
+func (m *ListSellerOrdersResponse) GetOrderInfos() []*OrderInfo {
 	if m != nil {
-		return m.ActivityID
+		return m.OrderInfos
 	}
-	return ""
+	return nil
 }
 
-func (m *GetActivityResponse) GetActivityName() string {
+func (m *ListSellerOrdersResponse) GetHasMore() bool {
 	if m != nil {
-		return m.ActivityName
+		return m.HasMore
 	}
-	return ""
+	return false
 }
 
-func (m *GetActivityResponse) GetStartTime() string {
+func (m *ListSellerOrdersResponse) GetLastID() int64 {
 	if m != nil {
-		return m.StartTime
+		return m.LastID
 	}
-	return ""
+	return 0
 }
 
-func (m *GetActivityResponse) GetEndTime() string {
+func (m *ListSellerOrdersResponse) GetLastPrice() float64 {
 	if m != nil {
-		return m.EndTime
+		return m.LastPrice
 	}
-	return ""
+	return 0
 }
 
-type GetPayOrderPersonTimeRequest struct {
-	Param                string   `protobuf:"bytes,1,opt,name=param,proto3" json:"param,omitempty"`
+type GetSellerSalesSummaryRequest struct {
+	StartTime            int64    `protobuf:"varint,1,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime              int64    `protobuf:"varint,2,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	TopN                 uint32   `protobuf:"varint,3,opt,name=topN,proto3" json:"topN,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetPayOrderPersonTimeRequest) Reset()         { *m = GetPayOrderPersonTimeRequest{} }
-func (m *GetPayOrderPersonTimeRequest) String() string { return proto.CompactTextString(m) }
-func (*GetPayOrderPersonTimeRequest) ProtoMessage()    {}
-func (*GetPayOrderPersonTimeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{13}
+func (m *GetSellerSalesSummaryRequest) Reset()         { *m = GetSellerSalesSummaryRequest{} }
+func (m *GetSellerSalesSummaryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSellerSalesSummaryRequest) ProtoMessage()    {}
+func (*GetSellerSalesSummaryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{54}
 }
 
-func (m *GetPayOrderPersonTimeRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPayOrderPersonTimeRequest.Unmarshal(m, b)
+func (m *GetSellerSalesSummaryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSellerSalesSummaryRequest.Unmarshal(m, b)
 }
-func (m *GetPayOrderPersonTimeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPayOrderPersonTimeRequest.Marshal(b, m, deterministic)
+func (m *GetSellerSalesSummaryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSellerSalesSummaryRequest.Marshal(b, m, deterministic)
 }
-func (m *GetPayOrderPersonTimeRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPayOrderPersonTimeRequest.Merge(m, src)
+func (m *GetSellerSalesSummaryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSellerSalesSummaryRequest.Merge(m, src)
 }
-func (m *GetPayOrderPersonTimeRequest) XXX_Size() int {
-	return xxx_messageInfo_GetPayOrderPersonTimeRequest.Size(m)
+func (m *GetSellerSalesSummaryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSellerSalesSummaryRequest.Size(m)
 }
-func (m *GetPayOrderPersonTimeRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPayOrderPersonTimeRequest.DiscardUnknown(m)
+func (m *GetSellerSalesSummaryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSellerSalesSummaryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPayOrderPersonTimeRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetSellerSalesSummaryRequest proto.InternalMessageInfo
 
-func (m *GetPayOrderPersonTimeRequest) GetParam() string {
+func (m *GetSellerSalesSummaryRequest) GetStartTime() int64 {
 	if m != nil {
-		return m.Param
+		return m.StartTime
 	}
-	return ""
+	return 0
+}
+
+func (m *GetSellerSalesSummaryRequest) GetEndTime() int64 {
+	if m != nil {
+		return m.EndTime
+	}
+	return 0
 }
 
-type GetPayOrderPersonTimeResponse struct {
-	Code                  uint32           `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
-	CodeMsg               string           `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
-	PersonTime            int64            `protobuf:"varint,3,opt,name=personTime,proto3" json:"personTime,omitempty"`
-	PersonList            []string         `protobuf:"bytes,4,rep,name=personList,proto3" json:"personList,omitempty"`
-	PersonTimeByTimestamp int64            `protobuf:"varint,5,opt,name=personTimeByTimestamp,proto3" json:"personTimeByTimestamp,omitempty"`
-	PersonListMap         map[string]int64 `protobuf:"bytes,6,rep,name=personListMap,proto3" json:"personListMap,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
-	PersonTimeEstimate    int64            `protobuf:"varint,7,opt,name=personTimeEstimate,proto3" json:"personTimeEstimate,omitempty"`
-	XXX_NoUnkeyedLiteral  struct{}         `json:"-"`
-	XXX_unrecognized      []byte           `json:"-"`
-	XXX_sizecache         int32            `json:"-"`
+func (m *GetSellerSalesSummaryRequest) GetTopN() uint32 {
+	if m != nil {
+		return m.TopN
+	}
+	return 0
 }
 
-func (m *GetPayOrderPersonTimeResponse) Reset()         { *m = GetPayOrderPersonTimeResponse{} }
-func (m *GetPayOrderPersonTimeResponse) String() string { return proto.CompactTextString(m) }
-func (*GetPayOrderPersonTimeResponse) ProtoMessage()    {}
-func (*GetPayOrderPersonTimeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_cd01338c35d87077, []int{14}
+type GetSellerSalesSummaryResponse struct {
+	Code                 uint32        `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string        `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	TotalOrders          int64         `protobuf:"varint,3,opt,name=totalOrders,proto3" json:"totalOrders,omitempty"`
+	PaidOrders           int64         `protobuf:"varint,4,opt,name=paidOrders,proto3" json:"paidOrders,omitempty"`
+	Gmv                  float64       `protobuf:"fixed64,5,opt,name=gmv,proto3" json:"gmv,omitempty"`
+	RefundedOrders       int64         `protobuf:"varint,6,opt,name=refundedOrders,proto3" json:"refundedOrders,omitempty"`
+	RefundAmount         float64       `protobuf:"fixed64,7,opt,name=refundAmount,proto3" json:"refundAmount,omitempty"`
+	TopGoods             []*GoodsSales `protobuf:"bytes,8,rep,name=topGoods,proto3" json:"topGoods,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetSellerSalesSummaryResponse) Reset()         { *m = GetSellerSalesSummaryResponse{} }
+func (m *GetSellerSalesSummaryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSellerSalesSummaryResponse) ProtoMessage()    {}
+func (*GetSellerSalesSummaryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cd01338c35d87077, []int{55}
 }
 
-func (m *GetPayOrderPersonTimeResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetPayOrderPersonTimeResponse.Unmarshal(m, b)
+func (m *GetSellerSalesSummaryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSellerSalesSummaryResponse.Unmarshal(m, b)
 }
-func (m *GetPayOrderPersonTimeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetPayOrderPersonTimeResponse.Marshal(b, m, deterministic)
+func (m *GetSellerSalesSummaryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSellerSalesSummaryResponse.Marshal(b, m, deterministic)
 }
-func (m *GetPayOrderPersonTimeResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetPayOrderPersonTimeResponse.Merge(m, src)
+func (m *GetSellerSalesSummaryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSellerSalesSummaryResponse.Merge(m, src)
 }
-func (m *GetPayOrderPersonTimeResponse) XXX_Size() int {
-	return xxx_messageInfo_GetPayOrderPersonTimeResponse.Size(m)
+func (m *GetSellerSalesSummaryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSellerSalesSummaryResponse.Size(m)
 }
-func (m *GetPayOrderPersonTimeResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetPayOrderPersonTimeResponse.DiscardUnknown(m)
+func (m *GetSellerSalesSummaryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSellerSalesSummaryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetPayOrderPersonTimeResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetSellerSalesSummaryResponse proto.InternalMessageInfo
 
-func (m *GetPayOrderPersonTimeResponse) GetCode() uint32 {
+func (m *GetSellerSalesSummaryResponse) GetCode() uint32 {
 	if m != nil {
 		return m.Code
 	}
 	return 0
 }
 
-func (m *GetPayOrderPersonTimeResponse) GetCodeMsg() string {
+func (m *GetSellerSalesSummaryResponse) GetCodeMsg() string {
 	if m != nil {
 		return m.CodeMsg
 	}
 	return ""
 }
 
-func (m *GetPayOrderPersonTimeResponse) GetPersonTime() int64 {
+func (m *GetSellerSalesSummaryResponse) GetTotalOrders() int64 {
 	if m != nil {
-		return m.PersonTime
+		return m.TotalOrders
 	}
 	return 0
 }
 
-func (m *GetPayOrderPersonTimeResponse) GetPersonList() []string {
+func (m *GetSellerSalesSummaryResponse) GetPaidOrders() int64 {
 	if m != nil {
-		return m.PersonList
+		return m.PaidOrders
 	}
-	return nil
+	return 0
 }
 
-func (m *GetPayOrderPersonTimeResponse) GetPersonTimeByTimestamp() int64 {
+func (m *GetSellerSalesSummaryResponse) GetGmv() float64 {
 	if m != nil {
-		return m.PersonTimeByTimestamp
+		return m.Gmv
 	}
 	return 0
 }
 
-func (m *GetPayOrderPersonTimeResponse) GetPersonListMap() map[string]int64 {
+func (m *GetSellerSalesSummaryResponse) GetRefundedOrders() int64 {
 	if m != nil {
-		return m.PersonListMap
+		return m.RefundedOrders
 	}
-	return nil
+	return 0
 }
 
-func (m *GetPayOrderPersonTimeResponse) GetPersonTimeEstimate() int64 {
+func (m *GetSellerSalesSummaryResponse) GetRefundAmount() float64 {
 	if m != nil {
-		return m.PersonTimeEstimate
+		return m.RefundAmount
 	}
 	return 0
 }
 
+func (m *GetSellerSalesSummaryResponse) GetTopGoods() []*GoodsSales {
+	if m != nil {
+		return m.TopGoods
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*OrderInfo)(nil), "order.OrderInfo")
+	proto.RegisterType((*OrderItem)(nil), "order.OrderItem")
 	proto.RegisterType((*AddOrderRequest)(nil), "order.AddOrderRequest")
 	proto.RegisterType((*AddOrderResponse)(nil), "order.AddOrderResponse")
+	proto.RegisterType((*CartItem)(nil), "order.CartItem")
+	proto.RegisterType((*CheckoutRequest)(nil), "order.CheckoutRequest")
+	proto.RegisterType((*CheckoutResponse)(nil), "order.CheckoutResponse")
 	proto.RegisterType((*GetOrderRequest)(nil), "order.GetOrderRequest")
 	proto.RegisterType((*GetOrderResponse)(nil), "order.GetOrderResponse")
+	proto.RegisterType((*ListOrdersRequest)(nil), "order.ListOrdersRequest")
+	proto.RegisterType((*ListOrdersResponse)(nil), "order.ListOrdersResponse")
 	proto.RegisterType((*DelOrderRequest)(nil), "order.DelOrderRequest")
 	proto.RegisterType((*DelOrderResponse)(nil), "order.DelOrderResponse")
+	proto.RegisterType((*RestoreOrderRequest)(nil), "order.RestoreOrderRequest")
+	proto.RegisterType((*RestoreOrderResponse)(nil), "order.RestoreOrderResponse")
 	proto.RegisterType((*PayOrderRequest)(nil), "order.PayOrderRequest")
 	proto.RegisterType((*PayOrderResponse)(nil), "order.PayOrderResponse")
+	proto.RegisterType((*GetPaymentRequest)(nil), "order.GetPaymentRequest")
+	proto.RegisterType((*GetPaymentResponse)(nil), "order.GetPaymentResponse")
+	proto.RegisterType((*CancelOrderRequest)(nil), "order.CancelOrderRequest")
+	proto.RegisterType((*CancelOrderResponse)(nil), "order.CancelOrderResponse")
+	proto.RegisterType((*RefundOrderRequest)(nil), "order.RefundOrderRequest")
+	proto.RegisterType((*RefundOrderResponse)(nil), "order.RefundOrderResponse")
+	proto.RegisterType((*SagaStepLog)(nil), "order.SagaStepLog")
+	proto.RegisterType((*SagaInfo)(nil), "order.SagaInfo")
+	proto.RegisterType((*GetOrderSagaRequest)(nil), "order.GetOrderSagaRequest")
+	proto.RegisterType((*GetOrderSagaResponse)(nil), "order.GetOrderSagaResponse")
+	proto.RegisterType((*ShipOrderRequest)(nil), "order.ShipOrderRequest")
+	proto.RegisterType((*ShipOrderResponse)(nil), "order.ShipOrderResponse")
+	proto.RegisterType((*CompleteOrderRequest)(nil), "order.CompleteOrderRequest")
+	proto.RegisterType((*CompleteOrderResponse)(nil), "order.CompleteOrderResponse")
+	proto.RegisterType((*OrderStatusHistory)(nil), "order.OrderStatusHistory")
+	proto.RegisterType((*GetOrderHistoryRequest)(nil), "order.GetOrderHistoryRequest")
+	proto.RegisterType((*GetOrderHistoryResponse)(nil), "order.GetOrderHistoryResponse")
+	proto.RegisterType((*Promotion)(nil), "order.Promotion")
 	proto.RegisterType((*SetActivityRequest)(nil), "order.SetActivityRequest")
 	proto.RegisterType((*SetActivityResponse)(nil), "order.SetActivityResponse")
 	proto.RegisterType((*GetActivityRequest)(nil), "order.GetActivityRequest")
 	proto.RegisterType((*GetActivityResponse)(nil), "order.GetActivityResponse")
-	proto.RegisterType((*GetPayOrderPersonTimeRequest)(nil), "order.GetPayOrderPersonTimeRequest")
-	proto.RegisterType((*GetPayOrderPersonTimeResponse)(nil), "order.GetPayOrderPersonTimeResponse")
-	proto.RegisterMapType((map[string]int64)(nil), "order.GetPayOrderPersonTimeResponse.PersonListMapEntry")
+	proto.RegisterType((*SetFlashSaleRequest)(nil), "order.SetFlashSaleRequest")
+	proto.RegisterType((*SetFlashSaleResponse)(nil), "order.SetFlashSaleResponse")
+	proto.RegisterType((*GetFlashSaleRequest)(nil), "order.GetFlashSaleRequest")
+	proto.RegisterType((*GetFlashSaleResponse)(nil), "order.GetFlashSaleResponse")
+	proto.RegisterType((*GetUniquePayersRequest)(nil), "order.GetUniquePayersRequest")
+	proto.RegisterType((*PayersBucket)(nil), "order.PayersBucket")
+	proto.RegisterType((*GetUniquePayersResponse)(nil), "order.GetUniquePayersResponse")
+	proto.RegisterType((*GetSalesStatsRequest)(nil), "order.GetSalesStatsRequest")
+	proto.RegisterType((*SalesBucket)(nil), "order.SalesBucket")
+	proto.RegisterType((*GetSalesStatsResponse)(nil), "order.GetSalesStatsResponse")
+	proto.RegisterType((*GetTopGoodsRequest)(nil), "order.GetTopGoodsRequest")
+	proto.RegisterType((*GoodsSales)(nil), "order.GoodsSales")
+	proto.RegisterType((*GetTopGoodsResponse)(nil), "order.GetTopGoodsResponse")
+	proto.RegisterType((*ListSellerOrdersRequest)(nil), "order.ListSellerOrdersRequest")
+	proto.RegisterType((*ListSellerOrdersResponse)(nil), "order.ListSellerOrdersResponse")
+	proto.RegisterType((*GetSellerSalesSummaryRequest)(nil), "order.GetSellerSalesSummaryRequest")
+	proto.RegisterType((*GetSellerSalesSummaryResponse)(nil), "order.GetSellerSalesSummaryResponse")
 }
 
 func init() { proto.RegisterFile("order.proto", fileDescriptor_cd01338c35d87077) }
 
 var fileDescriptor_cd01338c35d87077 = []byte{
-	// 687 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xdd, 0x6e, 0xd3, 0x30,
-	0x14, 0x5e, 0x96, 0xb6, 0x5b, 0x4f, 0x37, 0xad, 0xf2, 0xd8, 0x08, 0xd5, 0x40, 0x55, 0xe0, 0xa2,
-	0x12, 0x52, 0x2f, 0xc6, 0x24, 0x10, 0x12, 0xd2, 0x06, 0x1d, 0x51, 0x25, 0x06, 0x55, 0xc6, 0x15,
-	0x88, 0x0b, 0xaf, 0x31, 0x53, 0x44, 0x9b, 0x84, 0xd8, 0xad, 0x94, 0x3b, 0x1e, 0x81, 0x0b, 0xde,
-	0x87, 0x47, 0xe0, 0x95, 0x90, 0xed, 0x38, 0x4e, 0xd2, 0xb4, 0x2b, 0xe5, 0x66, 0xf3, 0xf9, 0xfb,
-	0xce, 0xe7, 0x93, 0xe3, 0x6f, 0x83, 0x56, 0x18, 0x7b, 0x24, 0xee, 0x47, 0x71, 0xc8, 0x42, 0x54,
-	0x17, 0x86, 0xfd, 0xc7, 0x80, 0xe6, 0x07, 0x7e, 0x1a, 0x06, 0x5f, 0x43, 0xd4, 0x81, 0x5d, 0x4a,
-	0x26, 0x13, 0x12, 0x0f, 0x07, 0x96, 0xd1, 0x35, 0x7a, 0x4d, 0x37, 0xb3, 0x91, 0x05, 0x3b, 0x37,
-	0xb3, 0x44, 0x84, 0xb6, 0x45, 0x48, 0x99, 0x3c, 0x72, 0x1b, 0x86, 0x1e, 0x1d, 0x0e, 0x2c, 0x53,
-	0x46, 0x52, 0x13, 0x9d, 0x40, 0x53, 0x1c, 0xdf, 0xe3, 0x29, 0xb1, 0x6a, 0x22, 0xa6, 0x1d, 0xe8,
-	0x1e, 0xd4, 0xc7, 0xe1, 0x2c, 0x60, 0x56, 0xbd, 0x6b, 0xf4, 0xf6, 0x5d, 0x69, 0x70, 0x6f, 0x14,
-	0xfb, 0x63, 0x62, 0x35, 0xba, 0x46, 0xcf, 0x70, 0xa5, 0x81, 0xda, 0x60, 0x46, 0x38, 0xb1, 0x76,
-	0x84, 0x8f, 0x1f, 0xd1, 0x31, 0x34, 0x28, 0xc3, 0x6c, 0x46, 0xad, 0x5d, 0x51, 0x9e, 0x5a, 0xf6,
-	0x67, 0x38, 0xb8, 0xf0, 0x3c, 0x71, 0x27, 0x97, 0x7c, 0x9f, 0x11, 0xca, 0xf2, 0xd4, 0x8d, 0xa5,
-	0xd4, 0xb7, 0x8b, 0xd4, 0x33, 0x72, 0x66, 0x8e, 0x9c, 0xfd, 0x09, 0xda, 0x1a, 0x9c, 0x46, 0x61,
-	0x40, 0x09, 0x42, 0x50, 0x1b, 0x87, 0x1e, 0x11, 0xd0, 0xfb, 0xae, 0x38, 0x73, 0x5c, 0xfe, 0xfb,
-	0x8a, 0xde, 0x2a, 0xdc, 0xd4, 0xe4, 0x11, 0x31, 0x79, 0x3d, 0xac, 0xd4, 0xb4, 0x9f, 0xc2, 0x81,
-	0x43, 0x58, 0x99, 0xb8, 0x4a, 0x36, 0x8a, 0xc9, 0x11, 0xb4, 0x75, 0xf2, 0x46, 0x44, 0xfa, 0xd0,
-	0x0c, 0xd5, 0x87, 0x17, 0x54, 0x5a, 0xa7, 0xed, 0xbe, 0xdc, 0x90, 0x6c, 0x21, 0x5c, 0x9d, 0xc2,
-	0xe9, 0x0d, 0xc8, 0x64, 0x4d, 0x7a, 0xe7, 0xd0, 0xd6, 0xc9, 0x9b, 0xd0, 0xe3, 0xed, 0x46, 0x38,
-	0x59, 0xbf, 0x9d, 0x4e, 0xde, 0xa8, 0xdd, 0x4f, 0x03, 0xd0, 0x35, 0x61, 0x17, 0x63, 0xe6, 0xcf,
-	0x7d, 0x96, 0xa8, 0x96, 0x8f, 0x00, 0x70, 0xea, 0xca, 0xba, 0xe6, 0x3c, 0xc8, 0x86, 0x3d, 0x65,
-	0x89, 0x1d, 0x97, 0xa8, 0x05, 0x1f, 0x7f, 0x04, 0x94, 0xe1, 0x98, 0x7d, 0xf4, 0xa7, 0x24, 0xfd,
-	0xe6, 0xda, 0xc1, 0x29, 0x91, 0xc0, 0x13, 0x31, 0xf9, 0x40, 0x94, 0x69, 0xbf, 0x81, 0xc3, 0x02,
-	0xa3, 0x8d, 0xee, 0x75, 0x06, 0xc8, 0xf9, 0xe7, 0x6b, 0xd9, 0xbf, 0x0d, 0x38, 0x74, 0xfe, 0xb7,
-	0x77, 0xa9, 0x8b, 0x79, 0xe7, 0xf0, 0x6a, 0x77, 0x0d, 0xaf, 0xbe, 0x62, 0x78, 0x8d, 0xe2, 0xf0,
-	0xce, 0xe0, 0xc4, 0x21, 0x4c, 0x2d, 0xc5, 0x88, 0xc4, 0x34, 0x0c, 0x78, 0x40, 0x4d, 0x80, 0xab,
-	0x0c, 0x8e, 0xf1, 0x34, 0xbd, 0xbc, 0x34, 0xec, 0x5f, 0x26, 0x3c, 0x5c, 0x52, 0xb6, 0xe9, 0x04,
-	0xa2, 0x0c, 0x43, 0x4c, 0xc0, 0x74, 0x73, 0x1e, 0x1d, 0x7f, 0xe7, 0x53, 0x66, 0xd5, 0xba, 0x26,
-	0x9f, 0x90, 0xf6, 0xa0, 0x33, 0x38, 0xd2, 0xd9, 0xaf, 0x13, 0xfe, 0x93, 0x32, 0x3c, 0x8d, 0xc4,
-	0x24, 0x4c, 0xb7, 0x3a, 0x88, 0xbe, 0xc0, 0xbe, 0xc6, 0xb8, 0xc2, 0x91, 0xd5, 0xe8, 0x9a, 0xbd,
-	0xd6, 0xe9, 0xf3, 0xf4, 0x75, 0xaf, 0xbc, 0x60, 0x7f, 0x94, 0xaf, 0xbc, 0x0c, 0x58, 0x9c, 0xb8,
-	0x45, 0x34, 0xd4, 0x07, 0xa4, 0xfb, 0x5e, 0x52, 0xe6, 0x4f, 0x31, 0x23, 0x42, 0x99, 0x4d, 0xb7,
-	0x22, 0xd2, 0x39, 0x07, 0xb4, 0x08, 0xca, 0x05, 0xfd, 0x1b, 0x49, 0xd2, 0xf1, 0xf3, 0x23, 0xff,
-	0x24, 0x73, 0x3c, 0x99, 0xc9, 0x47, 0x64, 0xba, 0xd2, 0x78, 0xb9, 0xfd, 0xc2, 0x38, 0xfd, 0x51,
-	0x83, 0x3d, 0xc1, 0xf7, 0x9a, 0xc4, 0x73, 0xfe, 0xd7, 0xe0, 0x15, 0xec, 0x2a, 0x19, 0x46, 0xc7,
-	0xe9, 0xb5, 0x4a, 0xa2, 0xdf, 0xb9, 0xbf, 0xe0, 0x97, 0x37, 0xb4, 0xb7, 0x78, 0xb9, 0x12, 0xcf,
-	0xac, 0xbc, 0x24, 0xbd, 0x59, 0x79, 0x59, 0x65, 0x65, 0xb9, 0x12, 0xb7, 0xac, 0xbc, 0x24, 0x8d,
-	0x59, 0x79, 0x59, 0x05, 0x65, 0xb9, 0x9a, 0x7f, 0x56, 0x5e, 0x92, 0xba, 0xac, 0xbc, 0xac, 0x6a,
-	0xf6, 0x16, 0x7a, 0x0b, 0xad, 0x9c, 0x2c, 0xa0, 0x07, 0x69, 0xe6, 0xa2, 0x78, 0x75, 0x3a, 0x55,
-	0xa1, 0x3c, 0x8e, 0x53, 0x81, 0xe3, 0x2c, 0xc7, 0x71, 0x2a, 0x71, 0x3c, 0x38, 0xaa, 0xdc, 0x28,
-	0xf4, 0x78, 0xf5, 0xbe, 0x49, 0xec, 0x27, 0xeb, 0x2c, 0xa5, 0xbd, 0x75, 0xd3, 0x10, 0xff, 0xb5,
-	0x3c, 0xfb, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x32, 0x64, 0x0a, 0x51, 0xc4, 0x08, 0x00, 0x00,
+	// 2270 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x8e, 0x1c, 0x49,
+	0xf1, 0xff, 0x57, 0x57, 0x77, 0x4f, 0x77, 0xcc, 0xb4, 0x67, 0x5c, 0x33, 0x3b, 0x53, 0x2e, 0x7b,
+	0xe7, 0x3f, 0x2a, 0x8c, 0x19, 0xc9, 0xd8, 0x20, 0x83, 0x84, 0x84, 0x00, 0xad, 0x77, 0x7a, 0xb7,
+	0xb7, 0x91, 0xd7, 0xb4, 0x72, 0xec, 0x2b, 0x6c, 0xb9, 0x2b, 0xa7, 0xa7, 0x70, 0x75, 0x57, 0xb9,
+	0x2a, 0xcb, 0xab, 0x3e, 0x20, 0x71, 0xe3, 0xc4, 0x85, 0x13, 0x87, 0x95, 0x90, 0xb8, 0x20, 0xc1,
+	0x03, 0x70, 0x45, 0x88, 0x13, 0x6f, 0xc0, 0x0b, 0x70, 0xe5, 0x15, 0x50, 0x7e, 0x56, 0xd6, 0x67,
+	0x0f, 0x8d, 0x7d, 0xe0, 0x34, 0x15, 0x91, 0x99, 0x91, 0x11, 0xbf, 0x88, 0x8c, 0x8c, 0x8c, 0x1e,
+	0xd8, 0x8d, 0x12, 0x1f, 0x27, 0x8f, 0xe3, 0x24, 0x22, 0x91, 0xd5, 0x63, 0x84, 0xfb, 0x07, 0x13,
+	0x86, 0x3f, 0xa1, 0x5f, 0xd3, 0xd5, 0x55, 0x64, 0x39, 0x30, 0x48, 0x71, 0x18, 0xe2, 0x64, 0x3a,
+	0xb6, 0x8d, 0x33, 0xe3, 0x7c, 0x88, 0x14, 0x6d, 0xd9, 0xb0, 0xf3, 0x2a, 0x5b, 0xb3, 0xa1, 0x0e,
+	0x1b, 0x92, 0x24, 0x1d, 0x59, 0x44, 0x91, 0x9f, 0x4e, 0xc7, 0xb6, 0xc9, 0x47, 0x04, 0x69, 0xdd,
+	0x83, 0x21, 0xfb, 0x7c, 0xee, 0x2d, 0xb1, 0xdd, 0x65, 0x63, 0x39, 0xc3, 0x3a, 0x82, 0xde, 0x3c,
+	0xca, 0x56, 0xc4, 0xee, 0x9d, 0x19, 0xe7, 0x23, 0xc4, 0x09, 0xca, 0x8d, 0x93, 0x60, 0x8e, 0xed,
+	0xfe, 0x99, 0x71, 0x6e, 0x20, 0x4e, 0x58, 0x07, 0x60, 0xc6, 0xde, 0xda, 0xde, 0x61, 0x3c, 0xfa,
+	0x69, 0x1d, 0x43, 0x3f, 0x25, 0x1e, 0xc9, 0x52, 0x7b, 0xc0, 0x96, 0x0b, 0x8a, 0xad, 0xf7, 0xd6,
+	0xd3, 0xb1, 0x3d, 0x64, 0xfb, 0x71, 0x82, 0xea, 0xc8, 0x0c, 0x9e, 0x8e, 0x6d, 0xe0, 0x3a, 0x0a,
+	0xd2, 0x7a, 0x00, 0xbd, 0x80, 0xe0, 0x65, 0x6a, 0xef, 0x9e, 0x99, 0xe7, 0xbb, 0x4f, 0x0e, 0x1e,
+	0x73, 0x94, 0x38, 0x28, 0x04, 0x2f, 0x11, 0x1f, 0xb6, 0x4e, 0x01, 0xbc, 0x39, 0x09, 0xde, 0x06,
+	0x84, 0x0a, 0xdf, 0x63, 0x42, 0x34, 0x0e, 0xc5, 0xce, 0x0f, 0x52, 0x6e, 0xd0, 0x88, 0xa9, 0xa9,
+	0x68, 0x3a, 0x36, 0x8f, 0xb2, 0x38, 0x5a, 0x4d, 0xc7, 0xf6, 0x2d, 0x8e, 0xab, 0xa4, 0xad, 0x07,
+	0x70, 0x8b, 0x7f, 0x8f, 0xe5, 0xea, 0x7d, 0xb6, 0xba, 0xc4, 0x75, 0xff, 0x61, 0x48, 0x4f, 0x11,
+	0xbc, 0xd4, 0x31, 0x37, 0x5a, 0x30, 0xef, 0xd4, 0x60, 0xce, 0xd1, 0x35, 0x75, 0x74, 0x95, 0x27,
+	0xba, 0xba, 0x27, 0x8e, 0xa1, 0xef, 0x2d, 0x95, 0x83, 0x0c, 0x24, 0xa8, 0x12, 0x12, 0xfd, 0x56,
+	0x24, 0x76, 0x4a, 0x48, 0x1c, 0x41, 0x2f, 0x7d, 0x9d, 0x4d, 0xc7, 0xcc, 0x69, 0x43, 0xc4, 0x09,
+	0xf7, 0xd7, 0x06, 0xec, 0x3f, 0xf5, 0x7d, 0x66, 0x1e, 0xc2, 0x6f, 0x32, 0x9c, 0x12, 0x3d, 0xde,
+	0x8c, 0xc6, 0x78, 0xeb, 0x14, 0x6d, 0x57, 0x76, 0x98, 0xba, 0x1d, 0x3a, 0xfa, 0xdd, 0x12, 0xfa,
+	0x4a, 0x9f, 0x9e, 0xae, 0x4f, 0x02, 0x07, 0xb9, 0x3a, 0x69, 0x1c, 0xad, 0x52, 0x6c, 0x59, 0xd0,
+	0x9d, 0x47, 0x3e, 0x66, 0xca, 0x8c, 0x10, 0xfb, 0xa6, 0x9a, 0xd0, 0xbf, 0x9f, 0xa7, 0x0b, 0xa9,
+	0x89, 0x20, 0xf5, 0x78, 0x33, 0x8b, 0xf1, 0x76, 0x0c, 0xfd, 0x37, 0x19, 0xce, 0xb0, 0xcf, 0x74,
+	0x19, 0x20, 0x41, 0xb9, 0x33, 0x18, 0x5c, 0x78, 0x09, 0xd9, 0xe0, 0x5d, 0x65, 0x61, 0xa7, 0x74,
+	0x66, 0xb8, 0x15, 0xa6, 0x6e, 0xc5, 0x0a, 0xf6, 0x2f, 0xae, 0xf1, 0xfc, 0x75, 0x94, 0x91, 0xcd,
+	0xa0, 0x7e, 0x5d, 0x1e, 0x83, 0x0e, 0x3b, 0x06, 0xfb, 0xe2, 0x18, 0x48, 0x95, 0xe4, 0x29, 0xd0,
+	0xb1, 0x34, 0x8b, 0x58, 0xba, 0xbf, 0x37, 0xe0, 0x20, 0xdf, 0xf0, 0x1d, 0xc3, 0x76, 0x1f, 0x46,
+	0x57, 0x5e, 0x10, 0x62, 0x7f, 0x22, 0x80, 0xe1, 0x9e, 0x2c, 0x32, 0xad, 0x33, 0xd8, 0xe5, 0x8c,
+	0x4b, 0xcd, 0xa9, 0x3a, 0xcb, 0x7d, 0x08, 0xfb, 0x13, 0x4c, 0xca, 0x91, 0x26, 0x37, 0x35, 0x0a,
+	0x9b, 0xba, 0x31, 0x1c, 0xe4, 0x93, 0xb7, 0x32, 0xe8, 0x31, 0x0c, 0x23, 0x99, 0x5e, 0x99, 0x49,
+	0xe5, 0x0c, 0xb3, 0xba, 0x8a, 0x50, 0x3e, 0xc5, 0xfd, 0x73, 0x07, 0x6e, 0x3f, 0x0b, 0x52, 0xbe,
+	0x67, 0xba, 0xd9, 0x6d, 0x7a, 0xc6, 0xee, 0x54, 0x33, 0x76, 0x43, 0x5e, 0xa6, 0xab, 0x58, 0xb6,
+	0xc4, 0xa9, 0xdd, 0x3d, 0x33, 0xcf, 0x47, 0x48, 0xd1, 0x34, 0x7f, 0xa4, 0xc4, 0x4b, 0xc8, 0x8b,
+	0x60, 0x89, 0x19, 0x80, 0x26, 0xca, 0x19, 0x54, 0x26, 0x5e, 0xf9, 0x6c, 0xac, 0xcf, 0xc6, 0x24,
+	0xc9, 0xf2, 0x71, 0x94, 0x90, 0x8f, 0x79, 0x92, 0x1e, 0x22, 0x41, 0xd1, 0xcc, 0xed, 0xa5, 0x73,
+	0x76, 0xde, 0x07, 0x88, 0x7e, 0xd2, 0xfc, 0xb1, 0xca, 0x96, 0x33, 0x9c, 0xcc, 0xbc, 0x05, 0x66,
+	0x69, 0xda, 0x44, 0x1a, 0x87, 0x4a, 0x0a, 0xbd, 0x94, 0x88, 0x54, 0x6d, 0x22, 0x41, 0x51, 0xcd,
+	0xe8, 0xd7, 0x8c, 0xe5, 0xaf, 0x5d, 0x96, 0x58, 0x72, 0x86, 0xfb, 0x37, 0x03, 0x2c, 0x1d, 0xb9,
+	0xad, 0xdc, 0xf5, 0x6d, 0x00, 0xe5, 0x8b, 0xd4, 0x36, 0xcf, 0xcc, 0x5a, 0x7f, 0x69, 0x73, 0xa8,
+	0xac, 0x6b, 0x2f, 0xfd, 0x3c, 0x4a, 0xb0, 0x38, 0xcf, 0x92, 0xd4, 0xcc, 0xe8, 0x35, 0x9b, 0xd1,
+	0x2f, 0x9b, 0xf1, 0x10, 0xf6, 0xc7, 0x38, 0xbc, 0x61, 0x7c, 0x7e, 0x04, 0x07, 0xf9, 0xe4, 0x6d,
+	0x0c, 0x76, 0xbf, 0x05, 0x87, 0x08, 0xa7, 0x24, 0x4a, 0xf0, 0x0d, 0xb7, 0x1c, 0xc3, 0x51, 0x71,
+	0xc1, 0x56, 0xdb, 0x3e, 0x84, 0xfd, 0x99, 0xb7, 0xbe, 0xf9, 0x29, 0xcc, 0x27, 0x6f, 0xe5, 0x56,
+	0x55, 0x13, 0x98, 0xa5, 0x9a, 0x20, 0xc6, 0x2b, 0x3f, 0x58, 0x2d, 0xa4, 0xeb, 0x04, 0xe9, 0x3e,
+	0x82, 0xdb, 0x13, 0x4c, 0x66, 0xde, 0x7a, 0x89, 0x57, 0x64, 0xb3, 0x82, 0xff, 0x34, 0xc0, 0xd2,
+	0xe7, 0xbf, 0x43, 0x1d, 0x1d, 0x18, 0xc4, 0x49, 0xf4, 0x36, 0xf0, 0x71, 0x22, 0xef, 0x2e, 0x49,
+	0x53, 0x59, 0x24, 0xf1, 0x7c, 0xfc, 0x3c, 0x12, 0x89, 0x4e, 0x92, 0xda, 0xcd, 0xdd, 0x2f, 0xdc,
+	0xdc, 0x0f, 0xe0, 0x56, 0x82, 0xaf, 0xb2, 0x95, 0x8f, 0xfd, 0xa7, 0x4b, 0xed, 0x7e, 0x2e, 0x71,
+	0x9b, 0x6a, 0x2b, 0xf7, 0x0b, 0xb0, 0x2e, 0xbc, 0xd5, 0xfc, 0xa6, 0xf1, 0xd9, 0x52, 0x33, 0x1e,
+	0x43, 0x3f, 0xc1, 0x5e, 0x1a, 0xad, 0x84, 0xb9, 0x82, 0x72, 0x7f, 0x06, 0x87, 0x85, 0x1d, 0xb6,
+	0x82, 0xd2, 0x81, 0x01, 0x37, 0x28, 0xbf, 0xa4, 0x24, 0x4d, 0x4d, 0x40, 0xec, 0xfb, 0xbd, 0x99,
+	0xf0, 0x25, 0x1c, 0x16, 0x76, 0x78, 0xd7, 0x26, 0x68, 0xde, 0xed, 0xea, 0xde, 0x75, 0x7f, 0x67,
+	0xc0, 0xee, 0xa5, 0xb7, 0xf0, 0x2e, 0x09, 0x8e, 0x9f, 0x45, 0x0b, 0xba, 0x63, 0x4a, 0x70, 0x2c,
+	0x77, 0xa4, 0xdf, 0x3c, 0xf3, 0xe3, 0x58, 0x2b, 0x0e, 0x15, 0xcd, 0xe4, 0xce, 0x49, 0x20, 0x0c,
+	0x1a, 0x21, 0x41, 0x71, 0x43, 0xd3, 0x2c, 0x94, 0xe5, 0xa1, 0xa0, 0x28, 0x1f, 0x27, 0x09, 0x55,
+	0x9e, 0x87, 0x9f, 0xa0, 0xa8, 0x55, 0x61, 0xb4, 0xd0, 0xef, 0x08, 0x41, 0xba, 0x5f, 0x75, 0x60,
+	0x40, 0x35, 0x64, 0x8f, 0x0d, 0x1a, 0x64, 0xf4, 0x5b, 0x42, 0x2e, 0x28, 0xa6, 0xa2, 0xb7, 0xf0,
+	0x5e, 0xac, 0xe3, 0x5c, 0x45, 0x41, 0xb7, 0xd4, 0x07, 0xea, 0xf8, 0x74, 0xf5, 0xe3, 0x23, 0x21,
+	0xe8, 0x35, 0x40, 0xd0, 0xaf, 0x42, 0x20, 0x02, 0x7f, 0xa7, 0xf0, 0xa8, 0x38, 0x05, 0x48, 0x30,
+	0x49, 0xd6, 0xd4, 0x0a, 0x79, 0x28, 0x34, 0x8e, 0xcc, 0xe9, 0x9f, 0x24, 0x49, 0x94, 0x88, 0x87,
+	0x47, 0xce, 0xb0, 0x1e, 0x40, 0x37, 0x8c, 0x16, 0xa9, 0x0d, 0xec, 0x3e, 0xb1, 0xc4, 0x7d, 0xa2,
+	0xb9, 0x0a, 0xb1, 0x71, 0x9a, 0x8c, 0x65, 0xb9, 0x41, 0x07, 0x37, 0x27, 0x9e, 0x14, 0x8e, 0x8a,
+	0x0b, 0xb6, 0x8a, 0xb5, 0x47, 0x30, 0x4c, 0x85, 0x53, 0xe4, 0x9d, 0xb7, 0xaf, 0xe9, 0xc8, 0x4b,
+	0x14, 0x35, 0xc3, 0xfd, 0x0c, 0x0e, 0x2e, 0xaf, 0x83, 0xf8, 0x86, 0xe7, 0xa7, 0xa5, 0x40, 0x71,
+	0x9f, 0xc2, 0x6d, 0x4d, 0xd2, 0x56, 0x17, 0xc9, 0x8f, 0xe1, 0xe8, 0x22, 0x5a, 0xc6, 0x21, 0x26,
+	0xf8, 0xbf, 0x3d, 0xd0, 0xee, 0x27, 0xf0, 0x41, 0x49, 0xd6, 0x56, 0x2a, 0xfd, 0xd6, 0x00, 0x8b,
+	0xbb, 0x84, 0xc5, 0xce, 0x67, 0x01, 0xbd, 0x2d, 0xd7, 0x34, 0x84, 0xae, 0x92, 0x68, 0xc9, 0x99,
+	0x42, 0x94, 0xc6, 0xa1, 0x40, 0x91, 0x48, 0x8c, 0xf2, 0xe2, 0x5e, 0xd1, 0x34, 0xb8, 0xbd, 0x39,
+	0x89, 0x12, 0x79, 0x37, 0x30, 0x42, 0x4b, 0x40, 0x5d, 0x3d, 0x01, 0xe9, 0xe7, 0xaf, 0x57, 0x3c,
+	0x7f, 0x4f, 0xe0, 0x58, 0xc6, 0x8b, 0x50, 0x6b, 0x73, 0x8c, 0xfd, 0xd2, 0x80, 0x93, 0xca, 0xa2,
+	0xad, 0xe2, 0xec, 0x7b, 0x30, 0xbc, 0x66, 0x02, 0x02, 0x2c, 0xe3, 0xec, 0x8e, 0x5e, 0x5b, 0x15,
+	0xf0, 0x42, 0xf9, 0x5c, 0xf7, 0x4f, 0x1d, 0x18, 0xce, 0x92, 0x68, 0x19, 0xb1, 0x74, 0x74, 0x1f,
+	0x46, 0xb1, 0x24, 0x58, 0x92, 0xe0, 0x0a, 0x17, 0x99, 0x14, 0x4e, 0x51, 0xed, 0xf2, 0x27, 0xcd,
+	0x10, 0x29, 0x9a, 0xba, 0x62, 0xee, 0x11, 0xbc, 0xc8, 0x35, 0x19, 0x21, 0x8d, 0x63, 0xb9, 0xb0,
+	0x27, 0x1f, 0xac, 0xc8, 0x23, 0x58, 0xa4, 0xd9, 0x02, 0x8f, 0x9e, 0x78, 0x72, 0x9d, 0xe0, 0xf4,
+	0x3a, 0x0a, 0x7d, 0xf1, 0x3e, 0xce, 0x19, 0x74, 0x34, 0xc1, 0x7e, 0xc6, 0xb3, 0xa9, 0xa8, 0xf1,
+	0x14, 0x83, 0xea, 0xf6, 0x2a, 0x5b, 0x5f, 0xa8, 0x0b, 0x78, 0x84, 0x14, 0x4d, 0x57, 0x5e, 0x25,
+	0x18, 0xf3, 0x41, 0x9e, 0x68, 0x72, 0x06, 0xd5, 0x2c, 0x0c, 0x96, 0x01, 0x99, 0xe1, 0xe4, 0x65,
+	0x8a, 0x79, 0xaa, 0x19, 0xa1, 0x02, 0xcf, 0xfd, 0x8b, 0x01, 0xd6, 0x25, 0x26, 0x4f, 0xc5, 0x83,
+	0x5c, 0x7a, 0xb8, 0xf8, 0x6a, 0x37, 0x2a, 0xaf, 0x76, 0x17, 0xf6, 0x24, 0xa5, 0xdd, 0x0e, 0x05,
+	0x5e, 0xf1, 0x6d, 0xc0, 0x63, 0xb1, 0xfe, 0x6d, 0xc0, 0x03, 0x52, 0x92, 0xf4, 0x15, 0xa4, 0xbc,
+	0xc3, 0xc0, 0xca, 0xab, 0x6a, 0xe5, 0x57, 0x94, 0x4f, 0x71, 0x2f, 0xe0, 0xb0, 0x60, 0xc1, 0x56,
+	0xe7, 0xf0, 0xbb, 0xac, 0x28, 0xfb, 0x0f, 0x61, 0x70, 0xff, 0x65, 0xc0, 0x61, 0x61, 0xd9, 0x56,
+	0xa1, 0x5e, 0xdc, 0xc5, 0xdc, 0x08, 0x76, 0x77, 0x13, 0xd8, 0xbd, 0x16, 0xb0, 0xfb, 0x2d, 0x60,
+	0xef, 0x6c, 0x06, 0xfb, 0x17, 0x0c, 0xec, 0x4f, 0x43, 0x2f, 0xbd, 0xbe, 0xf4, 0x42, 0x7c, 0xd3,
+	0x78, 0x69, 0xee, 0xc2, 0xd0, 0xba, 0x60, 0xe5, 0xbd, 0x0a, 0x79, 0x88, 0x0c, 0x90, 0xa0, 0xf2,
+	0xde, 0x53, 0x57, 0xeb, 0x3d, 0xd1, 0x07, 0x45, 0x71, 0xfb, 0x6d, 0xdf, 0x31, 0x93, 0x1a, 0x23,
+	0x1a, 0x1b, 0x29, 0xee, 0x5f, 0x0d, 0x38, 0x2a, 0xae, 0x78, 0x2f, 0x8e, 0xd6, 0x14, 0xe8, 0x36,
+	0xa1, 0xd4, 0xab, 0x47, 0xa9, 0x5f, 0xea, 0xd0, 0xa5, 0x24, 0x9a, 0xbf, 0x66, 0x0e, 0x35, 0x11,
+	0x27, 0xdc, 0xdf, 0x18, 0x2c, 0xa1, 0xbf, 0x5c, 0x05, 0x6f, 0x32, 0x3c, 0xf3, 0xd6, 0x5a, 0xcb,
+	0xe0, 0x0c, 0x76, 0x17, 0x89, 0xb7, 0xca, 0x42, 0x2f, 0x09, 0xc8, 0x5a, 0x58, 0xaf, 0xb3, 0x8a,
+	0xf1, 0xd5, 0x69, 0x89, 0x2f, 0xb3, 0x18, 0x5f, 0x45, 0x93, 0xbb, 0x95, 0x13, 0xf4, 0x7d, 0xd8,
+	0xe3, 0xaa, 0x7c, 0x9c, 0xcd, 0x5f, 0x63, 0x42, 0x01, 0x25, 0x54, 0x0c, 0x57, 0x81, 0x7d, 0x53,
+	0xe3, 0x63, 0x36, 0x87, 0x6d, 0x6c, 0x22, 0x41, 0xb9, 0x3f, 0x85, 0x93, 0x8a, 0x3d, 0xc2, 0x2f,
+	0x47, 0xd0, 0x23, 0x11, 0xf1, 0x42, 0x26, 0xc7, 0x44, 0x9c, 0xb0, 0x1e, 0xd1, 0xdb, 0x9c, 0x6e,
+	0x23, 0x1b, 0x57, 0x87, 0x32, 0xd4, 0x35, 0x15, 0x90, 0x9c, 0xe3, 0xc6, 0xcc, 0xe9, 0xd4, 0xdf,
+	0x29, 0xbd, 0x6d, 0xde, 0x3f, 0x5a, 0xee, 0x25, 0xad, 0xc9, 0x43, 0xdc, 0x06, 0xc6, 0x01, 0x98,
+	0x8b, 0xe5, 0x5b, 0x26, 0xd4, 0x40, 0xf4, 0x93, 0x42, 0xcc, 0xac, 0xb8, 0x50, 0xcd, 0x4c, 0x13,
+	0x69, 0x1c, 0xf7, 0x4b, 0xf8, 0xa0, 0x64, 0x86, 0x00, 0x49, 0x88, 0x32, 0x9a, 0x44, 0x75, 0xca,
+	0xa2, 0xac, 0x6f, 0xe6, 0x00, 0x9a, 0xa5, 0xf2, 0x34, 0xc4, 0x15, 0xfc, 0xbe, 0x60, 0x39, 0xf5,
+	0x45, 0x14, 0xb3, 0x86, 0x9b, 0x44, 0xaf, 0x80, 0x8d, 0xd1, 0x82, 0x4d, 0xa7, 0x18, 0x49, 0x14,
+	0x8c, 0x28, 0x7e, 0x2e, 0x9e, 0x1b, 0xec, 0xdb, 0xfd, 0x01, 0x00, 0x93, 0xcd, 0xb6, 0x6f, 0x6f,
+	0x84, 0xa6, 0x74, 0x8a, 0x30, 0x89, 0x13, 0xee, 0x8f, 0xe0, 0xb0, 0xa0, 0x9f, 0x80, 0xe5, 0x1b,
+	0xd0, 0x63, 0xeb, 0x6c, 0x83, 0x99, 0x78, 0x5b, 0x98, 0x98, 0x6f, 0x84, 0xf8, 0xb8, 0xfb, 0xab,
+	0x0e, 0x9c, 0xd0, 0x26, 0xd2, 0x25, 0x2b, 0x51, 0x2b, 0x4d, 0xb8, 0x06, 0x5d, 0xf4, 0x76, 0x5a,
+	0xa7, 0xad, 0x9d, 0x66, 0xb6, 0xb4, 0xd3, 0xba, 0x4d, 0xed, 0xb4, 0x5e, 0x5d, 0x3b, 0xad, 0xdf,
+	0xd4, 0x4e, 0xdb, 0x69, 0x69, 0xa7, 0x0d, 0x9a, 0xfb, 0x50, 0xc3, 0x72, 0x1f, 0xea, 0xef, 0x06,
+	0xd8, 0x55, 0x24, 0xfe, 0x47, 0x9b, 0x6a, 0x3f, 0x87, 0x7b, 0x13, 0x2c, 0x4c, 0xe1, 0xa7, 0x26,
+	0x5b, 0x2e, 0xbd, 0xbc, 0xfa, 0xad, 0x04, 0xb0, 0xd9, 0x12, 0xc0, 0x66, 0x7b, 0x00, 0x7f, 0xd5,
+	0x81, 0x0f, 0x1b, 0x36, 0xdb, 0x0a, 0xbd, 0x33, 0xd8, 0x65, 0xa9, 0x8e, 0xbb, 0x40, 0x84, 0x90,
+	0xce, 0xa2, 0x01, 0x10, 0x7b, 0x81, 0x2f, 0x26, 0xf0, 0x38, 0xd2, 0x38, 0x32, 0x29, 0xf4, 0xf2,
+	0xa4, 0xa0, 0xf5, 0x81, 0xc4, 0x2a, 0xfe, 0x50, 0x2f, 0x71, 0x69, 0x99, 0xc2, 0x39, 0x85, 0x6e,
+	0x51, 0x81, 0x67, 0x3d, 0xa2, 0xef, 0x16, 0x7e, 0xde, 0xec, 0x41, 0xd3, 0xf1, 0x52, 0x53, 0x9e,
+	0xfc, 0x71, 0x04, 0x7b, 0xbc, 0xda, 0xc7, 0xc9, 0x5b, 0x7a, 0xb3, 0xfd, 0x10, 0x06, 0xf2, 0xb7,
+	0x16, 0xeb, 0x58, 0xac, 0x2c, 0xfd, 0x16, 0xe4, 0x9c, 0x54, 0xf8, 0x1c, 0x4a, 0xf7, 0xff, 0xe8,
+	0x72, 0xf9, 0x9b, 0x83, 0x5a, 0x5e, 0xfa, 0xd5, 0xc3, 0x39, 0xa9, 0xf0, 0xf5, 0xe5, 0xf2, 0x71,
+	0xa3, 0x96, 0x97, 0x7e, 0x1f, 0x70, 0x4e, 0x2a, 0x7c, 0xb5, 0xfc, 0x02, 0x20, 0xef, 0x39, 0x5b,
+	0xb6, 0x98, 0x58, 0x69, 0xe0, 0x3b, 0x77, 0x6a, 0x46, 0x74, 0x1d, 0x64, 0x17, 0x57, 0xe9, 0x50,
+	0xea, 0x01, 0x3b, 0x27, 0x15, 0xbe, 0x5a, 0x3e, 0x85, 0x3d, 0xbd, 0x23, 0x6b, 0x39, 0x62, 0x6a,
+	0x4d, 0x5f, 0xd7, 0xb9, 0x5b, 0x3b, 0xa6, 0x6b, 0x22, 0x3b, 0xad, 0x4a, 0x93, 0x52, 0x9f, 0xd6,
+	0x39, 0xa9, 0xf0, 0x75, 0x4d, 0xf4, 0x76, 0x84, 0xd2, 0xa4, 0xa6, 0xa9, 0xe1, 0xdc, 0xad, 0x1d,
+	0xd3, 0x81, 0xcd, 0x3b, 0xaa, 0x0a, 0xd8, 0x4a, 0x53, 0xd6, 0xb9, 0x53, 0x33, 0xa2, 0x84, 0x7c,
+	0x0a, 0xbb, 0x5a, 0x33, 0xd1, 0xba, 0xa3, 0x7e, 0xd3, 0x2a, 0xb7, 0x30, 0x1d, 0xa7, 0x6e, 0x48,
+	0x97, 0xa3, 0x75, 0xf4, 0x94, 0x9c, 0x6a, 0x1f, 0xd1, 0x71, 0xea, 0x86, 0x94, 0x9c, 0x8f, 0x60,
+	0xa8, 0xfa, 0x1d, 0x96, 0xc4, 0xb1, 0xdc, 0x4b, 0x71, 0xec, 0xea, 0x80, 0x92, 0xf0, 0x0c, 0x46,
+	0x85, 0x16, 0x85, 0x25, 0x61, 0xac, 0x6b, 0x82, 0x38, 0xf7, 0xea, 0x07, 0x95, 0x34, 0x94, 0xff,
+	0x16, 0x26, 0xbb, 0x14, 0x1f, 0x96, 0xdc, 0x52, 0x6c, 0x13, 0x38, 0xa7, 0x4d, 0xc3, 0x3a, 0x56,
+	0xda, 0xd3, 0x4d, 0x61, 0x55, 0x7d, 0x90, 0x3a, 0x4e, 0xdd, 0x90, 0x2e, 0x67, 0x52, 0x23, 0x67,
+	0xd2, 0x2c, 0x67, 0x52, 0x2b, 0x67, 0x0a, 0x7b, 0xfa, 0xf3, 0xc2, 0xd2, 0x76, 0x2d, 0xbf, 0x16,
+	0x9c, 0xbb, 0xb5, 0x63, 0xa5, 0xf0, 0xae, 0x8a, 0x9a, 0xb4, 0x88, 0x9a, 0xd4, 0x8b, 0xe2, 0xc8,
+	0xeb, 0x75, 0xae, 0x8e, 0x7c, 0x4d, 0x3d, 0xef, 0x9c, 0x36, 0x0d, 0xeb, 0xb1, 0x51, 0x28, 0x0a,
+	0x2d, 0x4d, 0x87, 0x4a, 0xc5, 0xeb, 0xdc, 0xab, 0x1f, 0x2c, 0xe1, 0x2f, 0x2b, 0x29, 0x1d, 0xff,
+	0x52, 0xf5, 0xe7, 0x38, 0x75, 0x43, 0x4a, 0xce, 0x4b, 0x38, 0x28, 0x97, 0x11, 0xd6, 0xa9, 0x96,
+	0x0d, 0x6b, 0x2a, 0x2d, 0xe7, 0xff, 0x1b, 0xc7, 0x95, 0x58, 0x9f, 0x57, 0xc0, 0x95, 0x4b, 0xd6,
+	0xfa, 0x9a, 0x66, 0x57, 0xd3, 0x7d, 0xef, 0xdc, 0x6f, 0x9f, 0x24, 0x77, 0x79, 0xd5, 0x67, 0xff,
+	0x2b, 0xf3, 0x9d, 0x7f, 0x0f, 0x00, 0x04, 0xeb, 0x98, 0x75, 0x3a, 0x23, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -928,12 +3746,28 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type OrderServiceClient interface {
 	AddOrder(ctx context.Context, in *AddOrderRequest, opts ...grpc.CallOption) (*AddOrderResponse, error)
+	Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error)
 	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error)
+	ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error)
 	DelOrder(ctx context.Context, in *DelOrderRequest, opts ...grpc.CallOption) (*DelOrderResponse, error)
+	RestoreOrder(ctx context.Context, in *RestoreOrderRequest, opts ...grpc.CallOption) (*RestoreOrderResponse, error)
 	PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error)
+	GetOrderSaga(ctx context.Context, in *GetOrderSagaRequest, opts ...grpc.CallOption) (*GetOrderSagaResponse, error)
+	GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error)
+	ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error)
+	CompleteOrder(ctx context.Context, in *CompleteOrderRequest, opts ...grpc.CallOption) (*CompleteOrderResponse, error)
+	GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error)
 	SetActivity(ctx context.Context, in *SetActivityRequest, opts ...grpc.CallOption) (*SetActivityResponse, error)
 	GetActivity(ctx context.Context, in *GetActivityRequest, opts ...grpc.CallOption) (*GetActivityResponse, error)
-	GetPayOrderPersonTime(ctx context.Context, in *GetPayOrderPersonTimeRequest, opts ...grpc.CallOption) (*GetPayOrderPersonTimeResponse, error)
+	SetFlashSale(ctx context.Context, in *SetFlashSaleRequest, opts ...grpc.CallOption) (*SetFlashSaleResponse, error)
+	GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error)
+	GetUniquePayers(ctx context.Context, in *GetUniquePayersRequest, opts ...grpc.CallOption) (*GetUniquePayersResponse, error)
+	GetSalesStats(ctx context.Context, in *GetSalesStatsRequest, opts ...grpc.CallOption) (*GetSalesStatsResponse, error)
+	GetTopGoods(ctx context.Context, in *GetTopGoodsRequest, opts ...grpc.CallOption) (*GetTopGoodsResponse, error)
+	ListSellerOrders(ctx context.Context, in *ListSellerOrdersRequest, opts ...grpc.CallOption) (*ListSellerOrdersResponse, error)
+	GetSellerSalesSummary(ctx context.Context, in *GetSellerSalesSummaryRequest, opts ...grpc.CallOption) (*GetSellerSalesSummaryResponse, error)
 }
 
 type orderServiceClient struct {
@@ -953,54 +3787,198 @@ func (c *orderServiceClient) AddOrder(ctx context.Context, in *AddOrderRequest,
 	return out, nil
 }
 
-func (c *orderServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
-	out := new(GetOrderResponse)
-	err := c.cc.Invoke(ctx, "/order.OrderService/GetOrder", in, out, opts...)
+func (c *orderServiceClient) Checkout(ctx context.Context, in *CheckoutRequest, opts ...grpc.CallOption) (*CheckoutResponse, error) {
+	out := new(CheckoutResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/Checkout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*GetOrderResponse, error) {
+	out := new(GetOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ListOrders(ctx context.Context, in *ListOrdersRequest, opts ...grpc.CallOption) (*ListOrdersResponse, error) {
+	out := new(ListOrdersResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/ListOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) DelOrder(ctx context.Context, in *DelOrderRequest, opts ...grpc.CallOption) (*DelOrderResponse, error) {
+	out := new(DelOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/DelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RestoreOrder(ctx context.Context, in *RestoreOrderRequest, opts ...grpc.CallOption) (*RestoreOrderResponse, error) {
+	out := new(RestoreOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/RestoreOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error) {
+	out := new(PayOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/PayOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderSaga(ctx context.Context, in *GetOrderSagaRequest, opts ...grpc.CallOption) (*GetOrderSagaResponse, error) {
+	out := new(GetOrderSagaResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetOrderSaga", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetPayment(ctx context.Context, in *GetPaymentRequest, opts ...grpc.CallOption) (*GetPaymentResponse, error) {
+	out := new(GetPaymentResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetPayment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RefundOrder(ctx context.Context, in *RefundOrderRequest, opts ...grpc.CallOption) (*RefundOrderResponse, error) {
+	out := new(RefundOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/RefundOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) ShipOrder(ctx context.Context, in *ShipOrderRequest, opts ...grpc.CallOption) (*ShipOrderResponse, error) {
+	out := new(ShipOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/ShipOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CompleteOrder(ctx context.Context, in *CompleteOrderRequest, opts ...grpc.CallOption) (*CompleteOrderResponse, error) {
+	out := new(CompleteOrderResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/CompleteOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetOrderHistory(ctx context.Context, in *GetOrderHistoryRequest, opts ...grpc.CallOption) (*GetOrderHistoryResponse, error) {
+	out := new(GetOrderHistoryResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetOrderHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetActivity(ctx context.Context, in *SetActivityRequest, opts ...grpc.CallOption) (*SetActivityResponse, error) {
+	out := new(SetActivityResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/SetActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetActivity(ctx context.Context, in *GetActivityRequest, opts ...grpc.CallOption) (*GetActivityResponse, error) {
+	out := new(GetActivityResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetActivity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) SetFlashSale(ctx context.Context, in *SetFlashSaleRequest, opts ...grpc.CallOption) (*SetFlashSaleResponse, error) {
+	out := new(SetFlashSaleResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/SetFlashSale", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetFlashSale(ctx context.Context, in *GetFlashSaleRequest, opts ...grpc.CallOption) (*GetFlashSaleResponse, error) {
+	out := new(GetFlashSaleResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetFlashSale", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *orderServiceClient) DelOrder(ctx context.Context, in *DelOrderRequest, opts ...grpc.CallOption) (*DelOrderResponse, error) {
-	out := new(DelOrderResponse)
-	err := c.cc.Invoke(ctx, "/order.OrderService/DelOrder", in, out, opts...)
+func (c *orderServiceClient) GetUniquePayers(ctx context.Context, in *GetUniquePayersRequest, opts ...grpc.CallOption) (*GetUniquePayersResponse, error) {
+	out := new(GetUniquePayersResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetUniquePayers", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *orderServiceClient) PayOrder(ctx context.Context, in *PayOrderRequest, opts ...grpc.CallOption) (*PayOrderResponse, error) {
-	out := new(PayOrderResponse)
-	err := c.cc.Invoke(ctx, "/order.OrderService/PayOrder", in, out, opts...)
+func (c *orderServiceClient) GetSalesStats(ctx context.Context, in *GetSalesStatsRequest, opts ...grpc.CallOption) (*GetSalesStatsResponse, error) {
+	out := new(GetSalesStatsResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetSalesStats", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *orderServiceClient) SetActivity(ctx context.Context, in *SetActivityRequest, opts ...grpc.CallOption) (*SetActivityResponse, error) {
-	out := new(SetActivityResponse)
-	err := c.cc.Invoke(ctx, "/order.OrderService/SetActivity", in, out, opts...)
+func (c *orderServiceClient) GetTopGoods(ctx context.Context, in *GetTopGoodsRequest, opts ...grpc.CallOption) (*GetTopGoodsResponse, error) {
+	out := new(GetTopGoodsResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetTopGoods", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *orderServiceClient) GetActivity(ctx context.Context, in *GetActivityRequest, opts ...grpc.CallOption) (*GetActivityResponse, error) {
-	out := new(GetActivityResponse)
-	err := c.cc.Invoke(ctx, "/order.OrderService/GetActivity", in, out, opts...)
+func (c *orderServiceClient) ListSellerOrders(ctx context.Context, in *ListSellerOrdersRequest, opts ...grpc.CallOption) (*ListSellerOrdersResponse, error) {
+	out := new(ListSellerOrdersResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/ListSellerOrders", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
 	return out, nil
 }
 
-func (c *orderServiceClient) GetPayOrderPersonTime(ctx context.Context, in *GetPayOrderPersonTimeRequest, opts ...grpc.CallOption) (*GetPayOrderPersonTimeResponse, error) {
-	out := new(GetPayOrderPersonTimeResponse)
-	err := c.cc.Invoke(ctx, "/order.OrderService/GetPayOrderPersonTime", in, out, opts...)
+func (c *orderServiceClient) GetSellerSalesSummary(ctx context.Context, in *GetSellerSalesSummaryRequest, opts ...grpc.CallOption) (*GetSellerSalesSummaryResponse, error) {
+	out := new(GetSellerSalesSummaryResponse)
+	err := c.cc.Invoke(ctx, "/order.OrderService/GetSellerSalesSummary", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1010,12 +3988,28 @@ func (c *orderServiceClient) GetPayOrderPersonTime(ctx context.Context, in *GetP
 // OrderServiceServer is the server API for OrderService service.
 type OrderServiceServer interface {
 	AddOrder(context.Context, *AddOrderRequest) (*AddOrderResponse, error)
+	Checkout(context.Context, *CheckoutRequest) (*CheckoutResponse, error)
 	GetOrder(context.Context, *GetOrderRequest) (*GetOrderResponse, error)
+	ListOrders(context.Context, *ListOrdersRequest) (*ListOrdersResponse, error)
 	DelOrder(context.Context, *DelOrderRequest) (*DelOrderResponse, error)
+	RestoreOrder(context.Context, *RestoreOrderRequest) (*RestoreOrderResponse, error)
 	PayOrder(context.Context, *PayOrderRequest) (*PayOrderResponse, error)
+	GetOrderSaga(context.Context, *GetOrderSagaRequest) (*GetOrderSagaResponse, error)
+	GetPayment(context.Context, *GetPaymentRequest) (*GetPaymentResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	RefundOrder(context.Context, *RefundOrderRequest) (*RefundOrderResponse, error)
+	ShipOrder(context.Context, *ShipOrderRequest) (*ShipOrderResponse, error)
+	CompleteOrder(context.Context, *CompleteOrderRequest) (*CompleteOrderResponse, error)
+	GetOrderHistory(context.Context, *GetOrderHistoryRequest) (*GetOrderHistoryResponse, error)
 	SetActivity(context.Context, *SetActivityRequest) (*SetActivityResponse, error)
 	GetActivity(context.Context, *GetActivityRequest) (*GetActivityResponse, error)
-	GetPayOrderPersonTime(context.Context, *GetPayOrderPersonTimeRequest) (*GetPayOrderPersonTimeResponse, error)
+	SetFlashSale(context.Context, *SetFlashSaleRequest) (*SetFlashSaleResponse, error)
+	GetFlashSale(context.Context, *GetFlashSaleRequest) (*GetFlashSaleResponse, error)
+	GetUniquePayers(context.Context, *GetUniquePayersRequest) (*GetUniquePayersResponse, error)
+	GetSalesStats(context.Context, *GetSalesStatsRequest) (*GetSalesStatsResponse, error)
+	GetTopGoods(context.Context, *GetTopGoodsRequest) (*GetTopGoodsResponse, error)
+	ListSellerOrders(context.Context, *ListSellerOrdersRequest) (*ListSellerOrdersResponse, error)
+	GetSellerSalesSummary(context.Context, *GetSellerSalesSummaryRequest) (*GetSellerSalesSummaryResponse, error)
 }
 
 func RegisterOrderServiceServer(s *grpc.Server, srv OrderServiceServer) {
@@ -1040,6 +4034,24 @@ func _OrderService_AddOrder_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_Checkout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).Checkout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/Checkout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).Checkout(ctx, req.(*CheckoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetOrderRequest)
 	if err := dec(in); err != nil {
@@ -1058,6 +4070,24 @@ func _OrderService_GetOrder_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_ListOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/ListOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListOrders(ctx, req.(*ListOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_DelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DelOrderRequest)
 	if err := dec(in); err != nil {
@@ -1076,6 +4106,24 @@ func _OrderService_DelOrder_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_RestoreOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RestoreOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/RestoreOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RestoreOrder(ctx, req.(*RestoreOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_PayOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayOrderRequest)
 	if err := dec(in); err != nil {
@@ -1094,6 +4142,132 @@ func _OrderService_PayOrder_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetOrderSaga_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderSagaRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderSaga(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetOrderSaga",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderSaga(ctx, req.(*GetOrderSagaRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetPayment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetPayment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetPayment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetPayment(ctx, req.(*GetPaymentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RefundOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RefundOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RefundOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/RefundOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RefundOrder(ctx, req.(*RefundOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ShipOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ShipOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ShipOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/ShipOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ShipOrder(ctx, req.(*ShipOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CompleteOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CompleteOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/CompleteOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CompleteOrder(ctx, req.(*CompleteOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetOrderHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetOrderHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderHistory(ctx, req.(*GetOrderHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _OrderService_SetActivity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(SetActivityRequest)
 	if err := dec(in); err != nil {
@@ -1130,20 +4304,128 @@ func _OrderService_GetActivity_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
-func _OrderService_GetPayOrderPersonTime_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPayOrderPersonTimeRequest)
+func _OrderService_SetFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).SetFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/SetFlashSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).SetFlashSale(ctx, req.(*SetFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetFlashSale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFlashSaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetFlashSale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetFlashSale",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetFlashSale(ctx, req.(*GetFlashSaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetUniquePayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUniquePayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetUniquePayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetUniquePayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetUniquePayers(ctx, req.(*GetUniquePayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetSalesStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSalesStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetSalesStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetSalesStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetSalesStats(ctx, req.(*GetSalesStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetTopGoods_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTopGoodsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetTopGoods(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/GetTopGoods",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetTopGoods(ctx, req.(*GetTopGoodsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_ListSellerOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSellerOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).ListSellerOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/order.OrderService/ListSellerOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).ListSellerOrders(ctx, req.(*ListSellerOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetSellerSalesSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSellerSalesSummaryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(OrderServiceServer).GetPayOrderPersonTime(ctx, in)
+		return srv.(OrderServiceServer).GetSellerSalesSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/order.OrderService/GetPayOrderPersonTime",
+		FullMethod: "/order.OrderService/GetSellerSalesSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(OrderServiceServer).GetPayOrderPersonTime(ctx, req.(*GetPayOrderPersonTimeRequest))
+		return srv.(OrderServiceServer).GetSellerSalesSummary(ctx, req.(*GetSellerSalesSummaryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -1156,18 +4438,58 @@ var _OrderService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddOrder",
 			Handler:    _OrderService_AddOrder_Handler,
 		},
+		{
+			MethodName: "Checkout",
+			Handler:    _OrderService_Checkout_Handler,
+		},
 		{
 			MethodName: "GetOrder",
 			Handler:    _OrderService_GetOrder_Handler,
 		},
+		{
+			MethodName: "ListOrders",
+			Handler:    _OrderService_ListOrders_Handler,
+		},
 		{
 			MethodName: "DelOrder",
 			Handler:    _OrderService_DelOrder_Handler,
 		},
+		{
+			MethodName: "RestoreOrder",
+			Handler:    _OrderService_RestoreOrder_Handler,
+		},
 		{
 			MethodName: "PayOrder",
 			Handler:    _OrderService_PayOrder_Handler,
 		},
+		{
+			MethodName: "GetOrderSaga",
+			Handler:    _OrderService_GetOrderSaga_Handler,
+		},
+		{
+			MethodName: "GetPayment",
+			Handler:    _OrderService_GetPayment_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RefundOrder",
+			Handler:    _OrderService_RefundOrder_Handler,
+		},
+		{
+			MethodName: "ShipOrder",
+			Handler:    _OrderService_ShipOrder_Handler,
+		},
+		{
+			MethodName: "CompleteOrder",
+			Handler:    _OrderService_CompleteOrder_Handler,
+		},
+		{
+			MethodName: "GetOrderHistory",
+			Handler:    _OrderService_GetOrderHistory_Handler,
+		},
 		{
 			MethodName: "SetActivity",
 			Handler:    _OrderService_SetActivity_Handler,
@@ -1177,8 +4499,32 @@ var _OrderService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _OrderService_GetActivity_Handler,
 		},
 		{
-			MethodName: "GetPayOrderPersonTime",
-			Handler:    _OrderService_GetPayOrderPersonTime_Handler,
+			MethodName: "SetFlashSale",
+			Handler:    _OrderService_SetFlashSale_Handler,
+		},
+		{
+			MethodName: "GetFlashSale",
+			Handler:    _OrderService_GetFlashSale_Handler,
+		},
+		{
+			MethodName: "GetUniquePayers",
+			Handler:    _OrderService_GetUniquePayers_Handler,
+		},
+		{
+			MethodName: "GetSalesStats",
+			Handler:    _OrderService_GetSalesStats_Handler,
+		},
+		{
+			MethodName: "GetTopGoods",
+			Handler:    _OrderService_GetTopGoods_Handler,
+		},
+		{
+			MethodName: "ListSellerOrders",
+			Handler:    _OrderService_ListSellerOrders_Handler,
+		},
+		{
+			MethodName: "GetSellerSalesSummary",
+			Handler:    _OrderService_GetSellerSalesSummary_Handler,
 		},
 	},
 	Streams:  []grpc.StreamDesc{},
@@ -808,6 +808,92 @@ func (m *DelUserResponse) GetCodeMsg() string {
 	return ""
 }
 
+type RestoreUserRequest struct {
+	UserID               string   `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserRequest) Reset()         { *m = RestoreUserRequest{} }
+func (m *RestoreUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserRequest) ProtoMessage()    {}
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{14}
+}
+
+func (m *RestoreUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserRequest.Unmarshal(m, b)
+}
+func (m *RestoreUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserRequest.Marshal(b, m, deterministic)
+}
+func (m *RestoreUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserRequest.Merge(m, src)
+}
+func (m *RestoreUserRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserRequest.Size(m)
+}
+func (m *RestoreUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserRequest proto.InternalMessageInfo
+
+func (m *RestoreUserRequest) GetUserID() string {
+	if m != nil {
+		return m.UserID
+	}
+	return ""
+}
+
+type RestoreUserResponse struct {
+	Code                 uint32   `protobuf:"varint,1,opt,name=code,proto3" json:"code,omitempty"`
+	CodeMsg              string   `protobuf:"bytes,2,opt,name=codeMsg,proto3" json:"codeMsg,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserResponse) Reset()         { *m = RestoreUserResponse{} }
+func (m *RestoreUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserResponse) ProtoMessage()    {}
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_116e343673f7ffaf, []int{15}
+}
+
+func (m *RestoreUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserResponse.Unmarshal(m, b)
+}
+func (m *RestoreUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserResponse.Marshal(b, m, deterministic)
+}
+func (m *RestoreUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserResponse.Merge(m, src)
+}
+func (m *RestoreUserResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserResponse.Size(m)
+}
+func (m *RestoreUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserResponse proto.InternalMessageInfo
+
+func (m *RestoreUserResponse) GetCode() uint32 {
+	if m != nil {
+		return m.Code
+	}
+	return 0
+}
+
+func (m *RestoreUserResponse) GetCodeMsg() string {
+	if m != nil {
+		return m.CodeMsg
+	}
+	return ""
+}
+
 type ModifyUserInfoRequest struct {
 	UserID               string    `protobuf:"bytes,1,opt,name=userID,proto3" json:"userID,omitempty"`
 	UserInfo             *UserInfo `protobuf:"bytes,2,opt,name=userInfo,proto3" json:"userInfo,omitempty"`
@@ -820,7 +906,7 @@ func (m *ModifyUserInfoRequest) Reset()         { *m = ModifyUserInfoRequest{} }
 func (m *ModifyUserInfoRequest) String() string { return proto.CompactTextString(m) }
 func (*ModifyUserInfoRequest) ProtoMessage()    {}
 func (*ModifyUserInfoRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_116e343673f7ffaf, []int{14}
+	return fileDescriptor_116e343673f7ffaf, []int{16}
 }
 
 func (m *ModifyUserInfoRequest) XXX_Unmarshal(b []byte) error {
@@ -867,7 +953,7 @@ func (m *ModifyUserInfoResponse) Reset()         { *m = ModifyUserInfoResponse{}
 func (m *ModifyUserInfoResponse) String() string { return proto.CompactTextString(m) }
 func (*ModifyUserInfoResponse) ProtoMessage()    {}
 func (*ModifyUserInfoResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_116e343673f7ffaf, []int{15}
+	return fileDescriptor_116e343673f7ffaf, []int{17}
 }
 
 func (m *ModifyUserInfoResponse) XXX_Unmarshal(b []byte) error {
@@ -913,7 +999,7 @@ func (m *GetUserIdByPhoneNumberRequest) Reset()         { *m = GetUserIdByPhoneN
 func (m *GetUserIdByPhoneNumberRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUserIdByPhoneNumberRequest) ProtoMessage()    {}
 func (*GetUserIdByPhoneNumberRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_116e343673f7ffaf, []int{16}
+	return fileDescriptor_116e343673f7ffaf, []int{18}
 }
 
 func (m *GetUserIdByPhoneNumberRequest) XXX_Unmarshal(b []byte) error {
@@ -954,7 +1040,7 @@ func (m *GetUserIdByPhoneNumberResponse) Reset()         { *m = GetUserIdByPhone
 func (m *GetUserIdByPhoneNumberResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUserIdByPhoneNumberResponse) ProtoMessage()    {}
 func (*GetUserIdByPhoneNumberResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_116e343673f7ffaf, []int{17}
+	return fileDescriptor_116e343673f7ffaf, []int{19}
 }
 
 func (m *GetUserIdByPhoneNumberResponse) XXX_Unmarshal(b []byte) error {
@@ -1007,7 +1093,7 @@ func (m *GetUsersByNameRequest) Reset()         { *m = GetUsersByNameRequest{} }
 func (m *GetUsersByNameRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersByNameRequest) ProtoMessage()    {}
 func (*GetUsersByNameRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_116e343673f7ffaf, []int{18}
+	return fileDescriptor_116e343673f7ffaf, []int{20}
 }
 
 func (m *GetUsersByNameRequest) XXX_Unmarshal(b []byte) error {
@@ -1048,7 +1134,7 @@ func (m *GetUsersByNameResponse) Reset()         { *m = GetUsersByNameResponse{}
 func (m *GetUsersByNameResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersByNameResponse) ProtoMessage()    {}
 func (*GetUsersByNameResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_116e343673f7ffaf, []int{19}
+	return fileDescriptor_116e343673f7ffaf, []int{21}
 }
 
 func (m *GetUsersByNameResponse) XXX_Unmarshal(b []byte) error {
@@ -1105,6 +1191,8 @@ func init() {
 	proto.RegisterType((*GetUserResponse)(nil), "user.GetUserResponse")
 	proto.RegisterType((*DelUserRequest)(nil), "user.DelUserRequest")
 	proto.RegisterType((*DelUserResponse)(nil), "user.DelUserResponse")
+	proto.RegisterType((*RestoreUserRequest)(nil), "user.RestoreUserRequest")
+	proto.RegisterType((*RestoreUserResponse)(nil), "user.RestoreUserResponse")
 	proto.RegisterType((*ModifyUserInfoRequest)(nil), "user.ModifyUserInfoRequest")
 	proto.RegisterType((*ModifyUserInfoResponse)(nil), "user.ModifyUserInfoResponse")
 	proto.RegisterType((*GetUserIdByPhoneNumberRequest)(nil), "user.GetUserIdByPhoneNumberRequest")
@@ -1116,51 +1204,53 @@ func init() {
 func init() { proto.RegisterFile("user.proto", fileDescriptor_116e343673f7ffaf) }
 
 var fileDescriptor_116e343673f7ffaf = []byte{
-	// 702 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x5f, 0x6f, 0xd3, 0x3e,
-	0x14, 0x5d, 0x96, 0x76, 0x7f, 0x6e, 0x7f, 0xcd, 0xf6, 0xbb, 0xfb, 0xa3, 0xac, 0x8c, 0x52, 0x99,
-	0x09, 0x55, 0x20, 0x4d, 0x68, 0xbc, 0xf0, 0x86, 0x36, 0x22, 0x50, 0xa5, 0x6d, 0x42, 0x45, 0x63,
-	0x48, 0x3c, 0x65, 0x8d, 0x5b, 0x22, 0xd6, 0xa4, 0x4b, 0xd2, 0x8e, 0x7e, 0x09, 0x1e, 0xf8, 0x04,
-	0x3c, 0xf1, 0xc4, 0x87, 0x44, 0x71, 0x6c, 0xc7, 0xc9, 0xd2, 0x6e, 0x0a, 0xdb, 0x53, 0xec, 0xeb,
-	0x7b, 0x8f, 0x8f, 0x8f, 0x8f, 0xed, 0x00, 0x8c, 0x43, 0x1a, 0xec, 0x8f, 0x02, 0x3f, 0xf2, 0xb1,
-	0x12, 0xb7, 0xc9, 0x2f, 0x0d, 0x56, 0xce, 0x42, 0x1a, 0x74, 0xbc, 0xbe, 0x8f, 0x08, 0x15, 0xcf,
-	0x1e, 0x52, 0x53, 0x6b, 0x69, 0xed, 0xd5, 0x2e, 0x6b, 0x63, 0x13, 0xc0, 0x75, 0xa8, 0x17, 0xb9,
-	0xd1, 0xf4, 0xd4, 0x37, 0x17, 0xd9, 0x88, 0x12, 0xc1, 0x75, 0xd0, 0xed, 0x01, 0x35, 0xf5, 0x96,
-	0xd6, 0xae, 0x77, 0xe3, 0x26, 0x6e, 0xc3, 0xd2, 0x80, 0x7a, 0x0e, 0x0d, 0xcc, 0x0a, 0x0b, 0xf2,
-	0x1e, 0xb6, 0xa0, 0x36, 0xfa, 0xea, 0x7b, 0xf4, 0x74, 0x3c, 0xbc, 0xa0, 0x81, 0x59, 0x65, 0x50,
-	0x6a, 0x08, 0x37, 0xa1, 0x4a, 0x87, 0xb6, 0x7b, 0x69, 0x2e, 0xb1, 0xb1, 0xa4, 0x43, 0x3e, 0x03,
-	0x1e, 0x3a, 0x8e, 0x20, 0xd9, 0xa5, 0x57, 0x63, 0x1a, 0x46, 0xf1, 0x2c, 0xf1, 0x02, 0x3a, 0x16,
-	0x67, 0xcb, 0x7b, 0xf8, 0x1c, 0x56, 0xc6, 0x3c, 0x95, 0xb1, 0xad, 0x1d, 0x18, 0xfb, 0x6c, 0xd5,
-	0x12, 0x40, 0x8e, 0x93, 0xb7, 0xb0, 0x91, 0x41, 0x0e, 0x47, 0xbe, 0x17, 0xd2, 0x58, 0x86, 0x9e,
-	0xef, 0x24, 0x32, 0xd4, 0xbb, 0xac, 0x8d, 0x26, 0x2c, 0xc7, 0xdf, 0x93, 0x70, 0xc0, 0x35, 0x10,
-	0x5d, 0xf2, 0x43, 0x03, 0x38, 0xff, 0x2e, 0x35, 0x34, 0x61, 0x39, 0xb0, 0xaf, 0x2d, 0x3b, 0xb2,
-	0x39, 0x31, 0xd1, 0xc5, 0x5d, 0x58, 0x0d, 0xdd, 0x81, 0x67, 0x47, 0xe3, 0x80, 0x72, 0x90, 0x34,
-	0x80, 0x7b, 0x50, 0xa7, 0x5e, 0x2f, 0x98, 0x8e, 0x22, 0xea, 0xb0, 0x6a, 0x9d, 0x65, 0x64, 0x83,
-	0x68, 0xc0, 0xa2, 0x3b, 0x61, 0xba, 0xae, 0x76, 0x17, 0xdd, 0x89, 0xa4, 0x9a, 0x88, 0xc9, 0xda,
-	0xc4, 0x01, 0xd3, 0xa2, 0xac, 0x28, 0xa5, 0x75, 0x9b, 0x6a, 0x2f, 0x01, 0xae, 0x65, 0x32, 0xd7,
-	0x6d, 0x3d, 0xd1, 0x4d, 0x01, 0x51, 0x72, 0xc8, 0x15, 0xec, 0x14, 0xcc, 0x52, 0x46, 0x41, 0x7c,
-	0x06, 0x46, 0x0a, 0xac, 0xac, 0x3d, 0x17, 0x25, 0x7f, 0x34, 0xf8, 0xef, 0xd8, 0x1f, 0xb8, 0x9e,
-	0x58, 0x4d, 0x19, 0xbf, 0xe6, 0x5c, 0xa8, 0xcf, 0x71, 0x61, 0x45, 0x71, 0x61, 0x4e, 0xa1, 0xea,
-	0x1d, 0x14, 0x3a, 0x83, 0x3a, 0x67, 0x5b, 0x4a, 0x95, 0x74, 0xab, 0x74, 0x75, 0xab, 0xc8, 0x6f,
-	0x0d, 0x0c, 0xee, 0xda, 0x7f, 0xd1, 0xe1, 0xe1, 0xcf, 0xed, 0x39, 0xac, 0x49, 0x9e, 0xf7, 0xaa,
-	0x40, 0x1b, 0x8c, 0xf7, 0x34, 0x52, 0x05, 0x98, 0x61, 0x6b, 0xf2, 0x0d, 0xd6, 0x64, 0x66, 0x29,
-	0x0a, 0xea, 0x6d, 0xa2, 0xdf, 0x72, 0x9b, 0xb4, 0xc1, 0xb0, 0xe8, 0xe5, 0x5d, 0x68, 0xbd, 0x81,
-	0x35, 0x99, 0x59, 0xea, 0xce, 0xf9, 0x02, 0x5b, 0x27, 0xbe, 0xe3, 0xf6, 0xa7, 0x0f, 0x71, 0x2b,
-	0xbe, 0x83, 0xed, 0x3c, 0x78, 0x29, 0x92, 0x87, 0xf0, 0x98, 0x8b, 0xdf, 0x71, 0x8e, 0xa6, 0x1f,
-	0x52, 0xbf, 0x08, 0xb2, 0x39, 0x63, 0x69, 0x37, 0x8c, 0x45, 0xfa, 0xd0, 0x9c, 0x05, 0x71, 0xaf,
-	0x8e, 0x7a, 0x01, 0x5b, 0x7c, 0x9e, 0xf0, 0x68, 0x7a, 0x6a, 0x0f, 0xe9, 0x9c, 0x93, 0x45, 0xfa,
-	0xb0, 0x9d, 0x4f, 0x2e, 0x45, 0xa6, 0x99, 0x3c, 0xc7, 0x1d, 0xeb, 0xd8, 0x0d, 0x23, 0x53, 0x6f,
-	0xe9, 0xf1, 0x09, 0x4d, 0x23, 0x07, 0x3f, 0xab, 0x50, 0x8b, 0x67, 0xf9, 0x48, 0x83, 0x89, 0xdb,
-	0xa3, 0xf8, 0x1a, 0x96, 0xf9, 0x79, 0xc2, 0xcd, 0x64, 0xf3, 0xb2, 0xd7, 0x40, 0x63, 0x2b, 0x17,
-	0x4d, 0x58, 0x91, 0x85, 0xb8, 0x92, 0x33, 0x16, 0x95, 0xd9, 0xf3, 0x23, 0x2a, 0x73, 0x67, 0x25,
-	0xa9, 0xe4, 0x4e, 0x15, 0x95, 0x59, 0x8b, 0x8b, 0xca, 0x9c, 0x9d, 0xc9, 0x02, 0x9e, 0x80, 0x91,
-	0x75, 0x11, 0x3e, 0x4a, 0x52, 0x0b, 0x8d, 0xdb, 0xd8, 0x2d, 0x1e, 0x94, 0x70, 0x54, 0x8a, 0x9e,
-	0x73, 0x02, 0x3e, 0xcd, 0x70, 0x2f, 0xb6, 0x5a, 0x63, 0x6f, 0x7e, 0x92, 0xca, 0x3a, 0xbb, 0xb7,
-	0x82, 0x75, 0xa1, 0x3d, 0x04, 0xeb, 0x62, 0x3b, 0x90, 0x05, 0x3c, 0x80, 0x2a, 0x7b, 0x02, 0x10,
-	0x93, 0x44, 0xf5, 0xf5, 0x6a, 0x6c, 0x64, 0x62, 0xb2, 0xe6, 0x13, 0xfc, 0x7f, 0xe3, 0x61, 0xc5,
-	0xa6, 0x90, 0xb9, 0xf8, 0x5d, 0x6f, 0x3c, 0x99, 0x39, 0x2e, 0x71, 0x2d, 0xa8, 0x29, 0x3f, 0x3b,
-	0x68, 0x66, 0xcc, 0xa2, 0x62, 0xed, 0x14, 0x8c, 0x08, 0x94, 0x8b, 0x25, 0xf6, 0xf3, 0xf8, 0xea,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x08, 0x3f, 0xa2, 0xc9, 0x4a, 0x0a, 0x00, 0x00,
+	// 726 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0xc6, 0x75, 0xd2, 0x9f, 0x09, 0x71, 0xcb, 0xb4, 0x89, 0x5c, 0x53, 0x42, 0x64, 0x2a, 0x14,
+	0x01, 0xaa, 0x50, 0xb9, 0x70, 0x43, 0x6d, 0x23, 0x50, 0xa4, 0xb6, 0x42, 0x41, 0xa5, 0x48, 0x9c,
+	0xdc, 0x7a, 0x12, 0x2c, 0x1a, 0x3b, 0xb5, 0x9d, 0xb6, 0x79, 0x09, 0x9e, 0x81, 0x13, 0x27, 0x1e,
+	0x88, 0xc7, 0x41, 0x5e, 0xaf, 0x37, 0x6b, 0xc7, 0x0d, 0x91, 0x69, 0x4f, 0xd9, 0x9d, 0xbf, 0xfd,
+	0xfc, 0xcd, 0x37, 0x9b, 0x05, 0x18, 0x05, 0xe4, 0xef, 0x0c, 0x7d, 0x2f, 0xf4, 0xb0, 0x14, 0xad,
+	0xcd, 0x9f, 0x0a, 0x2c, 0x9f, 0x04, 0xe4, 0x77, 0xdc, 0x9e, 0x87, 0x08, 0x25, 0xd7, 0x1a, 0x90,
+	0xae, 0x34, 0x95, 0xd6, 0x4a, 0x97, 0xad, 0xb1, 0x01, 0xe0, 0xd8, 0xe4, 0x86, 0x4e, 0x38, 0x3e,
+	0xf6, 0xf4, 0x05, 0xe6, 0x91, 0x2c, 0xb8, 0x06, 0xaa, 0xd5, 0x27, 0x5d, 0x6d, 0x2a, 0xad, 0x6a,
+	0x37, 0x5a, 0x62, 0x1d, 0x16, 0xfb, 0xe4, 0xda, 0xe4, 0xeb, 0x25, 0x66, 0xe4, 0x3b, 0x6c, 0x42,
+	0x65, 0xf8, 0xcd, 0x73, 0xe9, 0x78, 0x34, 0x38, 0x23, 0x5f, 0x2f, 0xb3, 0x52, 0xb2, 0x09, 0x37,
+	0xa0, 0x4c, 0x03, 0xcb, 0xb9, 0xd0, 0x17, 0x99, 0x2f, 0xde, 0x98, 0x5f, 0x00, 0xf7, 0x6c, 0x3b,
+	0x01, 0xd9, 0xa5, 0xcb, 0x11, 0x05, 0x61, 0x74, 0x4a, 0xf4, 0x01, 0x9d, 0x36, 0x47, 0xcb, 0x77,
+	0xf8, 0x02, 0x96, 0x47, 0x3c, 0x94, 0xa1, 0xad, 0xec, 0x6a, 0x3b, 0xec, 0xab, 0x45, 0x01, 0xe1,
+	0x37, 0x0f, 0x60, 0x3d, 0x55, 0x39, 0x18, 0x7a, 0x6e, 0x40, 0x11, 0x0d, 0xe7, 0x9e, 0x1d, 0xd3,
+	0x50, 0xed, 0xb2, 0x35, 0xea, 0xb0, 0x14, 0xfd, 0x1e, 0x05, 0x7d, 0xce, 0x41, 0xb2, 0x35, 0x7f,
+	0x28, 0x00, 0xa7, 0x37, 0x82, 0x43, 0x1d, 0x96, 0x7c, 0xeb, 0xba, 0x6d, 0x85, 0x16, 0x07, 0x96,
+	0x6c, 0x71, 0x0b, 0x56, 0x02, 0xa7, 0xef, 0x5a, 0xe1, 0xc8, 0x27, 0x5e, 0x64, 0x62, 0xc0, 0x6d,
+	0xa8, 0x92, 0x7b, 0xee, 0x8f, 0x87, 0x21, 0xd9, 0x2c, 0x5b, 0x65, 0x11, 0x69, 0x23, 0x6a, 0xb0,
+	0xe0, 0x5c, 0x31, 0x5e, 0x57, 0xba, 0x0b, 0xce, 0x95, 0x80, 0x1a, 0x93, 0xc9, 0xd6, 0xa6, 0x0d,
+	0x7a, 0x9b, 0x58, 0xd2, 0xe9, 0xcd, 0xbc, 0xac, 0xbd, 0x06, 0xb8, 0x16, 0xc1, 0x9c, 0xb7, 0xb5,
+	0x98, 0x37, 0xa9, 0x88, 0x14, 0x63, 0x5e, 0xc2, 0x66, 0xce, 0x29, 0x45, 0x18, 0xc4, 0xe7, 0xa0,
+	0x4d, 0x0a, 0x4b, 0xdf, 0x9e, 0xb1, 0x9a, 0xbf, 0x15, 0x78, 0x78, 0xe8, 0xf5, 0x1d, 0x37, 0xf9,
+	0x9a, 0x22, 0x7a, 0xcd, 0xa8, 0x50, 0x9d, 0xa1, 0xc2, 0x92, 0xa4, 0xc2, 0x0c, 0x43, 0xe5, 0x39,
+	0x18, 0x3a, 0x81, 0x2a, 0x47, 0x5b, 0x88, 0x95, 0x49, 0xab, 0x54, 0xb9, 0x55, 0xe6, 0x2f, 0x05,
+	0x34, 0xae, 0xda, 0xff, 0xe1, 0xe1, 0xfe, 0xe7, 0xf6, 0x14, 0x56, 0x05, 0xce, 0x3b, 0x65, 0xa0,
+	0x05, 0xda, 0x07, 0x0a, 0x65, 0x02, 0x6e, 0x91, 0xb5, 0xf9, 0x1d, 0x56, 0x45, 0x64, 0x21, 0x08,
+	0xf2, 0x6d, 0xa2, 0xfe, 0xe3, 0x36, 0x69, 0x81, 0xd6, 0xa6, 0x8b, 0x79, 0x60, 0xbd, 0x83, 0x55,
+	0x11, 0x59, 0xe8, 0xce, 0x79, 0x05, 0xd8, 0xa5, 0x20, 0xf4, 0x7c, 0x9a, 0xe7, 0xb8, 0x03, 0x58,
+	0x4f, 0x45, 0x17, 0x3a, 0xf2, 0x2b, 0xd4, 0x8e, 0x3c, 0xdb, 0xe9, 0x8d, 0xef, 0xe3, 0x22, 0x7e,
+	0x0f, 0xf5, 0x6c, 0xf1, 0x42, 0x20, 0xf7, 0xe0, 0x09, 0xef, 0x77, 0xc7, 0xde, 0x1f, 0x7f, 0x9c,
+	0x48, 0x34, 0x01, 0x9b, 0xd1, 0xb2, 0x32, 0xa5, 0x65, 0xb3, 0x07, 0x8d, 0xdb, 0x4a, 0xdc, 0xa9,
+	0x88, 0x5f, 0x42, 0x8d, 0x9f, 0x13, 0xec, 0x8f, 0x8f, 0xad, 0x01, 0xcd, 0x18, 0x66, 0xb3, 0x07,
+	0xf5, 0x6c, 0x70, 0x21, 0x30, 0x8d, 0xf8, 0x05, 0xd0, 0x69, 0x1f, 0x3a, 0x41, 0xa8, 0xab, 0x4d,
+	0x35, 0xba, 0x14, 0x26, 0x96, 0xdd, 0x3f, 0x65, 0xa8, 0x44, 0xa7, 0x7c, 0x22, 0xff, 0xca, 0x39,
+	0x27, 0x7c, 0x0b, 0x4b, 0x7c, 0x84, 0x71, 0x23, 0x6e, 0x5e, 0xfa, 0xe6, 0x31, 0x6a, 0x19, 0x6b,
+	0x8c, 0xca, 0x7c, 0x10, 0x65, 0x72, 0xc4, 0x49, 0x66, 0x7a, 0x64, 0x8d, 0x5a, 0xc6, 0x2a, 0x67,
+	0xf2, 0xe1, 0x48, 0x32, 0xd3, 0x53, 0x65, 0xd4, 0x32, 0x56, 0x91, 0xd9, 0x86, 0x8a, 0xa4, 0x73,
+	0xd4, 0xe3, 0xb8, 0xe9, 0x41, 0x31, 0x36, 0x73, 0x3c, 0xa2, 0xca, 0x11, 0x68, 0x69, 0x2d, 0xe2,
+	0xe3, 0x38, 0x3c, 0x57, 0xfe, 0xc6, 0x56, 0xbe, 0x53, 0x94, 0x23, 0xa8, 0xe7, 0xeb, 0x09, 0x9f,
+	0xa5, 0x18, 0xc8, 0x17, 0xac, 0xb1, 0x3d, 0x3b, 0x48, 0x46, 0x9d, 0x56, 0x48, 0x82, 0x3a, 0x57,
+	0x64, 0xc6, 0x56, 0xbe, 0x53, 0x94, 0xdb, 0x85, 0x32, 0xfb, 0xef, 0x42, 0x8c, 0x03, 0xe5, 0xbf,
+	0x5d, 0x63, 0x3d, 0x65, 0x13, 0x39, 0x9f, 0xe1, 0xd1, 0xd4, 0x8b, 0x00, 0x1b, 0x49, 0xb3, 0xf2,
+	0x1f, 0x24, 0xc6, 0xd3, 0x5b, 0xfd, 0x72, 0x5b, 0xa5, 0x57, 0x5a, 0xd2, 0xd6, 0xe9, 0x27, 0xa1,
+	0xb1, 0x99, 0xe3, 0x49, 0xaa, 0x9c, 0x2d, 0xb2, 0x57, 0xef, 0x9b, 0xbf, 0x03, 0x00, 0x2e, 0x35,
+	0x39, 0xd7, 0x03, 0x0b, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1178,6 +1268,7 @@ type UserServiceClient interface {
 	AddUser(ctx context.Context, in *AddUserRequest, opts ...grpc.CallOption) (*AddUserResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	DelUser(ctx context.Context, in *DelUserRequest, opts ...grpc.CallOption) (*DelUserResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
 	ModifyUserInfo(ctx context.Context, in *ModifyUserInfoRequest, opts ...grpc.CallOption) (*ModifyUserInfoResponse, error)
 	GetUserIdByPhoneNumber(ctx context.Context, in *GetUserIdByPhoneNumberRequest, opts ...grpc.CallOption) (*GetUserIdByPhoneNumberResponse, error)
 	GetUsersByName(ctx context.Context, in *GetUsersByNameRequest, opts ...grpc.CallOption) (*GetUsersByNameResponse, error)
@@ -1221,6 +1312,15 @@ func (c *userServiceClient) DelUser(ctx context.Context, in *DelUserRequest, opt
 	return out, nil
 }
 
+func (c *userServiceClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, "/user.UserService/RestoreUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userServiceClient) ModifyUserInfo(ctx context.Context, in *ModifyUserInfoRequest, opts ...grpc.CallOption) (*ModifyUserInfoResponse, error) {
 	out := new(ModifyUserInfoResponse)
 	err := c.cc.Invoke(ctx, "/user.UserService/ModifyUserInfo", in, out, opts...)
@@ -1280,6 +1380,7 @@ type UserServiceServer interface {
 	AddUser(context.Context, *AddUserRequest) (*AddUserResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	DelUser(context.Context, *DelUserRequest) (*DelUserResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
 	ModifyUserInfo(context.Context, *ModifyUserInfoRequest) (*ModifyUserInfoResponse, error)
 	GetUserIdByPhoneNumber(context.Context, *GetUserIdByPhoneNumberRequest) (*GetUserIdByPhoneNumberResponse, error)
 	GetUsersByName(context.Context, *GetUsersByNameRequest) (*GetUsersByNameResponse, error)
@@ -1346,6 +1447,24 @@ func _UserService_DelUser_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserService_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserServiceServer).RestoreUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/user.UserService/RestoreUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserServiceServer).RestoreUser(ctx, req.(*RestoreUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserService_ModifyUserInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ModifyUserInfoRequest)
 	if err := dec(in); err != nil {
@@ -1470,6 +1589,10 @@ var _UserService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DelUser",
 			Handler:    _UserService_DelUser_Handler,
 		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _UserService_RestoreUser_Handler,
+		},
 		{
 			MethodName: "ModifyUserInfo",
 			Handler:    _UserService_ModifyUserInfo_Handler,
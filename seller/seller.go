@@ -0,0 +1,66 @@
+package seller
+
+import (
+	"context"
+	"errors"
+
+	"google.golang.org/grpc/metadata"
+)
+
+/*
+商家身份
+1.网关把HTTP头X-Seller-ID转成gRPC metadata透传给后端服务,服务自己的HTTP接口用NewIncomingContext转换
+2.商家接口只从metadata里取商家ID,不信任请求体里的sellerID
+3.服务之间调用时用AppendToOutgoingContext继续透传
+*/
+
+const (
+	HeaderKey   = "X-Seller-ID"
+	MetadataKey = "x-seller-id" // gRPC metadata的key都是小写
+)
+
+var (
+	ErrSellerRequired = errors.New("sellerID is null, set it in metadata " + MetadataKey)
+)
+
+// 没有商家ID时返回空
+func FromContext(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(MetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return values[0]
+}
+
+// 商家接口调用,没有商家ID时返回ErrSellerRequired
+func Require(ctx context.Context) (string, error) {
+	sellerID := FromContext(ctx)
+	if sellerID == "" {
+		return "", ErrSellerRequired
+	}
+
+	return sellerID, nil
+}
+
+func AppendToOutgoingContext(ctx context.Context, sellerID string) context.Context {
+	if sellerID == "" {
+		return ctx
+	}
+
+	return metadata.AppendToOutgoingContext(ctx, MetadataKey, sellerID)
+}
+
+// HTTP接口直接调用服务方法时,把HTTP头里的商家ID放进incoming metadata,和gRPC调用一样取
+func NewIncomingContext(ctx context.Context, sellerID string) context.Context {
+	if sellerID == "" {
+		return ctx
+	}
+
+	return metadata.NewIncomingContext(ctx, metadata.Pairs(MetadataKey, sellerID))
+}